@@ -1,6 +1,7 @@
 package pushaction
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -16,6 +17,10 @@ type ApplicationConfig struct {
 
 	CurrentRoutes []v2action.Route
 	DesiredRoutes []v2action.Route
+	NoRoute       bool
+
+	CurrentServices map[string]v2action.ServiceInstance
+	DesiredServices map[string]v2action.ServiceInstance
 
 	AllResources       []v2action.Resource
 	MatchedResources   []v2action.Resource
@@ -23,6 +28,10 @@ type ApplicationConfig struct {
 	Archive            bool
 	Path               string
 
+	// Dependencies are the names of the applications that must be pushed and
+	// started before this application.
+	Dependencies []string
+
 	TargetedSpaceGUID string
 }
 
@@ -48,6 +57,7 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		config := ApplicationConfig{
 			TargetedSpaceGUID: spaceGUID,
 			Path:              absPath,
+			Dependencies:      app.DependsOn,
 		}
 
 		log.Infoln("searching for app", app.Name)
@@ -81,15 +91,21 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		}
 		log.Debugln("post overriding config:", config.DesiredApplication)
 
-		defaultRoute, routeWarnings, err := actor.GetRouteWithDefaultDomain(app.Name, orgGUID, spaceGUID, config.CurrentRoutes)
+		var routeWarnings Warnings
+		config, routeWarnings, err = actor.configureRoutes(config, app, orgGUID, spaceGUID)
 		warnings = append(warnings, routeWarnings...)
 		if err != nil {
-			log.Errorln("getting default route:", err)
+			log.Errorln("configuring routes:", err)
 			return nil, warnings, err
 		}
 
-		// TODO: when working with all of routes, append to current route
-		config.DesiredRoutes = []v2action.Route{defaultRoute}
+		var serviceWarnings Warnings
+		config, serviceWarnings, err = actor.configureServices(config, app, spaceGUID)
+		warnings = append(warnings, serviceWarnings...)
+		if err != nil {
+			log.Errorln("configuring services:", err)
+			return nil, warnings, err
+		}
 
 		config, err = actor.configureResources(config, app.DockerImage)
 		if err != nil {
@@ -118,6 +134,81 @@ func (actor Actor) configureExistingApp(config ApplicationConfig, app manifest.A
 	return config, warnings, nil
 }
 
+// configureRoutes sets the desired routes to the manifest routes, the routes
+// built from the manifest hosts and domains, a random route on the first
+// push, no routes or the default route, in that order of precedence.
+func (actor Actor) configureRoutes(config ApplicationConfig, app manifest.Application, orgGUID string, spaceGUID string) (ApplicationConfig, Warnings, error) {
+	switch {
+	case app.NoRoute:
+		log.Debug("no-route set, removing all routes")
+		config.NoRoute = true
+		config.DesiredRoutes = nil
+		return config, nil, nil
+	case len(app.Routes) > 0:
+		routes, warnings, err := actor.CalculateRoutes(app.Routes, orgGUID, spaceGUID, config.CurrentRoutes)
+		config.DesiredRoutes = routes
+		return config, warnings, err
+	case len(app.Hosts) > 0 || len(app.Domains) > 0 || app.NoHostname:
+		routes, warnings, err := actor.HostAndDomainRoutes(app, orgGUID, spaceGUID, config.CurrentRoutes)
+		config.DesiredRoutes = routes
+		return config, warnings, err
+	case app.RandomRoute && len(config.CurrentRoutes) > 0:
+		log.Debug("random-route set on an app with routes, keeping existing routes")
+		config.DesiredRoutes = config.CurrentRoutes
+		return config, nil, nil
+	}
+
+	host := app.Name
+	if app.RandomRoute {
+		host = fmt.Sprintf("%s-%s", app.Name, actor.WordGenerator.Babble())
+	}
+
+	defaultRoute, warnings, err := actor.GetRouteWithDefaultDomain(host, orgGUID, spaceGUID, config.CurrentRoutes)
+	if err != nil {
+		log.Errorln("getting default route:", err)
+		return config, warnings, err
+	}
+
+	// TODO: when working with all of routes, append to current route
+	config.DesiredRoutes = []v2action.Route{defaultRoute}
+	return config, warnings, nil
+}
+
+// configureServices looks up the service instances listed in the manifest and
+// which of them are already bound to the application.
+func (actor Actor) configureServices(config ApplicationConfig, app manifest.Application, spaceGUID string) (ApplicationConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	config.CurrentServices = map[string]v2action.ServiceInstance{}
+	config.DesiredServices = map[string]v2action.ServiceInstance{}
+	for _, serviceName := range app.Services {
+		log.Debugln("looking up service instance", serviceName)
+		serviceInstance, warnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(serviceName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("service instance lookup:", err)
+			return config, allWarnings, err
+		}
+		config.DesiredServices[serviceName] = serviceInstance
+
+		if config.CreatingApplication() {
+			continue
+		}
+
+		_, warnings, err = actor.V2Actor.GetServiceBindingByApplicationAndServiceInstance(config.CurrentApplication.GUID, serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(v2action.ServiceBindingNotFoundError); ok {
+			continue
+		} else if err != nil {
+			log.Errorln("service binding lookup:", err)
+			return config, allWarnings, err
+		}
+		config.CurrentServices[serviceName] = serviceInstance
+	}
+
+	return config, allWarnings, nil
+}
+
 func (actor Actor) configureResources(config ApplicationConfig, dockerImagePath string) (ApplicationConfig, error) {
 	if dockerImagePath == "" {
 		info, err := os.Stat(config.Path)
@@ -156,6 +247,22 @@ func (Actor) overrideApplicationProperties(application Application, manifest man
 	if manifest.DiskQuota != 0 {
 		application.DiskQuota = manifest.DiskQuota
 	}
+	if manifest.DockerUsername != "" {
+		application.DockerCredentials = &ccv2.DockerCredentials{
+			Username: manifest.DockerUsername,
+			Password: manifest.DockerPassword,
+		}
+	}
+	if manifest.EnvironmentVariables != nil {
+		env := map[string]string{}
+		for name, value := range application.EnvironmentVariables {
+			env[name] = value
+		}
+		for name, value := range manifest.EnvironmentVariables {
+			env[name] = value
+		}
+		application.EnvironmentVariables = env
+	}
 	if manifest.HealthCheckHTTPEndpoint != "" {
 		application.HealthCheckHTTPEndpoint = manifest.HealthCheckHTTPEndpoint
 	}
@@ -10,6 +10,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -154,6 +156,17 @@ var _ = Describe("Application Config", func() {
 			})
 		})
 
+		Context("when the manifest app has dependencies", func() {
+			BeforeEach(func() {
+				manifestApps[0].DependsOn = []string{"some-other-app"}
+			})
+
+			It("sets the dependencies on the config", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.Dependencies).To(Equal([]string{"some-other-app"}))
+			})
+		})
+
 		Context("when the application exists", func() {
 			var app Application
 			var route v2action.Route
@@ -267,6 +280,9 @@ var _ = Describe("Application Config", func() {
 					manifestApps[0].DiskQuota = 2
 					manifestApps[0].Memory = 3
 					manifestApps[0].StackName = "some-stack"
+					manifestApps[0].DockerUsername = "some-docker-username"
+					manifestApps[0].DockerPassword = "some-docker-password"
+					manifestApps[0].EnvironmentVariables = map[string]string{"SOME_VAR": "some-value"}
 
 					stack = v2action.Stack{
 						Name: "some-stack",
@@ -279,6 +295,14 @@ var _ = Describe("Application Config", func() {
 				It("overrides the current application properties", func() {
 					Expect(warnings).To(ConsistOf("some-stack-warning", "private-domain-warnings", "shared-domain-warnings"))
 
+					Expect(firstConfig.DesiredApplication.DockerCredentials).To(Equal(&ccv2.DockerCredentials{
+						Username: "some-docker-username",
+						Password: "some-docker-password",
+					}))
+					Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{
+						"SOME_VAR": "some-value",
+					}))
+
 					Expect(firstConfig.DesiredApplication.Buildpack).To(Equal("some-buildpack"))
 					Expect(firstConfig.DesiredApplication.Command).To(Equal("some-buildpack"))
 					Expect(firstConfig.DesiredApplication.HealthCheckHTTPEndpoint).To(Equal("some-buildpack"))
@@ -304,10 +328,10 @@ var _ = Describe("Application Config", func() {
 					fakeV2Actor.GetStackReturns(stack, nil, nil)
 
 					app := v2action.Application{
-						Buildpack: "some-buildpack",
-						Command:   "some-buildpack",
-						DiskQuota: 2,
-						GUID:      "some-app-guid",
+						Buildpack:               "some-buildpack",
+						Command:                 "some-buildpack",
+						DiskQuota:               2,
+						GUID:                    "some-app-guid",
 						HealthCheckHTTPEndpoint: "some-buildpack",
 						HealthCheckTimeout:      5,
 						HealthCheckType:         "some-buildpack",
@@ -315,11 +339,13 @@ var _ = Describe("Application Config", func() {
 						Memory:                  3,
 						Name:                    appName,
 						StackGUID:               stack.GUID,
+						EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
 					}
 					fakeV2Actor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
 				})
 
 				It("keeps the original app properties", func() {
+					Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{"SOME_VAR": "some-value"}))
 					Expect(firstConfig.DesiredApplication.Buildpack).To(Equal("some-buildpack"))
 					Expect(firstConfig.DesiredApplication.Command).To(Equal("some-buildpack"))
 					Expect(firstConfig.DesiredApplication.HealthCheckHTTPEndpoint).To(Equal("some-buildpack"))
@@ -349,6 +375,29 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 
+			Context("when the manifest contains environment variables for an existing app", func() {
+				BeforeEach(func() {
+					manifestApps[0].EnvironmentVariables = map[string]string{"NEW_VAR": "new-value", "SOME_VAR": "overridden-value"}
+
+					app := v2action.Application{
+						GUID:                 "some-app-guid",
+						Name:                 appName,
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value", "OTHER_VAR": "other-value"},
+					}
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+				})
+
+				It("merges them into the existing environment variables", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{
+						"NEW_VAR":   "new-value",
+						"SOME_VAR":  "overridden-value",
+						"OTHER_VAR": "other-value",
+					}))
+					Expect(firstConfig.CurrentApplication.EnvironmentVariables).To(HaveLen(2))
+				})
+			})
+
 			Context("when no-start is set to true", func() {
 				BeforeEach(func() {
 					noStart = true
@@ -378,6 +427,237 @@ var _ = Describe("Application Config", func() {
 			})
 		})
 
+		Context("when the manifest contains routes", func() {
+			BeforeEach(func() {
+				manifestApps[0].Routes = []string{"some-host.private-domain.com/some-path", "private-domain.com:1234"}
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+			})
+
+			It("sets the desired routes to the manifest routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings", "get-route-warnings"))
+				Expect(firstConfig.DesiredRoutes).To(ConsistOf(
+					v2action.Route{
+						Domain:    domain,
+						Host:      "some-host",
+						Path:      "/some-path",
+						SpaceGUID: spaceGUID,
+					},
+					v2action.Route{
+						Domain:    domain,
+						Port:      1234,
+						SpaceGUID: spaceGUID,
+					},
+				))
+			})
+		})
+
+		Context("when the manifest contains hosts and domains", func() {
+			var otherDomain v2action.Domain
+
+			BeforeEach(func() {
+				otherDomain = v2action.Domain{Name: "shared-domain.com", GUID: "some-shared-domain-guid"}
+				fakeV2Actor.GetOrganizationDomainsReturns(
+					[]v2action.Domain{domain, otherDomain},
+					v2action.Warnings{"private-domain-warnings", "shared-domain-warnings"},
+					nil,
+				)
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+			})
+
+			Context("when hosts and domains are provided", func() {
+				BeforeEach(func() {
+					manifestApps[0].Hosts = []string{"host-1", "host-2"}
+					manifestApps[0].Domains = []string{"shared-domain.com"}
+				})
+
+				It("sets the desired routes to every host in every domain", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings", "get-route-warnings"))
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(
+						v2action.Route{Domain: otherDomain, Host: "host-1", SpaceGUID: spaceGUID},
+						v2action.Route{Domain: otherDomain, Host: "host-2", SpaceGUID: spaceGUID},
+					))
+				})
+			})
+
+			Context("when only hosts are provided", func() {
+				BeforeEach(func() {
+					manifestApps[0].Hosts = []string{"host-1"}
+				})
+
+				It("uses the default domain", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(
+						v2action.Route{Domain: domain, Host: "host-1", SpaceGUID: spaceGUID},
+					))
+				})
+			})
+
+			Context("when only domains are provided", func() {
+				BeforeEach(func() {
+					manifestApps[0].Domains = []string{"private-domain.com", "shared-domain.com"}
+				})
+
+				It("uses the app name as the host", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(
+						v2action.Route{Domain: domain, Host: appName, SpaceGUID: spaceGUID},
+						v2action.Route{Domain: otherDomain, Host: appName, SpaceGUID: spaceGUID},
+					))
+				})
+			})
+
+			Context("when no-hostname is set", func() {
+				BeforeEach(func() {
+					manifestApps[0].NoHostname = true
+					manifestApps[0].Hosts = []string{"host-1"}
+					manifestApps[0].Domains = []string{"shared-domain.com"}
+				})
+
+				It("uses routes without a host", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(
+						v2action.Route{Domain: otherDomain, SpaceGUID: spaceGUID},
+					))
+				})
+			})
+
+			Context("when a domain does not exist", func() {
+				BeforeEach(func() {
+					manifestApps[0].Domains = []string{"unknown-domain.com"}
+				})
+
+				It("returns a NoMatchingDomainError", func() {
+					Expect(executeErr).To(MatchError(NoMatchingDomainError{Route: "unknown-domain.com"}))
+				})
+			})
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				manifestApps[0].NoRoute = true
+			})
+
+			It("does not set any desired routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.NoRoute).To(BeTrue())
+				Expect(firstConfig.DesiredRoutes).To(BeEmpty())
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when random-route is set", func() {
+			var fakeWordGenerator *generatorfakes.FakeWordGenerator
+
+			BeforeEach(func() {
+				manifestApps[0].RandomRoute = true
+
+				fakeWordGenerator = new(generatorfakes.FakeWordGenerator)
+				fakeWordGenerator.BabbleReturns("some-random-words")
+				actor.WordGenerator = fakeWordGenerator
+
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, v2action.RouteNotFoundError{})
+			})
+
+			Context("when the app has no routes", func() {
+				It("adds a random route to desired routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(v2action.Route{
+						Domain:    domain,
+						Host:      "some-app-some-random-words",
+						SpaceGUID: spaceGUID,
+					}))
+				})
+			})
+
+			Context("when the app already has routes", func() {
+				var route v2action.Route
+
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: appName, GUID: "some-app-guid"}, nil, nil)
+					route = v2action.Route{GUID: "some-route-guid", Host: "some-host", Domain: domain, SpaceGUID: spaceGUID}
+					fakeV2Actor.GetApplicationRoutesReturns([]v2action.Route{route}, nil, nil)
+				})
+
+				It("keeps the existing routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(route))
+					Expect(fakeWordGenerator.BabbleCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the manifest contains services", func() {
+			var serviceInstance v2action.ServiceInstance
+
+			BeforeEach(func() {
+				manifestApps[0].Services = []string{"some-service"}
+				serviceInstance = v2action.ServiceInstance{Name: "some-service", GUID: "some-service-guid"}
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(serviceInstance, v2action.Warnings{"service-instance-warning"}, nil)
+			})
+
+			Context("when the app does not exist", func() {
+				It("sets the desired services", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("service-instance-warning"))
+					Expect(firstConfig.DesiredServices).To(Equal(map[string]v2action.ServiceInstance{"some-service": serviceInstance}))
+					Expect(firstConfig.CurrentServices).To(BeEmpty())
+
+					Expect(fakeV2Actor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+					serviceName, passedSpaceGUID := fakeV2Actor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(serviceName).To(Equal("some-service"))
+					Expect(passedSpaceGUID).To(Equal(spaceGUID))
+					Expect(fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the app exists", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: appName, GUID: "some-app-guid"}, nil, nil)
+				})
+
+				Context("when the service is already bound", func() {
+					BeforeEach(func() {
+						fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceReturns(v2action.ServiceBinding{GUID: "some-binding-guid"}, v2action.Warnings{"service-binding-warning"}, nil)
+					})
+
+					It("sets the current services", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ContainElement("service-binding-warning"))
+						Expect(firstConfig.CurrentServices).To(Equal(map[string]v2action.ServiceInstance{"some-service": serviceInstance}))
+
+						appGUID, serviceInstanceGUID := fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(serviceInstanceGUID).To(Equal("some-service-guid"))
+					})
+				})
+
+				Context("when the service is not bound", func() {
+					BeforeEach(func() {
+						fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceReturns(v2action.ServiceBinding{}, nil, v2action.ServiceBindingNotFoundError{})
+					})
+
+					It("does not set the current services", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.CurrentServices).To(BeEmpty())
+						Expect(firstConfig.DesiredServices).To(HaveLen(1))
+					})
+				})
+			})
+
+			Context("when the service instance cannot be found", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"service-instance-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-service"})
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(v2action.ServiceInstanceNotFoundError{Name: "some-service"}))
+					Expect(warnings).To(ContainElement("service-instance-warning"))
+				})
+			})
+		})
+
 		Context("when retrieving the default route errors", func() {
 			var expectedErr error
 
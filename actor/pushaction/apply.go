@@ -1,10 +1,6 @@
 package pushaction
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
-
 	log "github.com/sirupsen/logrus"
 )
 
@@ -47,57 +43,64 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 
 		eventStream <- ConfiguringRoutes
 
-		var createdRoutes bool
-		config, createdRoutes, warnings, err = actor.CreateRoutes(config)
-		warningsStream <- warnings
-		if err != nil {
-			errorStream <- err
-			return
-		}
-		if createdRoutes {
-			log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
-			eventStream <- CreatedRoutes
-		}
+		if config.NoRoute {
+			var unboundRoutes bool
+			config, unboundRoutes, warnings, err = actor.UnbindRoutes(config)
+			warningsStream <- warnings
+			if err != nil {
+				errorStream <- err
+				return
+			}
+			if unboundRoutes {
+				eventStream <- UnboundRoutes
+			}
+		} else {
+			var createdRoutes bool
+			config, createdRoutes, warnings, err = actor.CreateRoutes(config)
+			warningsStream <- warnings
+			if err != nil {
+				errorStream <- err
+				return
+			}
+			if createdRoutes {
+				log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
+				eventStream <- CreatedRoutes
+			}
 
-		var boundRoutes bool
-		config, boundRoutes, warnings, err = actor.BindRoutes(config)
-		warningsStream <- warnings
-		if err != nil {
-			errorStream <- err
-			return
-		}
-		if boundRoutes {
-			log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
-			eventStream <- BoundRoutes
+			var boundRoutes bool
+			config, boundRoutes, warnings, err = actor.BindRoutes(config)
+			warningsStream <- warnings
+			if err != nil {
+				errorStream <- err
+				return
+			}
+			if boundRoutes {
+				log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
+				eventStream <- BoundRoutes
+			}
 		}
 
-		if config.DesiredApplication.DockerImage == "" {
-			eventStream <- ResourceMatching
-			config, warnings = actor.SetMatchedResources(config)
+		if len(config.DesiredServices) > 0 {
+			var boundServices bool
+			config, boundServices, warnings, err = actor.BindServices(config)
 			warningsStream <- warnings
-
-			archivePath, err := actor.CreateArchive(config)
 			if err != nil {
 				errorStream <- err
 				return
 			}
-			eventStream <- CreatingArchive
-			defer os.Remove(archivePath)
-
-			for count := 0; count < PushRetries; count++ {
-				warnings, err = actor.UploadPackage(config, archivePath, progressBar, eventStream)
-				warningsStream <- warnings
-				if _, ok := err.(ccerror.PipeSeekError); !ok {
-					break
-				}
-				eventStream <- RetryUpload
+			if boundServices {
+				log.Debugf("bound desired services: %#v", config.DesiredServices)
+				eventStream <- BoundServices
 			}
+		}
+
+		if config.DesiredApplication.DockerImage == "" {
+			eventStream <- ResourceMatching
+			config, warnings = actor.SetMatchedResources(config)
+			warningsStream <- warnings
 
+			config, err = actor.UploadResources(config, progressBar, eventStream, warningsStream)
 			if err != nil {
-				if _, ok := err.(ccerror.PipeSeekError); ok {
-					errorStream <- UploadFailedError{}
-					return
-				}
 				errorStream <- err
 				return
 			}
@@ -1,7 +1,9 @@
 package pushaction_test
 
 import (
+	"archive/zip"
 	"errors"
+	"io"
 	"io/ioutil"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
@@ -151,13 +153,24 @@ var _ = Describe("Apply", func() {
 
 						Context("when the upload is successful", func() {
 							BeforeEach(func() {
-								fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil)
+								fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+									return reader
+								}
+								fakeV2Actor.UploadApplicationPackageStub = func(_ string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+									_, err := ioutil.ReadAll(reader)
+									Expect(err).ToNot(HaveOccurred())
+									return v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil
+								}
+								fakeV2Actor.DownloadApplicationPackageStub = func(_ string, writer io.Writer) (v2action.Warnings, error) {
+									return v2action.Warnings{"download-warnings-1", "download-warnings-2"}, zip.NewWriter(writer).Close()
+								}
 							})
 
 							JustBeforeEach(func() {
 								Eventually(eventStream).Should(Receive(Equal(UploadingApplication)))
 								Eventually(eventStream).Should(Receive(Equal(UploadComplete)))
 								Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
+								Eventually(warningsStream).Should(Receive(ConsistOf("download-warnings-1", "download-warnings-2")))
 							})
 
 							It("sends the updated config and a complete event", func() {
@@ -196,7 +209,7 @@ var _ = Describe("Apply", func() {
 									Eventually(warningsStream).Should(Receive(ConsistOf("upload-warnings-1", "upload-warnings-2")))
 									Eventually(eventStream).Should(Receive(Equal(RetryUpload)))
 
-									Eventually(errorStream).Should(Receive(Equal(UploadFailedError{})))
+									Eventually(errorStream).Should(Receive(Equal(UploadFailedError{Err: ccerror.PipeSeekError{}})))
 								})
 							})
 
@@ -247,6 +260,54 @@ var _ = Describe("Apply", func() {
 				})
 			})
 
+			Context("when services need to be bound", func() {
+				BeforeEach(func() {
+					config.DesiredApplication.DockerImage = "some-docker-image-path"
+					config.DesiredServices = map[string]v2action.ServiceInstance{
+						"some-service": {Name: "some-service", GUID: "some-service-guid"},
+					}
+				})
+
+				Context("when binding the services is successful", func() {
+					BeforeEach(func() {
+						fakeV2Actor.BindRouteToApplicationReturns(nil, nil)
+						fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warnings-1", "bind-service-warnings-2"}, nil)
+					})
+
+					It("binds the services and sends the BoundServices event", func() {
+						Eventually(warningsStream).Should(Receive())
+						Eventually(eventStream).Should(Receive(Equal(BoundRoutes)))
+						Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warnings-1", "bind-service-warnings-2")))
+						Eventually(eventStream).Should(Receive(Equal(BoundServices)))
+						Eventually(configStream).Should(Receive())
+						Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+						Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+						appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(serviceInstanceGUID).To(Equal("some-service-guid"))
+					})
+				})
+
+				Context("when binding the services errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("dios mio")
+						fakeV2Actor.BindRouteToApplicationReturns(nil, nil)
+						fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warnings-1", "bind-service-warnings-2"}, expectedErr)
+					})
+
+					It("sends warnings and errors, then stops", func() {
+						Eventually(warningsStream).Should(Receive())
+						Eventually(eventStream).Should(Receive(Equal(BoundRoutes)))
+						Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warnings-1", "bind-service-warnings-2")))
+						Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+						Consistently(eventStream).ShouldNot(Receive())
+					})
+				})
+			})
+
 			Context("when there are no routes to bind", func() {
 				BeforeEach(func() {
 					config.CurrentRoutes = createdRoutes
@@ -274,6 +335,51 @@ var _ = Describe("Apply", func() {
 			})
 		})
 
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.DesiredRoutes = nil
+				config.CurrentRoutes = []v2action.Route{{Host: "banana", GUID: "some-route-guid"}}
+				config.DesiredApplication.DockerImage = "some-docker-image-path"
+			})
+
+			Context("when unbinding the routes is successful", func() {
+				BeforeEach(func() {
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warnings-1", "unbind-route-warnings-2"}, nil)
+				})
+
+				It("unbinds the current routes and sends the UnboundRoutes event", func() {
+					Eventually(eventStream).Should(Receive(Equal(ConfiguringRoutes)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warnings-1", "unbind-route-warnings-2")))
+					Eventually(eventStream).Should(Receive(Equal(UnboundRoutes)))
+					Eventually(configStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+					Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(0))
+					Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+					routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("some-route-guid"))
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when unbinding the routes errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("dios mio")
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warnings-1", "unbind-route-warnings-2"}, expectedErr)
+				})
+
+				It("sends warnings and errors, then stops", func() {
+					Eventually(eventStream).Should(Receive(Equal(ConfiguringRoutes)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warnings-1", "unbind-route-warnings-2")))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+					Consistently(eventStream).ShouldNot(Receive())
+				})
+			})
+		})
+
 		Context("when there are no routes to create", func() {
 			BeforeEach(func() {
 				config.DesiredRoutes[0].GUID = "some-route-guid"
@@ -13,12 +13,16 @@ type CommandLineSettings struct {
 	CurrentDirectory   string
 	DiskQuota          uint64
 	DockerImage        string
+	DockerPassword     string
+	DockerUsername     string
 	HealthCheckTimeout int
 	HealthCheckType    string
 	Instances          int
 	Memory             uint64
 	Name               string
+	NoRoute            bool
 	ProvidedAppPath    string
+	RandomRoute        bool
 	StackName          string
 }
 
@@ -46,6 +50,11 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.DockerImage = settings.DockerImage
 	}
 
+	if settings.DockerUsername != "" {
+		app.DockerUsername = settings.DockerUsername
+	}
+	app.DockerPassword = settings.DockerPassword
+
 	if settings.HealthCheckTimeout != 0 {
 		app.HealthCheckTimeout = settings.HealthCheckTimeout
 	}
@@ -66,6 +75,10 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.Name = settings.Name
 	}
 
+	if settings.NoRoute {
+		app.NoRoute = true
+	}
+
 	if settings.ProvidedAppPath != "" {
 		app.Path = settings.absoluteProvidedAppPath()
 	}
@@ -73,6 +86,10 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.Path = settings.CurrentDirectory
 	}
 
+	if settings.RandomRoute {
+		app.RandomRoute = true
+	}
+
 	if settings.StackName != "" {
 		app.StackName = settings.StackName
 	}
@@ -82,18 +99,21 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 
 func (settings CommandLineSettings) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack: '%s', Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances: '%d', Memory: '%d', Provided App Path: '%s', Stack: '%s'",
+		"App Name: '%s', Buildpack: '%s', Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Docker Username: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances: '%d', Memory: '%d', No Route: '%t', Provided App Path: '%s', Random Route: '%t', Stack: '%s'",
 		settings.Name,
 		settings.BuildpackName,
 		settings.Command,
 		settings.CurrentDirectory,
 		settings.DiskQuota,
 		settings.DockerImage,
+		settings.DockerUsername,
 		settings.HealthCheckTimeout,
 		settings.HealthCheckType,
 		settings.Instances,
 		settings.Memory,
+		settings.NoRoute,
 		settings.ProvidedAppPath,
+		settings.RandomRoute,
 		settings.StackName,
 	)
 }
@@ -76,6 +76,16 @@ var _ = Describe("CommandLineSettings", func() {
 			manifest.Application{DockerImage: "steve"},
 			manifest.Application{DockerImage: "steve"},
 		),
+		Entry("overrides docker username and sets the docker password",
+			CommandLineSettings{DockerUsername: "not-steve", DockerPassword: "some-password"},
+			manifest.Application{DockerUsername: "steve"},
+			manifest.Application{DockerUsername: "not-steve", DockerPassword: "some-password"},
+		),
+		Entry("passes through docker username",
+			CommandLineSettings{},
+			manifest.Application{DockerUsername: "steve"},
+			manifest.Application{DockerUsername: "steve"},
+		),
 		Entry("overrides health check timeout",
 			CommandLineSettings{HealthCheckTimeout: 1024},
 			manifest.Application{HealthCheckTimeout: 512},
@@ -126,6 +136,26 @@ var _ = Describe("CommandLineSettings", func() {
 			manifest.Application{Name: "steve"},
 			manifest.Application{Name: "steve"},
 		),
+		Entry("overrides no route",
+			CommandLineSettings{NoRoute: true},
+			manifest.Application{},
+			manifest.Application{NoRoute: true},
+		),
+		Entry("passes through no route",
+			CommandLineSettings{},
+			manifest.Application{NoRoute: true},
+			manifest.Application{NoRoute: true},
+		),
+		Entry("overrides random route",
+			CommandLineSettings{RandomRoute: true},
+			manifest.Application{},
+			manifest.Application{RandomRoute: true},
+		),
+		Entry("passes through random route",
+			CommandLineSettings{},
+			manifest.Application{RandomRoute: true},
+			manifest.Application{RandomRoute: true},
+		),
 		Entry("overrides stack name",
 			CommandLineSettings{StackName: "not-steve"},
 			manifest.Application{StackName: "steve"},
@@ -0,0 +1,128 @@
+package pushaction
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	log "github.com/sirupsen/logrus"
+)
+
+// UnknownDependencyError is returned when an application depends on an
+// application that is not in the manifest.
+type UnknownDependencyError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e UnknownDependencyError) Error() string {
+	return fmt.Sprintf("app %s depends on %s which is not in the manifest", e.AppName, e.Dependency)
+}
+
+// DependencyCycleError is returned when the dependencies of the applications
+// in a manifest form a cycle.
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("apps depend on each other: %s", strings.Join(e.AppNames, " -> "))
+}
+
+// SortApplicationConfigsByDependencies returns the configs ordered so that
+// every application comes after the applications it depends on. Otherwise the
+// order of the configs is kept. Dependencies that are not in configs are
+// ignored.
+func SortApplicationConfigsByDependencies(configs []ApplicationConfig) []ApplicationConfig {
+	var sorted []ApplicationConfig
+	placed := map[string]bool{}
+	remaining := append([]ApplicationConfig{}, configs...)
+
+	for len(remaining) > 0 {
+		next := 0
+		for i, config := range remaining {
+			if DependenciesSatisfied(config, configs, placed) {
+				next = i
+				break
+			}
+		}
+
+		placed[remaining[next].DesiredApplication.Name] = true
+		sorted = append(sorted, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+
+	return sorted
+}
+
+// DependenciesSatisfied returns true if every dependency of config that is in
+// configs is in done.
+func DependenciesSatisfied(config ApplicationConfig, configs []ApplicationConfig, done map[string]bool) bool {
+	for _, dependency := range config.Dependencies {
+		if configInList(dependency, configs) && !done[dependency] {
+			return false
+		}
+	}
+	return true
+}
+
+// validateDependencies returns an error if an application depends on an
+// application that is not in apps or if the dependencies form a cycle.
+func (Actor) validateDependencies(apps []manifest.Application) error {
+	dependencies := map[string][]string{}
+	for _, app := range apps {
+		dependencies[app.Name] = app.DependsOn
+	}
+
+	for _, app := range apps {
+		for _, dependency := range app.DependsOn {
+			if _, ok := dependencies[dependency]; !ok {
+				log.WithField("app", app.Name).Errorln("unknown dependency:", dependency)
+				return UnknownDependencyError{AppName: app.Name, Dependency: dependency}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return DependencyCycleError{AppNames: append(path, name)}
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, app := range apps {
+		if err := visit(app.Name, nil); err != nil {
+			log.Errorln("dependency cycle:", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func configInList(name string, configs []ApplicationConfig) bool {
+	for _, config := range configs {
+		if config.DesiredApplication.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dependencies", func() {
+	var configs []ApplicationConfig
+
+	config := func(name string, dependencies ...string) ApplicationConfig {
+		return ApplicationConfig{
+			DesiredApplication: Application{Application: v2action.Application{Name: name}},
+			Dependencies:       dependencies,
+		}
+	}
+
+	names := func(configs []ApplicationConfig) []string {
+		var names []string
+		for _, config := range configs {
+			names = append(names, config.DesiredApplication.Name)
+		}
+		return names
+	}
+
+	Describe("SortApplicationConfigsByDependencies", func() {
+		Context("when there are no dependencies", func() {
+			BeforeEach(func() {
+				configs = []ApplicationConfig{config("app-1"), config("app-2"), config("app-3")}
+			})
+
+			It("keeps the order of the configs", func() {
+				Expect(names(SortApplicationConfigsByDependencies(configs))).To(Equal([]string{"app-1", "app-2", "app-3"}))
+			})
+		})
+
+		Context("when apps depend on apps later in the list", func() {
+			BeforeEach(func() {
+				configs = []ApplicationConfig{
+					config("app-1", "app-3"),
+					config("app-2"),
+					config("app-3", "app-4"),
+					config("app-4"),
+				}
+			})
+
+			It("orders the apps after their dependencies", func() {
+				Expect(names(SortApplicationConfigsByDependencies(configs))).To(Equal([]string{"app-2", "app-4", "app-3", "app-1"}))
+			})
+		})
+
+		Context("when an app depends on an app that is not being pushed", func() {
+			BeforeEach(func() {
+				configs = []ApplicationConfig{config("app-1", "app-0"), config("app-2")}
+			})
+
+			It("ignores the dependency", func() {
+				Expect(names(SortApplicationConfigsByDependencies(configs))).To(Equal([]string{"app-1", "app-2"}))
+			})
+		})
+	})
+
+	Describe("DependenciesSatisfied", func() {
+		BeforeEach(func() {
+			configs = []ApplicationConfig{config("app-1"), config("app-2"), config("app-3", "app-1", "app-2", "app-0")}
+		})
+
+		It("returns true only when all the dependencies being pushed are done", func() {
+			Expect(DependenciesSatisfied(configs[2], configs, map[string]bool{"app-1": true})).To(BeFalse())
+			Expect(DependenciesSatisfied(configs[2], configs, map[string]bool{"app-1": true, "app-2": true})).To(BeTrue())
+			Expect(DependenciesSatisfied(configs[0], configs, nil)).To(BeTrue())
+		})
+	})
+})
@@ -9,10 +9,23 @@ const (
 	ConfiguringRoutes    Event = "configuring routes"
 	CreatedRoutes        Event = "created routes"
 	BoundRoutes          Event = "bound routes"
+	UnboundRoutes        Event = "unbound routes"
+	BoundServices        Event = "bound services"
 	CreatingArchive      Event = "creating archive"
 	ResourceMatching     Event = "resource matching"
 	UploadingApplication Event = "uploading application"
 	UploadComplete       Event = "upload complete"
 	RetryUpload          Event = "retry upload"
+	ResumingUpload       Event = "resuming upload"
 	Complete             Event = "complete"
+
+	DeletingVenerableApplication   Event = "deleting venerable application"
+	MappingReplacementRoutes       Event = "mapping routes to replacement application"
+	UnmappingOriginalRoutes        Event = "unmapping routes from original application"
+	DeletingOriginalApplication    Event = "deleting original application"
+	StoppingOriginalApplication    Event = "stopping original application"
+	RenamingOriginalApplication    Event = "renaming original application"
+	RenamingReplacementApplication Event = "renaming replacement application"
+	RollingBack                    Event = "rolling back"
+	RolledBack                     Event = "rolled back"
 )
@@ -0,0 +1,23 @@
+package pushaction
+
+import "os"
+
+// SetInterruptSignals replaces the signals that make an upload delete its
+// scratch application, and returns a function that restores them.
+func SetInterruptSignals(signals ...os.Signal) func() {
+	original := interruptSignals
+	interruptSignals = signals
+	return func() {
+		interruptSignals = original
+	}
+}
+
+// SetExit replaces the function an interrupted upload exits with, and returns
+// a function that restores it.
+func SetExit(exitFunc func(int)) func() {
+	original := exit
+	exit = exitFunc
+	return func() {
+		exit = original
+	}
+}
@@ -4,12 +4,53 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strconv"
 
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	"github.com/cloudfoundry/bytefmt"
-
 	yaml "gopkg.in/yaml.v2"
 )
 
+// EnvVarNilError is returned when an environment variable in the manifest has
+// no value.
+type EnvVarNilError struct {
+	Name string
+}
+
+func (e EnvVarNilError) Error() string {
+	return fmt.Sprintf("env var '%s' should not be null", e.Name)
+}
+
+// InvalidInheritPathError is returned when the inherit property of a manifest
+// is not a path.
+type InvalidInheritPathError struct {
+	Path string
+}
+
+func (e InvalidInheritPathError) Error() string {
+	return fmt.Sprintf("invalid inherit path in manifest %s", e.Path)
+}
+
+// InheritanceCycleError is returned when a manifest inherits from itself,
+// directly or through other manifests.
+type InheritanceCycleError struct {
+	Path string
+}
+
+func (e InheritanceCycleError) Error() string {
+	return fmt.Sprintf("manifest %s inherits from itself", e.Path)
+}
+
+// RoutesWithHostsOrDomainsError is returned when an application has both
+// 'routes' and any of 'host', 'hosts', 'domain', 'domains' or 'no-hostname'.
+type RoutesWithHostsOrDomainsError struct {
+	AppName string
+}
+
+func (e RoutesWithHostsOrDomainsError) Error() string {
+	return fmt.Sprintf("application '%s' cannot use 'routes' together with 'host', 'hosts', 'domain', 'domains' or 'no-hostname'", e.AppName)
+}
+
 type Manifest struct {
 	Applications []Application `yaml:"applications"`
 }
@@ -17,53 +58,102 @@ type Manifest struct {
 type Application struct {
 	BuildpackName string
 	Command       string
+	// DependsOn are the names of the applications in the same manifest that
+	// must be pushed and started before this application.
+	DependsOn []string
 	// DiskQuota is the disk size in megabytes.
-	DiskQuota               uint64
-	DockerImage             string
+	DiskQuota   uint64
+	DockerImage string
+	// DockerPassword is never read from the manifest. It is provided by the
+	// command line settings.
+	DockerPassword string
+	DockerUsername string
+	// Domains are the domains of the routes built from Hosts and Domains when
+	// there are no Routes.
+	Domains                 []string
+	EnvironmentVariables    map[string]string
 	HealthCheckHTTPEndpoint string
 	// HealthCheckType attribute defines the number of seconds that is allocated
 	// for starting an application.
 	HealthCheckTimeout int
 	HealthCheckType    string
-	Instances          int
+	// Hosts are the hostnames of the routes built from Hosts and Domains when
+	// there are no Routes.
+	Hosts     []string
+	Instances int
 	// Memory is the amount of memory in megabytes.
-	Memory    uint64
-	Name      string
-	Path      string
+	Memory uint64
+	Name   string
+	// NoHostname builds routes from the Domains alone, ignoring Hosts.
+	NoHostname  bool
+	NoRoute     bool
+	Path        string
+	RandomRoute bool
+	// Routes are the full routes, for example 'host.domain.com/path' or
+	// 'tcp.domain.com:1234'.
+	Routes    []string
+	Services  []string
 	StackName string
 }
 
 func (app Application) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack: '%s', Command: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances: '%d', Memory: '%d', Path: '%s', Stack Name: '%s'",
+		"App Name: '%s', Buildpack: '%s', Command: '%s', Depends On: '%s', Disk Quota: '%d', Docker Image: '%s', Docker Username: '%s', Domains: '%s', Environment Variables: '%d', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Hosts: '%s', Instances: '%d', Memory: '%d', No Hostname: '%t', No Route: '%t', Path: '%s', Random Route: '%t', Routes: '%s', Services: '%s', Stack Name: '%s'",
 		app.Name,
 		app.BuildpackName,
 		app.Command,
+		app.DependsOn,
 		app.DiskQuota,
 		app.DockerImage,
+		app.DockerUsername,
+		app.Domains,
+		len(app.EnvironmentVariables),
 		app.HealthCheckHTTPEndpoint,
 		app.HealthCheckTimeout,
 		app.HealthCheckType,
+		app.Hosts,
 		app.Instances,
 		app.Memory,
+		app.NoHostname,
+		app.NoRoute,
 		app.Path,
+		app.RandomRoute,
+		app.Routes,
+		app.Services,
 		app.StackName,
 	)
 }
 
 func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error {
 	var manifestApp struct {
-		Buildpack               string `yaml:"buildpack"`
-		Command                 string `yaml:"command"`
-		DiskQuota               string `yaml:"disk_quota"`
-		HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint"`
-		HealthCheckType         string `yaml:"health-check-type"`
-		Instances               int    `yaml:"instances"`
-		Memory                  string `yaml:"memory"`
-		Name                    string `yaml:"name"`
-		Path                    string `yaml:"path"`
-		StackName               string `yaml:"stack"`
-		Timeout                 int    `yaml:"timeout"`
+		Buildpack string   `yaml:"buildpack"`
+		Command   string   `yaml:"command"`
+		DependsOn []string `yaml:"depends-on"`
+		DiskQuota string   `yaml:"disk_quota"`
+		Docker    struct {
+			Image    string `yaml:"image"`
+			Username string `yaml:"username"`
+		} `yaml:"docker"`
+		Domain                  string                 `yaml:"domain"`
+		Domains                 []string               `yaml:"domains"`
+		EnvironmentVariables    map[string]interface{} `yaml:"env"`
+		HealthCheckHTTPEndpoint string                 `yaml:"health-check-http-endpoint"`
+		HealthCheckType         string                 `yaml:"health-check-type"`
+		Host                    string                 `yaml:"host"`
+		Hosts                   []string               `yaml:"hosts"`
+		Instances               intOrString            `yaml:"instances"`
+		Memory                  string                 `yaml:"memory"`
+		Name                    string                 `yaml:"name"`
+		NoHostname              boolOrString           `yaml:"no-hostname"`
+		NoRoute                 boolOrString           `yaml:"no-route"`
+		Path                    string                 `yaml:"path"`
+		RandomRoute             boolOrString           `yaml:"random-route"`
+		Routes                  []struct {
+			Route string `yaml:"route"`
+		} `yaml:"routes"`
+		Services  []string    `yaml:"services"`
+		StackName string      `yaml:"stack"`
+		Timeout   intOrString `yaml:"timeout"`
 	}
 
 	err := unmarshaller(&manifestApp)
@@ -73,13 +163,40 @@ func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error
 
 	a.BuildpackName = manifestApp.Buildpack
 	a.Command = manifestApp.Command
+	a.DependsOn = manifestApp.DependsOn
+	a.DockerImage = manifestApp.Docker.Image
+	a.DockerUsername = manifestApp.Docker.Username
 	a.HealthCheckHTTPEndpoint = manifestApp.HealthCheckHTTPEndpoint
 	a.HealthCheckType = manifestApp.HealthCheckType
-	a.Instances = manifestApp.Instances
+	a.Instances = int(manifestApp.Instances)
 	a.Name = manifestApp.Name
+	a.NoHostname = bool(manifestApp.NoHostname)
+	a.NoRoute = bool(manifestApp.NoRoute)
 	a.Path = manifestApp.Path
+	a.RandomRoute = bool(manifestApp.RandomRoute)
+	a.Services = manifestApp.Services
 	a.StackName = manifestApp.StackName
-	a.HealthCheckTimeout = manifestApp.Timeout
+	a.HealthCheckTimeout = int(manifestApp.Timeout)
+
+	for _, route := range manifestApp.Routes {
+		a.Routes = append(a.Routes, route.Route)
+	}
+
+	a.Hosts = appendUnique(manifestApp.Hosts, manifestApp.Host)
+	a.Domains = appendUnique(manifestApp.Domains, manifestApp.Domain)
+	if len(a.Routes) > 0 && (len(a.Hosts) > 0 || len(a.Domains) > 0 || a.NoHostname) {
+		return RoutesWithHostsOrDomainsError{AppName: a.Name}
+	}
+
+	if manifestApp.EnvironmentVariables != nil {
+		a.EnvironmentVariables = map[string]string{}
+		for name, value := range manifestApp.EnvironmentVariables {
+			if value == nil {
+				return EnvVarNilError{Name: name}
+			}
+			a.EnvironmentVariables[name] = fmt.Sprint(value)
+		}
+	}
 
 	if manifestApp.DiskQuota != "" {
 		disk, err := bytefmt.ToMegabytes(manifestApp.DiskQuota)
@@ -100,9 +217,83 @@ func (a *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error
 	return nil
 }
 
-func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
+// intOrString is an integer that can also be written as a string, which is
+// the case when it is set from a --var placeholder.
+type intOrString int
+
+func (i *intOrString) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var value int
+	if err := unmarshaller(&value); err == nil {
+		*i = intOrString(value)
+		return nil
+	}
+
+	var str string
+	if err := unmarshaller(&str); err != nil {
+		return err
+	}
+	value, err := strconv.Atoi(str)
+	if err != nil {
+		return fmt.Errorf("invalid integer '%s'", str)
+	}
+	*i = intOrString(value)
+	return nil
+}
+
+// boolOrString is a boolean that can also be written as a string, which is
+// the case when it is set from a --var placeholder.
+type boolOrString bool
+
+func (b *boolOrString) UnmarshalYAML(unmarshaller func(interface{}) error) error {
+	var value bool
+	if err := unmarshaller(&value); err == nil {
+		*b = boolOrString(value)
+		return nil
+	}
+
+	var str string
+	if err := unmarshaller(&str); err != nil {
+		return err
+	}
+	value, err := strconv.ParseBool(str)
+	if err != nil {
+		return fmt.Errorf("invalid boolean '%s'", str)
+	}
+	*b = boolOrString(value)
+	return nil
+}
+
+// appendUnique returns the values followed by value, without duplicates or
+// empty strings.
+func appendUnique(values []string, value string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, v := range append(values, value) {
+		if v != "" && !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// ReadAndMergeManifests reads the manifest at the provided path along with
+// the manifests it inherits from. Properties outside of 'applications' apply
+// to every application. ((var)) placeholders are replaced with the provided
+// vars before the properties are validated.
+func ReadAndMergeManifests(pathToManifest string, vars manifestvars.Vars) ([]Application, error) {
 	// Read all manifest files
-	raw, err := ioutil.ReadFile(pathToManifest)
+	rawManifest, err := readManifestWithInheritance(pathToManifest, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	interpolatedManifest, err := manifestvars.Interpolate(rawManifest, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := yaml.Marshal(applyGlobalProperties(interpolatedManifest.(map[interface{}]interface{})))
 	if err != nil {
 		return nil, err
 	}
@@ -122,3 +313,114 @@ func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
 	// Merge all manifest files
 	return manifest.Applications, err
 }
+
+// readManifestWithInheritance reads the manifest and merges it on top of the
+// manifest referenced by its 'inherit' property, if any. visited contains the
+// absolute paths of the manifests that inherit from this one.
+func readManifestWithInheritance(pathToManifest string, visited map[string]bool) (map[interface{}]interface{}, error) {
+	absPath, err := filepath.Abs(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+	if visited[absPath] {
+		return nil, InheritanceCycleError{Path: pathToManifest}
+	}
+	visited[absPath] = true
+
+	raw, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	rawManifest := map[interface{}]interface{}{}
+	err = yaml.Unmarshal(raw, &rawManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	inherit, found := rawManifest["inherit"]
+	if !found {
+		return rawManifest, nil
+	}
+	delete(rawManifest, "inherit")
+
+	inheritPath, ok := inherit.(string)
+	if !ok {
+		return nil, InvalidInheritPathError{Path: pathToManifest}
+	}
+	if !filepath.IsAbs(inheritPath) {
+		inheritPath = filepath.Join(filepath.Dir(pathToManifest), inheritPath)
+	}
+
+	parentManifest, err := readManifestWithInheritance(inheritPath, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	return deepMerge(parentManifest, rawManifest), nil
+}
+
+// applyGlobalProperties merges the properties outside of 'applications' into
+// every application. A manifest without 'applications' describes a single
+// application.
+func applyGlobalProperties(rawManifest map[interface{}]interface{}) map[interface{}]interface{} {
+	globalProperties := map[interface{}]interface{}{}
+	for key, value := range rawManifest {
+		if key != "applications" {
+			globalProperties[key] = value
+		}
+	}
+
+	rawApps, found := rawManifest["applications"].([]interface{})
+	if !found {
+		return map[interface{}]interface{}{
+			"applications": []interface{}{globalProperties},
+		}
+	}
+
+	var apps []interface{}
+	for _, rawApp := range rawApps {
+		if app, ok := rawApp.(map[interface{}]interface{}); ok {
+			apps = append(apps, deepMerge(globalProperties, app))
+		} else {
+			apps = append(apps, rawApp)
+		}
+	}
+
+	return map[interface{}]interface{}{
+		"applications": apps,
+	}
+}
+
+// deepMerge returns the union of the two maps. Nested maps are merged, lists
+// are concatenated and any other value in override replaces the one in base.
+func deepMerge(base map[interface{}]interface{}, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := map[interface{}]interface{}{}
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, value := range override {
+		baseValue, found := merged[key]
+		if !found {
+			merged[key] = value
+			continue
+		}
+
+		switch typedValue := value.(type) {
+		case map[interface{}]interface{}:
+			if baseMap, ok := baseValue.(map[interface{}]interface{}); ok {
+				merged[key] = deepMerge(baseMap, typedValue)
+				continue
+			}
+		case []interface{}:
+			if baseList, ok := baseValue.([]interface{}); ok {
+				merged[key] = append(append([]interface{}{}, baseList...), typedValue...)
+				continue
+			}
+		}
+		merged[key] = value
+	}
+
+	return merged
+}
@@ -3,8 +3,10 @@ package manifest_test
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -32,15 +34,18 @@ var _ = Describe("Manifest", func() {
 
 	Describe("ReadAndMergeManifests", func() {
 		var (
+			vars manifestvars.Vars
+
 			apps       []Application
 			executeErr error
 		)
 
 		JustBeforeEach(func() {
-			apps, executeErr = ReadAndMergeManifests(pathToManifest)
+			apps, executeErr = ReadAndMergeManifests(pathToManifest, vars)
 		})
 
 		BeforeEach(func() {
+			vars = nil
 			manifest = `---
 applications:
 - name: "app-1"
@@ -83,5 +88,354 @@ applications:
 				Application{Name: "app-3"},
 			))
 		})
+
+		Context("when the manifest contains docker, env, routes and services", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+  docker:
+    image: "some-image"
+    username: "some-docker-user"
+  env:
+    SOME_STRING: "some-value"
+    SOME_NUMBER: 12
+    SOME_BOOL: true
+  routes:
+  - route: "host.some-domain.com/path"
+  - route: "tcp.some-domain.com:1234"
+  services:
+  - "service-1"
+  - "service-2"
+- name: "app-2"
+  no-route: true
+- name: "app-3"
+  random-route: true
+`
+			})
+
+			It("reads the properties", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:           "app-1",
+						DockerImage:    "some-image",
+						DockerUsername: "some-docker-user",
+						EnvironmentVariables: map[string]string{
+							"SOME_STRING": "some-value",
+							"SOME_NUMBER": "12",
+							"SOME_BOOL":   "true",
+						},
+						Routes:   []string{"host.some-domain.com/path", "tcp.some-domain.com:1234"},
+						Services: []string{"service-1", "service-2"},
+					},
+					Application{Name: "app-2", NoRoute: true},
+					Application{Name: "app-3", RandomRoute: true},
+				))
+			})
+		})
+
+		Context("when the manifest contains hosts and domains", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+  host: "some-host"
+  hosts:
+  - "other-host"
+  - "some-host"
+  domain: "some-domain.com"
+  domains:
+  - "other-domain.com"
+- name: "app-2"
+  no-hostname: true
+  domain: "some-domain.com"
+`
+			})
+
+			It("reads the hosts and domains", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:    "app-1",
+						Hosts:   []string{"other-host", "some-host"},
+						Domains: []string{"other-domain.com", "some-domain.com"},
+					},
+					Application{
+						Name:       "app-2",
+						Domains:    []string{"some-domain.com"},
+						NoHostname: true,
+					},
+				))
+			})
+
+			Context("when the application also has routes", func() {
+				BeforeEach(func() {
+					manifest = `---
+domain: "some-domain.com"
+applications:
+- name: "app-1"
+  routes:
+  - route: "host.some-domain.com"
+`
+				})
+
+				It("returns a RoutesWithHostsOrDomainsError", func() {
+					Expect(executeErr).To(MatchError(RoutesWithHostsOrDomainsError{AppName: "app-1"}))
+				})
+			})
+		})
+
+		Context("when the manifest contains dependencies", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+- name: "app-2"
+  depends-on:
+  - "app-1"
+`
+			})
+
+			It("reads the dependencies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{Name: "app-1"},
+					Application{Name: "app-2", DependsOn: []string{"app-1"}},
+				))
+			})
+		})
+
+		Context("when the manifest contains variables", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: ((name))
+  instances: ((instances))
+  no-route: ((no-route))
+  memory: ((memory))
+  env:
+    GREETING: "hello ((name))"
+`
+			})
+
+			Context("when all variables are provided", func() {
+				BeforeEach(func() {
+					vars = manifestvars.Vars{
+						"name":      "some-app",
+						"instances": 3,
+						"no-route":  true,
+						"memory":    "1G",
+					}
+				})
+
+				It("substitutes the variables before reading the properties", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(apps).To(ConsistOf(Application{
+						Name:                 "some-app",
+						Instances:            3,
+						NoRoute:              true,
+						Memory:               1024,
+						EnvironmentVariables: map[string]string{"GREETING": "hello some-app"},
+					}))
+				})
+			})
+
+			Context("when the variables are strings from --var", func() {
+				BeforeEach(func() {
+					manifest = `---
+applications:
+- name: some-app
+  instances: ((instances))
+  no-route: ((no-route))
+  timeout: ((timeout))
+  env:
+    VERSION: ((version))
+`
+					vars = manifestvars.Vars{
+						"instances": "3",
+						"no-route":  "true",
+						"timeout":   "60",
+						"version":   "1.10",
+					}
+				})
+
+				It("reads numbers and booleans from the strings, keeping strings as they are", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(apps).To(ConsistOf(Application{
+						Name:                 "some-app",
+						Instances:            3,
+						NoRoute:              true,
+						HealthCheckTimeout:   60,
+						EnvironmentVariables: map[string]string{"VERSION": "1.10"},
+					}))
+				})
+
+				Context("when a string is not a number", func() {
+					BeforeEach(func() {
+						vars["instances"] = "three"
+					})
+
+					It("returns an error", func() {
+						Expect(executeErr).To(MatchError("invalid integer 'three'"))
+					})
+				})
+			})
+
+			Context("when variables are missing", func() {
+				BeforeEach(func() {
+					vars = manifestvars.Vars{"name": "some-app"}
+				})
+
+				It("returns an UnresolvedVariablesError with every missing variable", func() {
+					Expect(executeErr).To(MatchError(manifestvars.UnresolvedVariablesError{
+						Names: []string{"instances", "memory", "no-route"},
+					}))
+				})
+			})
+		})
+
+		Context("when an env var is null", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: "app-1"
+  env:
+    SOME_VAR:
+`
+			})
+
+			It("returns an EnvVarNilError", func() {
+				Expect(executeErr).To(MatchError(EnvVarNilError{Name: "SOME_VAR"}))
+			})
+		})
+
+		Context("when the manifest has global properties", func() {
+			BeforeEach(func() {
+				manifest = `---
+memory: 200M
+env:
+  GLOBAL: "global-value"
+services:
+- "global-service"
+applications:
+- name: "app-1"
+  memory: 1G
+  env:
+    LOCAL: "local-value"
+  services:
+  - "local-service"
+- name: "app-2"
+`
+			})
+
+			It("applies them to every application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:   "app-1",
+						Memory: 1024,
+						EnvironmentVariables: map[string]string{
+							"GLOBAL": "global-value",
+							"LOCAL":  "local-value",
+						},
+						Services: []string{"global-service", "local-service"},
+					},
+					Application{
+						Name:                 "app-2",
+						Memory:               200,
+						EnvironmentVariables: map[string]string{"GLOBAL": "global-value"},
+						Services:             []string{"global-service"},
+					},
+				))
+			})
+		})
+
+		Context("when the manifest has no applications", func() {
+			BeforeEach(func() {
+				manifest = `---
+name: "app-1"
+instances: 2
+`
+			})
+
+			It("reads the manifest as a single application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(Application{Name: "app-1", Instances: 2}))
+			})
+		})
+
+		Context("when the manifest inherits from another manifest", func() {
+			var pathToParentManifest string
+
+			BeforeEach(func() {
+				tempFile, err := ioutil.TempFile("", "manifest-parent-test-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).ToNot(HaveOccurred())
+				pathToParentManifest = tempFile.Name()
+
+				err = ioutil.WriteFile(pathToParentManifest, []byte(`---
+instances: 3
+memory: 200M
+env:
+  PARENT: "parent-value"
+`), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				manifest = `---
+inherit: ` + pathToParentManifest + `
+memory: 1G
+env:
+  CHILD: "child-value"
+applications:
+- name: "app-1"
+`
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(pathToParentManifest)).ToNot(HaveOccurred())
+			})
+
+			It("merges the child manifest on top of the parent manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:      "app-1",
+						Instances: 3,
+						Memory:    1024,
+						EnvironmentVariables: map[string]string{
+							"PARENT": "parent-value",
+							"CHILD":  "child-value",
+						},
+					},
+				))
+			})
+
+			Context("when the parent manifest inherits from the child manifest", func() {
+				JustBeforeEach(func() {
+					err := ioutil.WriteFile(pathToParentManifest, []byte("inherit: "+pathToManifest+"\n"), 0666)
+					Expect(err).ToNot(HaveOccurred())
+
+					apps, executeErr = ReadAndMergeManifests(pathToManifest, vars)
+				})
+
+				It("returns an InheritanceCycleError", func() {
+					Expect(executeErr).To(MatchError(InheritanceCycleError{Path: pathToManifest}))
+				})
+			})
+		})
+
+		Context("when the manifest inherits from itself", func() {
+			JustBeforeEach(func() {
+				err := ioutil.WriteFile(pathToManifest, []byte("inherit: "+filepath.Base(pathToManifest)+"\n"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+
+				apps, executeErr = ReadAndMergeManifests(pathToManifest, vars)
+			})
+
+			It("returns an InheritanceCycleError", func() {
+				Expect(executeErr).To(MatchError(InheritanceCycleError{Path: pathToManifest}))
+			})
+		})
 	})
 })
@@ -40,7 +40,7 @@ var _ = Describe("Manifest with paths", func() {
 		)
 
 		JustBeforeEach(func() {
-			apps, executeErr = ReadAndMergeManifests(pathToManifest)
+			apps, executeErr = ReadAndMergeManifests(pathToManifest, nil)
 		})
 
 		BeforeEach(func() {
@@ -28,6 +28,12 @@ func (CommandLineOptionsWithMultipleAppsError) Error() string {
 	return "cannot use command line flag with multiple apps"
 }
 
+type DockerPasswordNotSetError struct{}
+
+func (DockerPasswordNotSetError) Error() string {
+	return "docker password not set"
+}
+
 type AppNotFoundInManifestError struct {
 	Name string
 }
@@ -43,6 +49,11 @@ func (actor Actor) MergeAndValidateSettingsAndManifests(settings CommandLineSett
 		log.Info("no manifest, generating one from command line settings")
 		mergedApps = append(mergedApps, settings.OverrideManifestSettings(manifest.Application{}))
 	} else {
+		err := actor.validateDependencies(apps)
+		if err != nil {
+			return nil, err
+		}
+
 		if settings.Name != "" && len(apps) > 1 {
 			var err error
 			apps, err = actor.selectApp(settings.Name, apps)
@@ -51,7 +62,7 @@ func (actor Actor) MergeAndValidateSettingsAndManifests(settings CommandLineSett
 			}
 		}
 
-		err := actor.validatePremergedSettings(settings, apps)
+		err = actor.validatePremergedSettings(settings, apps)
 		if err != nil {
 			return nil, err
 		}
@@ -98,11 +109,14 @@ func (Actor) validatePremergedSettings(settings CommandLineSettings, apps []mani
 			settings.Command != "",
 			settings.DiskQuota != 0,
 			settings.DockerImage != "",
+			settings.DockerUsername != "",
 			settings.HealthCheckTimeout != 0,
 			settings.HealthCheckType != "",
 			settings.Instances != 0,
 			settings.Memory != 0,
+			settings.NoRoute,
 			settings.ProvidedAppPath != "",
+			settings.RandomRoute,
 			settings.StackName != "":
 			log.Error("cannot use some parameters with multiple apps")
 			return CommandLineOptionsWithMultipleAppsError{}
@@ -122,6 +136,10 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 			log.WithField("path", app.Path).Error("app path does not exist")
 			return NonexistentAppPathError{Path: app.Path}
 		}
+		if app.DockerUsername != "" && app.DockerPassword == "" {
+			log.WithField("app", app.Name).Error("docker username provided without a password")
+			return DockerPasswordNotSetError{}
+		}
 	}
 	return nil
 }
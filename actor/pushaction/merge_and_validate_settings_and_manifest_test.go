@@ -131,6 +131,25 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 				})
 			})
 
+			Context("when the app depends on an app that is not selected", func() {
+				BeforeEach(func() {
+					cmdSettings.Name = "app-2"
+					apps[1].DependsOn = []string{"app-1"}
+				})
+
+				It("returns just the specified app manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(mergedApps).To(ConsistOf(
+						manifest.Application{
+							Name:      "app-2",
+							Path:      currentDirectory,
+							DependsOn: []string{"app-1"},
+						},
+					))
+				})
+			})
+
 			Context("when the app does *not* exist in the manifest", func() {
 				BeforeEach(func() {
 					cmdSettings.Name = "app-4"
@@ -200,5 +219,13 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{Memory: 4}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{ProvidedAppPath: "some-path"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{StackName: "some-stackname"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{DockerUsername: "some-docker-username"}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{NoRoute: true}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("CommandLineOptionsWithMultipleAppsError", CommandLineSettings{RandomRoute: true}, []manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}}, CommandLineOptionsWithMultipleAppsError{}),
+		Entry("DockerPasswordNotSetError", CommandLineSettings{Name: "some-name", ProvidedAppPath: ".", DockerImage: "some-image", DockerUsername: "some-docker-username"}, nil, DockerPasswordNotSetError{}),
+		Entry("DockerPasswordNotSetError", CommandLineSettings{}, []manifest.Application{{Name: "some-name", Path: ".", DockerImage: "some-image", DockerUsername: "some-docker-username"}}, DockerPasswordNotSetError{}),
+		Entry("UnknownDependencyError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DependsOn: []string{"some-name-3"}}, {Name: "some-name-2"}}, UnknownDependencyError{AppName: "some-name-1", Dependency: "some-name-3"}),
+		Entry("DependencyCycleError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DependsOn: []string{"some-name-2"}}, {Name: "some-name-2", DependsOn: []string{"some-name-1"}}}, DependencyCycleError{AppNames: []string{"some-name-1", "some-name-2", "some-name-1"}}),
+		Entry("DependencyCycleError", CommandLineSettings{}, []manifest.Application{{Name: "some-name-1", DependsOn: []string{"some-name-1"}}}, DependencyCycleError{AppNames: []string{"some-name-1", "some-name-1"}}),
 	)
 })
@@ -0,0 +1,136 @@
+package pushaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/sirupsen/logrus"
+)
+
+// PushPlan is the set of changes that applying an ApplicationConfig would
+// make to the routes, services, environment variables and bits of an
+// application.
+type PushPlan struct {
+	RoutesToCreate []v2action.Route
+	RoutesToBind   []v2action.Route
+	RoutesToUnbind []v2action.Route
+	ServicesToBind []string
+
+	// EnvironmentVariablesToSet are the names of the environment variables
+	// that would be added or changed.
+	EnvironmentVariablesToSet []string
+
+	MatchedResources   []v2action.Resource
+	UnmatchedResources []v2action.Resource
+}
+
+// MinimumMatchableResourceSize is the size in bytes below which the Cloud
+// Controller's resource pool does not store, and so never matches, a file.
+// It mirrors the default resource_pool.minimum_size of the Cloud Controller.
+const MinimumMatchableResourceSize int64 = 64 * 1024
+
+// HasChanges returns true if the plan creates, binds or unbinds anything,
+// sets any environment variables or uploads any bits that could have been
+// matched. Files smaller than MinimumMatchableResourceSize are always
+// unmatched, so they are reported by UndetectableResources instead.
+func (plan PushPlan) HasChanges() bool {
+	return len(plan.RoutesToCreate) > 0 ||
+		len(plan.RoutesToBind) > 0 ||
+		len(plan.RoutesToUnbind) > 0 ||
+		len(plan.ServicesToBind) > 0 ||
+		len(plan.EnvironmentVariablesToSet) > 0 ||
+		plan.hasMatchableUnmatchedResources()
+}
+
+// UndetectableResources returns the unmatched files that are smaller than
+// MinimumMatchableResourceSize. The Cloud Controller never matches them, so
+// whether they changed cannot be detected.
+func (plan PushPlan) UndetectableResources() []v2action.Resource {
+	var resources []v2action.Resource
+	for _, resource := range plan.UnmatchedResources {
+		if resource.SHA1 != "" && resource.Size < MinimumMatchableResourceSize {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// MatchedSize returns the number of bytes that do not need to be uploaded
+// because the Cloud Controller already has them.
+func (plan PushPlan) MatchedSize() int64 {
+	return resourcesSize(plan.MatchedResources)
+}
+
+// UnmatchedSize returns the number of bytes that would be uploaded.
+func (plan PushPlan) UnmatchedSize() int64 {
+	return resourcesSize(plan.UnmatchedResources)
+}
+
+// PlanPush returns the changes Apply would make for the provided config
+// without changing anything on the Cloud Controller. Bits are resource
+// matched so the plan knows which files would be uploaded.
+func (actor Actor) PlanPush(config ApplicationConfig) (PushPlan, Warnings) {
+	log.WithField("app", config.DesiredApplication.Name).Info("planning push")
+
+	var plan PushPlan
+
+	if config.NoRoute {
+		plan.RoutesToUnbind = config.CurrentRoutes
+	} else {
+		for _, route := range config.DesiredRoutes {
+			if route.GUID == "" {
+				plan.RoutesToCreate = append(plan.RoutesToCreate, route)
+				plan.RoutesToBind = append(plan.RoutesToBind, route)
+			} else if !actor.routeInListByGUID(route, config.CurrentRoutes) {
+				plan.RoutesToBind = append(plan.RoutesToBind, route)
+			}
+		}
+	}
+
+	for serviceName := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceName]; !ok {
+			plan.ServicesToBind = append(plan.ServicesToBind, serviceName)
+		}
+	}
+	sort.Strings(plan.ServicesToBind)
+
+	currentEnv := config.CurrentApplication.EnvironmentVariables
+	for name, value := range config.DesiredApplication.EnvironmentVariables {
+		if currentValue, ok := currentEnv[name]; !ok || currentValue != value {
+			plan.EnvironmentVariablesToSet = append(plan.EnvironmentVariablesToSet, name)
+		}
+	}
+	sort.Strings(plan.EnvironmentVariablesToSet)
+
+	if config.DesiredApplication.DockerImage != "" {
+		log.WithField("docker_image", config.DesiredApplication.DockerImage).Debug("skipping resource matching")
+		return plan, nil
+	}
+
+	config, warnings := actor.SetMatchedResources(config)
+	plan.MatchedResources = config.MatchedResources
+	plan.UnmatchedResources = config.UnmatchedResources
+
+	log.WithFields(log.Fields{
+		"matched":   len(plan.MatchedResources),
+		"unmatched": len(plan.UnmatchedResources),
+	}).Debug("planned resources")
+	return plan, warnings
+}
+
+func (plan PushPlan) hasMatchableUnmatchedResources() bool {
+	for _, resource := range plan.UnmatchedResources {
+		if resource.Size >= MinimumMatchableResourceSize {
+			return true
+		}
+	}
+	return false
+}
+
+func resourcesSize(resources []v2action.Resource) int64 {
+	var size int64
+	for _, resource := range resources {
+		size += resource.Size
+	}
+	return size
+}
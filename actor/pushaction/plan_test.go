@@ -0,0 +1,208 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+	})
+
+	Describe("PlanPush", func() {
+		var (
+			config ApplicationConfig
+
+			plan     PushPlan
+			warnings Warnings
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+				},
+				CurrentRoutes: []v2action.Route{
+					{GUID: "route-guid-1", Host: "route-1"},
+				},
+				DesiredRoutes: []v2action.Route{
+					{GUID: "route-guid-1", Host: "route-1"},
+					{GUID: "route-guid-2", Host: "route-2"},
+					{Host: "route-3"},
+				},
+				CurrentServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1", GUID: "service-guid-1"},
+				},
+				DesiredServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1", GUID: "service-guid-1"},
+					"service-3": {Name: "service-3", GUID: "service-guid-3"},
+					"service-2": {Name: "service-2", GUID: "service-guid-2"},
+				},
+				AllResources: []v2action.Resource{
+					{Filename: "file-1", SHA1: "sha-1", Size: 10},
+					{Filename: "file-2", SHA1: "sha-2", Size: 20},
+					{Filename: "file-3", SHA1: "sha-3", Size: 40},
+				},
+			}
+
+			fakeV2Actor.ResourceMatchReturns(
+				[]v2action.Resource{{Filename: "file-1", SHA1: "sha-1", Size: 10}, {Filename: "file-3", SHA1: "sha-3", Size: 40}},
+				[]v2action.Resource{{Filename: "file-2", SHA1: "sha-2", Size: 20}},
+				v2action.Warnings{"resource-match-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings = actor.PlanPush(config)
+		})
+
+		It("plans the routes, services and bits without changing anything", func() {
+			Expect(warnings).To(ConsistOf("resource-match-warning"))
+
+			Expect(plan.RoutesToCreate).To(ConsistOf(v2action.Route{Host: "route-3"}))
+			Expect(plan.RoutesToBind).To(ConsistOf(
+				v2action.Route{GUID: "route-guid-2", Host: "route-2"},
+				v2action.Route{Host: "route-3"},
+			))
+			Expect(plan.RoutesToUnbind).To(BeEmpty())
+			Expect(plan.ServicesToBind).To(Equal([]string{"service-2", "service-3"}))
+
+			Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(config.AllResources))
+			Expect(plan.MatchedSize()).To(BeNumerically("==", 50))
+			Expect(plan.UnmatchedSize()).To(BeNumerically("==", 20))
+			Expect(plan.HasChanges()).To(BeTrue())
+
+			Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(0))
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.DesiredRoutes = nil
+			})
+
+			It("plans to unbind the current routes", func() {
+				Expect(plan.RoutesToCreate).To(BeEmpty())
+				Expect(plan.RoutesToBind).To(BeEmpty())
+				Expect(plan.RoutesToUnbind).To(Equal(config.CurrentRoutes))
+			})
+		})
+
+		Context("when resource matching fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.ResourceMatchReturns(nil, nil, v2action.Warnings{"resource-match-warning"}, errors.New("some-error"))
+			})
+
+			It("plans to upload all the resources", func() {
+				Expect(warnings).To(ConsistOf("resource-match-warning"))
+				Expect(plan.MatchedResources).To(BeEmpty())
+				Expect(plan.UnmatchedResources).To(Equal(config.AllResources))
+			})
+		})
+
+		Context("when the app is a docker app", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.DockerImage = "some-image"
+			})
+
+			It("does not resource match", func() {
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+				Expect(plan.UnmatchedResources).To(BeEmpty())
+			})
+		})
+
+		Context("when nothing would change", func() {
+			BeforeEach(func() {
+				config.DesiredRoutes = config.CurrentRoutes
+				config.DesiredServices = config.CurrentServices
+				fakeV2Actor.ResourceMatchReturns(config.AllResources, nil, nil, nil)
+			})
+
+			It("has no changes", func() {
+				Expect(plan.HasChanges()).To(BeFalse())
+				Expect(plan.UnmatchedSize()).To(BeZero())
+				Expect(plan.UndetectableResources()).To(BeEmpty())
+			})
+
+			Context("when an environment variable would be added or changed", func() {
+				BeforeEach(func() {
+					config.CurrentApplication.EnvironmentVariables = map[string]string{
+						"UNCHANGED": "value",
+						"CHANGED":   "old-value",
+					}
+					config.DesiredApplication.EnvironmentVariables = map[string]string{
+						"UNCHANGED": "value",
+						"CHANGED":   "new-value",
+						"ADDED":     "value",
+					}
+				})
+
+				It("plans to set them", func() {
+					Expect(plan.EnvironmentVariablesToSet).To(Equal([]string{"ADDED", "CHANGED"}))
+					Expect(plan.HasChanges()).To(BeTrue())
+				})
+			})
+
+			Context("when only directories are unmatched", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(config.AllResources, []v2action.Resource{{Filename: "some-dir/"}}, nil, nil)
+				})
+
+				It("has no undetectable resources", func() {
+					Expect(plan.HasChanges()).To(BeFalse())
+					Expect(plan.UndetectableResources()).To(BeEmpty())
+				})
+			})
+
+			Context("when only files too small to be resource matched are unmatched", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(
+						[]v2action.Resource{{Filename: "large-file", Size: MinimumMatchableResourceSize}},
+						config.AllResources,
+						nil,
+						nil,
+					)
+				})
+
+				It("reports the files as undetectable instead of changes", func() {
+					Expect(plan.UnmatchedResources).To(Equal(config.AllResources))
+					Expect(plan.HasChanges()).To(BeFalse())
+					Expect(plan.UndetectableResources()).To(Equal(config.AllResources))
+				})
+			})
+
+			Context("when a file large enough to be resource matched is unmatched", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(
+						config.AllResources,
+						[]v2action.Resource{{Filename: "large-file", Size: MinimumMatchableResourceSize}},
+						nil,
+						nil,
+					)
+				})
+
+				It("has changes", func() {
+					Expect(plan.HasChanges()).To(BeTrue())
+				})
+			})
+		})
+	})
+})
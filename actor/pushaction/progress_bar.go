@@ -1,9 +1,22 @@
 package pushaction
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 //go:generate counterfeiter . ProgressBar
 
 type ProgressBar interface {
 	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+
+	// AttemptComplete is called after every upload request with the number of
+	// bytes sent, how long the request took and the error it failed with, if
+	// any.
+	AttemptComplete(bytes int64, duration time.Duration, err error)
+
+	// Verified is called with the number and size of the pushed files found
+	// unchanged in the package the Cloud Controller stores, and the SHA256 of
+	// that package.
+	Verified(files int, size int64, packageSHA256 string)
 }
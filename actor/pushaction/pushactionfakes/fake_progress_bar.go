@@ -4,6 +4,7 @@ package pushactionfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 )
@@ -21,6 +22,20 @@ type FakeProgressBar struct {
 	newProgressBarWrapperReturnsOnCall map[int]struct {
 		result1 io.Reader
 	}
+	AttemptCompleteStub        func(bytes int64, duration time.Duration, err error)
+	attemptCompleteMutex       sync.RWMutex
+	attemptCompleteArgsForCall []struct {
+		bytes    int64
+		duration time.Duration
+		err      error
+	}
+	VerifiedStub        func(files int, size int64, packageSHA256 string)
+	verifiedMutex       sync.RWMutex
+	verifiedArgsForCall []struct {
+		files         int
+		size          int64
+		packageSHA256 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -74,11 +89,67 @@ func (fake *FakeProgressBar) NewProgressBarWrapperReturnsOnCall(i int, result1 i
 	}{result1}
 }
 
+func (fake *FakeProgressBar) AttemptComplete(bytes int64, duration time.Duration, err error) {
+	fake.attemptCompleteMutex.Lock()
+	fake.attemptCompleteArgsForCall = append(fake.attemptCompleteArgsForCall, struct {
+		bytes    int64
+		duration time.Duration
+		err      error
+	}{bytes, duration, err})
+	fake.recordInvocation("AttemptComplete", []interface{}{bytes, duration, err})
+	fake.attemptCompleteMutex.Unlock()
+	if fake.AttemptCompleteStub != nil {
+		fake.AttemptCompleteStub(bytes, duration, err)
+	}
+}
+
+func (fake *FakeProgressBar) AttemptCompleteCallCount() int {
+	fake.attemptCompleteMutex.RLock()
+	defer fake.attemptCompleteMutex.RUnlock()
+	return len(fake.attemptCompleteArgsForCall)
+}
+
+func (fake *FakeProgressBar) AttemptCompleteArgsForCall(i int) (int64, time.Duration, error) {
+	fake.attemptCompleteMutex.RLock()
+	defer fake.attemptCompleteMutex.RUnlock()
+	return fake.attemptCompleteArgsForCall[i].bytes, fake.attemptCompleteArgsForCall[i].duration, fake.attemptCompleteArgsForCall[i].err
+}
+
+func (fake *FakeProgressBar) Verified(files int, size int64, packageSHA256 string) {
+	fake.verifiedMutex.Lock()
+	fake.verifiedArgsForCall = append(fake.verifiedArgsForCall, struct {
+		files         int
+		size          int64
+		packageSHA256 string
+	}{files, size, packageSHA256})
+	fake.recordInvocation("Verified", []interface{}{files, size, packageSHA256})
+	fake.verifiedMutex.Unlock()
+	if fake.VerifiedStub != nil {
+		fake.VerifiedStub(files, size, packageSHA256)
+	}
+}
+
+func (fake *FakeProgressBar) VerifiedCallCount() int {
+	fake.verifiedMutex.RLock()
+	defer fake.verifiedMutex.RUnlock()
+	return len(fake.verifiedArgsForCall)
+}
+
+func (fake *FakeProgressBar) VerifiedArgsForCall(i int) (int, int64, string) {
+	fake.verifiedMutex.RLock()
+	defer fake.verifiedMutex.RUnlock()
+	return fake.verifiedArgsForCall[i].files, fake.verifiedArgsForCall[i].size, fake.verifiedArgsForCall[i].packageSHA256
+}
+
 func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.newProgressBarWrapperMutex.RLock()
 	defer fake.newProgressBarWrapperMutex.RUnlock()
+	fake.attemptCompleteMutex.RLock()
+	defer fake.attemptCompleteMutex.RUnlock()
+	fake.verifiedMutex.RLock()
+	defer fake.verifiedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
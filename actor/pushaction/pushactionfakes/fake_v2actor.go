@@ -24,6 +24,20 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	BindServiceByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	bindServiceByApplicationAndServiceInstanceMutex       sync.RWMutex
+	bindServiceByApplicationAndServiceInstanceArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	bindServiceByApplicationAndServiceInstanceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindServiceByApplicationAndServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	CreateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -55,6 +69,33 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(guid string) (v2action.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DownloadApplicationPackageStub        func(guid string, writer io.Writer) (v2action.Warnings, error)
+	downloadApplicationPackageMutex       sync.RWMutex
+	downloadApplicationPackageArgsForCall []struct {
+		guid   string
+		writer io.Writer
+	}
+	downloadApplicationPackageReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	downloadApplicationPackageReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	FindRouteBoundToSpaceWithSettingsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	findRouteBoundToSpaceWithSettingsMutex       sync.RWMutex
 	findRouteBoundToSpaceWithSettingsArgsForCall []struct {
@@ -142,6 +183,38 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceBindingByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	getServiceBindingByApplicationAndServiceInstanceMutex       sync.RWMutex
+	getServiceBindingByApplicationAndServiceInstanceArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	getServiceBindingByApplicationAndServiceInstanceReturns struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingByApplicationAndServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstanceByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getServiceInstanceByNameAndSpaceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
 	getStackMutex       sync.RWMutex
 	getStackArgsForCall []struct {
@@ -202,6 +275,20 @@ type FakeV2Actor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -319,6 +406,58 @@ func (fake *FakeV2Actor) BindRouteToApplicationReturnsOnCall(i int, result1 v2ac
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error) {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall[len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)]
+	fake.bindServiceByApplicationAndServiceInstanceArgsForCall = append(fake.bindServiceByApplicationAndServiceInstanceArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("BindServiceByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID})
+	fake.bindServiceByApplicationAndServiceInstanceMutex.Unlock()
+	if fake.BindServiceByApplicationAndServiceInstanceStub != nil {
+		return fake.BindServiceByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindServiceByApplicationAndServiceInstanceReturns.result1, fake.bindServiceByApplicationAndServiceInstanceReturns.result2
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceCallCount() int {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	return len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceArgsForCall(i int) (string, string) {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	return fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindServiceByApplicationAndServiceInstanceStub = nil
+	fake.bindServiceByApplicationAndServiceInstanceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindServiceByApplicationAndServiceInstanceStub = nil
+	if fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall == nil {
+		fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -428,6 +567,109 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) DeleteApplication(guid string) (v2action.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) DeleteApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DownloadApplicationPackage(guid string, writer io.Writer) (v2action.Warnings, error) {
+	fake.downloadApplicationPackageMutex.Lock()
+	ret, specificReturn := fake.downloadApplicationPackageReturnsOnCall[len(fake.downloadApplicationPackageArgsForCall)]
+	fake.downloadApplicationPackageArgsForCall = append(fake.downloadApplicationPackageArgsForCall, struct {
+		guid   string
+		writer io.Writer
+	}{guid, writer})
+	fake.recordInvocation("DownloadApplicationPackage", []interface{}{guid, writer})
+	fake.downloadApplicationPackageMutex.Unlock()
+	if fake.DownloadApplicationPackageStub != nil {
+		return fake.DownloadApplicationPackageStub(guid, writer)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.downloadApplicationPackageReturns.result1, fake.downloadApplicationPackageReturns.result2
+}
+
+func (fake *FakeV2Actor) DownloadApplicationPackageCallCount() int {
+	fake.downloadApplicationPackageMutex.RLock()
+	defer fake.downloadApplicationPackageMutex.RUnlock()
+	return len(fake.downloadApplicationPackageArgsForCall)
+}
+
+func (fake *FakeV2Actor) DownloadApplicationPackageArgsForCall(i int) (string, io.Writer) {
+	fake.downloadApplicationPackageMutex.RLock()
+	defer fake.downloadApplicationPackageMutex.RUnlock()
+	return fake.downloadApplicationPackageArgsForCall[i].guid, fake.downloadApplicationPackageArgsForCall[i].writer
+}
+
+func (fake *FakeV2Actor) DownloadApplicationPackageReturns(result1 v2action.Warnings, result2 error) {
+	fake.DownloadApplicationPackageStub = nil
+	fake.downloadApplicationPackageReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DownloadApplicationPackageReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DownloadApplicationPackageStub = nil
+	if fake.downloadApplicationPackageReturnsOnCall == nil {
+		fake.downloadApplicationPackageReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.downloadApplicationPackageReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
 	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
 	ret, specificReturn := fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)]
@@ -747,6 +989,116 @@ func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall[len(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall)]
+	fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall = append(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("GetServiceBindingByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID})
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.Unlock()
+	if fake.GetServiceBindingByApplicationAndServiceInstanceStub != nil {
+		return fake.GetServiceBindingByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingByApplicationAndServiceInstanceReturns.result1, fake.getServiceBindingByApplicationAndServiceInstanceReturns.result2, fake.getServiceBindingByApplicationAndServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceCallCount() int {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceArgsForCall(i int) (string, string) {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	return fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceReturns(result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceStub = nil
+	fake.getServiceBindingByApplicationAndServiceInstanceReturns = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceStub = nil
+	if fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall == nil {
+		fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceByNameAndSpaceArgsForCall = append(fake.getServiceInstanceByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetServiceInstanceByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceByNameAndSpaceReturns.result1, fake.getServiceInstanceByNameAndSpaceReturns.result2, fake.getServiceInstanceByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.getServiceInstanceByNameAndSpaceArgsForCall[i].name, fake.getServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	fake.getServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	if fake.getServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
 	fake.getStackMutex.Lock()
 	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
@@ -968,6 +1320,58 @@ func (fake *FakeV2Actor) ResourceMatchReturnsOnCall(i int, result1 []v2action.Re
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV2Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -1203,10 +1607,16 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.bindRouteToApplicationMutex.RLock()
 	defer fake.bindRouteToApplicationMutex.RUnlock()
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.downloadApplicationPackageMutex.RLock()
+	defer fake.downloadApplicationPackageMutex.RUnlock()
 	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
 	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
 	fake.gatherArchiveResourcesMutex.RLock()
@@ -1219,6 +1629,10 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
 	defer fake.getOrganizationDomainsMutex.RUnlock()
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
 	fake.getStackByNameMutex.RLock()
@@ -1227,6 +1641,8 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.pollJobMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
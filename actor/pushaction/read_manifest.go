@@ -1,8 +1,17 @@
 package pushaction
 
-import "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/util/manifestvars"
+)
 
-func (*Actor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
-	// Cover method to make testing easier
-	return manifest.ReadAndMergeManifests(pathToManifest)
+// ReadManifest reads the manifest and replaces its ((var)) placeholders with
+// the values from the vars files and the KEY=VALUE vars.
+func (*Actor) ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []string) ([]manifest.Application, error) {
+	allVars, err := manifestvars.ReadVars(pathsToVarsFiles, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ReadAndMergeManifests(pathToManifest, allVars)
 }
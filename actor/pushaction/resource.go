@@ -1,11 +1,53 @@
 package pushaction
 
 import (
+	"archive/zip"
+	"crypto/sha1"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	log "github.com/sirupsen/logrus"
 )
 
+// UploadChunkSize is the number of bytes of unmatched files above which the
+// files the Cloud Controller can resource match are uploaded in chunks, so
+// that a failed request only has to resend one chunk.
+var UploadChunkSize int64 = 64 * 1024 * 1024
+
+// MaximumMatchableResourceSize is the size in bytes above which the Cloud
+// Controller's resource pool does not store, and so never matches, a file.
+// It mirrors the default resource_pool.maximum_size of the Cloud Controller.
+const MaximumMatchableResourceSize int64 = 512 * 1024 * 1024
+
+// interruptSignals are the signals that make an upload delete its scratch
+// application before the push exits.
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// exit ends the push once an interrupted upload has deleted its scratch
+// application.
+var exit = os.Exit
+
+// UploadChecksumError is returned when files of the package the Cloud
+// Controller stores after the upload are missing or differ from the pushed
+// files.
+type UploadChecksumError struct {
+	Files []string
+}
+
+func (e UploadChecksumError) Error() string {
+	return fmt.Sprintf("files of the uploaded package do not match the pushed files: %s", strings.Join(e.Files, ", "))
+}
+
 func (actor Actor) CreateArchive(config ApplicationConfig) (string, error) {
 	log.Info("creating archive")
 
@@ -41,19 +83,276 @@ func (actor Actor) SetMatchedResources(config ApplicationConfig) (ApplicationCon
 	return config, Warnings(warnings)
 }
 
+// NextUploadChunk returns the unmatched files to upload before the rest of
+// the config's bits, or nil if the unmatched files fit in a single upload.
+// Only files the Cloud Controller stores in its resource pool are chunked,
+// because the others cannot be resource matched once they are uploaded.
+func NextUploadChunk(config ApplicationConfig) []v2action.Resource {
+	pending := matchableResources(config.UnmatchedResources)
+	if resourcesSize(pending) <= UploadChunkSize {
+		return nil
+	}
+
+	var chunk []v2action.Resource
+	var size int64
+	for _, resource := range pending {
+		chunk = append(chunk, resource)
+		size += resource.Size
+		if size >= UploadChunkSize {
+			break
+		}
+	}
+	return chunk
+}
+
+// UploadResources uploads the unmatched resources of the config. When there
+// are more than UploadChunkSize bytes of them, they are first uploaded in
+// chunks to a stopped scratch application, which adds them to the Cloud
+// Controller's resource pool without replacing the bits of the config's
+// application. The files are resource matched again after every chunk, so
+// the chunks the Cloud Controller already has, including those uploaded by an
+// earlier push that failed, are never sent again. The application's bits are
+// then replaced in a single upload of the files that are left, and the
+// package the Cloud Controller stores is downloaded to check the SHA1 of every
+// pushed file.
+//
+// The attempts made and the scratch application are persisted in the actor's
+// UploadStateDir until the upload succeeds, so that a later push can report
+// that it is resuming and delete a scratch application left behind.
+func (actor Actor) UploadResources(config ApplicationConfig, progressBar ProgressBar, eventStream chan<- Event, warningsStream chan<- Warnings) (ApplicationConfig, error) {
+	state := actor.loadUploadState(config)
+	if state.Resumed() {
+		log.WithField("attempts", len(state.Attempts)).Info("resuming upload")
+		eventStream <- ResumingUpload
+	}
+	state = actor.deleteScratchApp(state, warningsStream)
+
+	config, state, err := actor.uploadChunks(config, state, progressBar, eventStream, warningsStream)
+	state = actor.deleteScratchApp(state, warningsStream)
+	if err != nil {
+		return config, err
+	}
+
+	state, err = actor.uploadArchive(config, state, progressBar, eventStream, warningsStream)
+	if err != nil {
+		return config, err
+	}
+
+	err = actor.verifyUpload(config, progressBar, warningsStream)
+	if err != nil {
+		actor.saveUploadState(state)
+		return config, err
+	}
+
+	state.Attempts = nil
+	actor.updateUploadState(state)
+	return config, nil
+}
+
+// uploadChunks uploads the chunks returned by NextUploadChunk to a scratch
+// application and returns the config resource matched again. Chunking stops
+// if the Cloud Controller does not match the files of a chunk after it is
+// uploaded, and is skipped if the scratch application cannot be created or
+// one left by an earlier push could not be deleted, in which case the files
+// are all sent in the final upload. The scratch application is recorded in
+// the returned state for the caller to delete, and is deleted before the push
+// exits if it is interrupted.
+func (actor Actor) uploadChunks(config ApplicationConfig, state UploadState, progressBar ProgressBar, eventStream chan<- Event, warningsStream chan<- Warnings) (ApplicationConfig, UploadState, error) {
+	chunk := NextUploadChunk(config)
+	if chunk == nil {
+		return config, state, nil
+	}
+	if state.ScratchAppGUID != "" {
+		log.WithField("appGUID", state.ScratchAppGUID).Warn("scratch app of an earlier push still exists, uploading without chunks")
+		return config, state, nil
+	}
+
+	scratchApp, warnings, err := actor.V2Actor.CreateApplication(v2action.Application{
+		Name:      fmt.Sprintf("%s-upload-%s", config.DesiredApplication.Name, actor.WordGenerator.Babble()),
+		SpaceGUID: config.TargetedSpaceGUID,
+		State:     ccv2.ApplicationStopped,
+	})
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		log.Warnln("creating scratch app, uploading without chunks:", err)
+		return config, state, nil
+	}
+	state.ScratchAppGUID = scratchApp.GUID
+	actor.saveUploadState(state)
+	defer actor.deleteOnInterrupt(scratchApp)()
+
+	for ; chunk != nil; chunk = NextUploadChunk(config) {
+		log.WithFields(log.Fields{
+			"files": len(chunk),
+			"size":  resourcesSize(chunk),
+		}).Debug("uploading chunk")
+
+		chunkConfig := config
+		chunkConfig.DesiredApplication.GUID = scratchApp.GUID
+		chunkConfig.MatchedResources = nil
+		chunkConfig.UnmatchedResources = chunk
+
+		state, err = actor.uploadArchive(chunkConfig, state, progressBar, eventStream, warningsStream)
+		if err != nil {
+			return config, state, err
+		}
+
+		var warnings Warnings
+		matchedSize := resourcesSize(config.MatchedResources)
+		eventStream <- ResourceMatching
+		config, warnings = actor.SetMatchedResources(config)
+		warningsStream <- warnings
+
+		if resourcesSize(config.MatchedResources) <= matchedSize {
+			log.Warn("uploaded chunk was not resource matched, uploading the rest without chunks")
+			break
+		}
+	}
+
+	return config, state, nil
+}
+
+// deleteOnInterrupt deletes app and exits if the push is interrupted before
+// the returned function is called.
+func (actor Actor) deleteOnInterrupt(app v2action.Application) func() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, interruptSignals...)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-signals:
+			log.WithField("appName", app.Name).Warn("interrupted, deleting scratch app")
+			_, err := actor.V2Actor.DeleteApplication(app.GUID)
+			if err != nil {
+				log.WithField("appName", app.Name).Errorln("deleting scratch app:", err)
+			}
+			exit(2)
+		case <-done:
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
+
+// deleteScratchApp deletes the scratch application recorded in state and
+// removes it from the state once it no longer exists. A failed deletion is
+// logged and left for a later push to retry.
+func (actor Actor) deleteScratchApp(state UploadState, warningsStream chan<- Warnings) UploadState {
+	if state.ScratchAppGUID == "" {
+		return state
+	}
+
+	warnings, err := actor.V2Actor.DeleteApplication(state.ScratchAppGUID)
+	warningsStream <- Warnings(warnings)
+	if _, ok := err.(v2action.ApplicationNotFoundError); err != nil && !ok {
+		log.WithField("appGUID", state.ScratchAppGUID).Errorln("deleting scratch app:", err)
+		return state
+	}
+
+	state.ScratchAppGUID = ""
+	actor.updateUploadState(state)
+	return state
+}
+
+// uploadArchive archives the config's unmatched resources and uploads them,
+// retrying up to PushRetries times if the request fails. The state is
+// persisted after a failed attempt.
+func (actor Actor) uploadArchive(config ApplicationConfig, state UploadState, progressBar ProgressBar, eventStream chan<- Event, warningsStream chan<- Warnings) (UploadState, error) {
+	archivePath, err := actor.CreateArchive(config)
+	if err != nil {
+		return state, err
+	}
+	eventStream <- CreatingArchive
+	defer os.Remove(archivePath)
+
+	for count := 0; count < PushRetries; count++ {
+		var attempt UploadAttempt
+		var warnings Warnings
+		attempt, warnings, err = actor.uploadPackage(config, archivePath, progressBar, eventStream)
+		warningsStream <- warnings
+		state.Attempts = append(state.Attempts, attempt)
+
+		if err != nil {
+			actor.saveUploadState(state)
+		}
+		if !isRetryableUploadError(err) {
+			break
+		}
+		eventStream <- RetryUpload
+	}
+
+	if isRetryableUploadError(err) {
+		return state, UploadFailedError{Err: err}
+	}
+	return state, err
+}
+
+// verifyUpload downloads the package the Cloud Controller stores for the
+// config's application and checks that it has every pushed file with the
+// SHA1 it was pushed with. The package cannot be verified without being
+// downloaded, so failing to download it fails the upload.
+func (actor Actor) verifyUpload(config ApplicationConfig, progressBar ProgressBar, warningsStream chan<- Warnings) error {
+	log.Info("verifying uploaded package")
+	pkg, err := ioutil.TempFile("", "cf-cli-package-")
+	if err != nil {
+		log.Errorln("creating temp file for package:", err)
+		return err
+	}
+	defer os.Remove(pkg.Name())
+	defer pkg.Close()
+
+	packageSum := sha256.New()
+	warnings, err := actor.V2Actor.DownloadApplicationPackage(config.DesiredApplication.GUID, io.MultiWriter(pkg, packageSum))
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		log.Errorln("downloading uploaded package:", err)
+		return err
+	}
+
+	packaged, err := zipFileSHA1s(pkg)
+	if err != nil {
+		log.Errorln("reading uploaded package:", err)
+		return err
+	}
+
+	pushed := pushedFiles(config.AllResources)
+	var mismatched []string
+	for _, resource := range pushed {
+		if packaged[resource.Filename] != resource.SHA1 {
+			mismatched = append(mismatched, resource.Filename)
+		}
+	}
+	if len(mismatched) > 0 {
+		log.WithField("files", mismatched).Error("uploaded package does not match the pushed files")
+		return UploadChecksumError{Files: mismatched}
+	}
+
+	progressBar.Verified(len(pushed), resourcesSize(pushed), fmt.Sprintf("%x", packageSum.Sum(nil)))
+	return nil
+}
+
 func (actor Actor) UploadPackage(config ApplicationConfig, archivePath string, progressbar ProgressBar, eventStream chan<- Event) (Warnings, error) {
+	_, warnings, err := actor.uploadPackage(config, archivePath, progressbar, eventStream)
+	return warnings, err
+}
+
+func (actor Actor) uploadPackage(config ApplicationConfig, archivePath string, progressbar ProgressBar, eventStream chan<- Event) (UploadAttempt, Warnings, error) {
 	log.Info("uploading archive")
 	archive, err := os.Open(archivePath)
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("opening temp archive:", err)
-		return nil, err
+		return UploadAttempt{}, nil, err
 	}
 	defer archive.Close()
 
 	archiveInfo, err := archive.Stat()
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("stat temp archive:", err)
-		return nil, err
+		return UploadAttempt{}, nil, err
 	}
 
 	log.WithFields(log.Fields{
@@ -62,20 +361,108 @@ func (actor Actor) UploadPackage(config ApplicationConfig, archivePath string, p
 	}).Debug("uploading app bits")
 
 	eventStream <- UploadingApplication
-	reader := progressbar.NewProgressBarWrapper(archive, archiveInfo.Size())
+	sent := &countingReader{reader: archive}
+	reader := progressbar.NewProgressBarWrapper(sent, archiveInfo.Size())
 
 	var allWarnings Warnings
+	startTime := time.Now()
 	// change to look at matched resoruces
 	job, warnings, err := actor.V2Actor.UploadApplicationPackage(config.DesiredApplication.GUID, config.MatchedResources, reader, archiveInfo.Size())
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 
+	attempt := UploadAttempt{Bytes: sent.bytes, Duration: time.Since(startTime)}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	progressbar.AttemptComplete(attempt.Bytes, attempt.Duration, err)
+
 	if err != nil {
 		log.WithField("archivePath", archivePath).Errorln("streaming archive:", err)
-		return allWarnings, err
+		return attempt, allWarnings, err
 	}
+
 	eventStream <- UploadComplete
 	warnings, err = actor.V2Actor.PollJob(job)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 
-	return allWarnings, err
+	return attempt, allWarnings, err
+}
+
+func isRetryableUploadError(err error) bool {
+	switch err.(type) {
+	case ccerror.PipeSeekError, ccerror.RequestError:
+		return true
+	}
+	return false
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	reader io.Reader
+	bytes  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.bytes += int64(n)
+	return n, err
+}
+
+// matchableResources returns the files in resources that the Cloud
+// Controller stores in its resource pool once they are uploaded.
+func matchableResources(resources []v2action.Resource) []v2action.Resource {
+	var matchable []v2action.Resource
+	for _, resource := range pushedFiles(resources) {
+		if resource.Size < MinimumMatchableResourceSize || resource.Size > MaximumMatchableResourceSize {
+			continue
+		}
+		matchable = append(matchable, resource)
+	}
+	return matchable
+}
+
+// pushedFiles returns the files in resources, leaving out directories.
+func pushedFiles(resources []v2action.Resource) []v2action.Resource {
+	var files []v2action.Resource
+	for _, resource := range resources {
+		if resource.Mode.IsDir() || resource.SHA1 == "" {
+			continue
+		}
+		files = append(files, resource)
+	}
+	return files
+}
+
+// zipFileSHA1s returns the SHA1 of every file in the zip archive, keyed by
+// its name in the archive.
+func zipFileSHA1s(archive *os.File) (map[string]string, error) {
+	info, err := archive.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := zip.NewReader(archive, info.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	sums := map[string]string{}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		contents, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		sum := sha1.New()
+		_, err = io.Copy(sum, contents)
+		contents.Close()
+		if err != nil {
+			return nil, err
+		}
+		sums[file.Name] = fmt.Sprintf("%x", sum.Sum(nil))
+	}
+	return sums, nil
 }
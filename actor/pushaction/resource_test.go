@@ -1,15 +1,24 @@
 package pushaction_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
-	"strings"
+	"path/filepath"
+	"syscall"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -228,16 +237,23 @@ var _ = Describe("Resources", func() {
 
 			Context("when the upload is successful", func() {
 				var (
-					progressBarReader io.Reader
-					uploadJob         v2action.Job
+					uploadJob    v2action.Job
+					uploadedBits string
 				)
 
 				BeforeEach(func() {
 					uploadJob.GUID = "some-job-guid"
-					fakeV2Actor.UploadApplicationPackageReturns(uploadJob, v2action.Warnings{"upload-warning-1", "upload-warning-2"}, nil)
-
-					progressBarReader = strings.NewReader("123456")
-					fakeProgressBar.NewProgressBarWrapperReturns(progressBarReader)
+					uploadedBits = ""
+					fakeV2Actor.UploadApplicationPackageStub = func(_ string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+						raw, err := ioutil.ReadAll(reader)
+						Expect(err).ToNot(HaveOccurred())
+						uploadedBits = string(raw)
+						return uploadJob, v2action.Warnings{"upload-warning-1", "upload-warning-2"}, nil
+					}
+
+					fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+						return reader
+					}
 
 					go func() {
 						defer GinkgoRecover()
@@ -261,6 +277,7 @@ var _ = Describe("Resources", func() {
 						Expect(appGUID).To(Equal("some-app-guid"))
 						Expect(existingResources).To(Equal(resources))
 						Expect(newResourcesLength).To(BeNumerically("==", 6))
+						Expect(uploadedBits).To(Equal("123456"))
 
 						Expect(fakeV2Actor.PollJobCallCount()).To(Equal(1))
 						Expect(fakeV2Actor.PollJobArgsForCall(0)).To(Equal(uploadJob))
@@ -273,6 +290,15 @@ var _ = Describe("Resources", func() {
 						_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
 						Expect(size).To(BeNumerically("==", 6))
 					})
+
+					It("reports the attempt to the progress bar", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeProgressBar.AttemptCompleteCallCount()).To(Equal(1))
+						bytes, _, err := fakeProgressBar.AttemptCompleteArgsForCall(0)
+						Expect(bytes).To(BeNumerically("==", 6))
+						Expect(err).ToNot(HaveOccurred())
+					})
 				})
 
 				Context("when the polling fails", func() {
@@ -320,6 +346,13 @@ var _ = Describe("Resources", func() {
 					Expect(executeErr).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("upload-warning-1", "upload-warning-2"))
 				})
+
+				It("reports the failed attempt to the progress bar", func() {
+					Eventually(done).Should(Receive())
+					Expect(fakeProgressBar.AttemptCompleteCallCount()).To(Equal(1))
+					_, _, err := fakeProgressBar.AttemptCompleteArgsForCall(0)
+					Expect(err).To(MatchError(expectedErr))
+				})
 			})
 		})
 
@@ -330,4 +363,518 @@ var _ = Describe("Resources", func() {
 			})
 		})
 	})
+
+	Describe("NextUploadChunk", func() {
+		var (
+			config       ApplicationConfig
+			oldChunkSize int64
+		)
+
+		BeforeEach(func() {
+			oldChunkSize = UploadChunkSize
+			UploadChunkSize = 2 * MinimumMatchableResourceSize
+
+			config = ApplicationConfig{
+				UnmatchedResources: []v2action.Resource{
+					{Filename: "dir", Mode: os.ModeDir | 0755},
+					{Filename: "small-file", SHA1: "sha-small", Size: 6},
+					{Filename: "file-1", SHA1: "sha-1", Size: MinimumMatchableResourceSize},
+					{Filename: "file-2", SHA1: "sha-2", Size: MinimumMatchableResourceSize},
+					{Filename: "file-3", SHA1: "sha-3", Size: MinimumMatchableResourceSize},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			UploadChunkSize = oldChunkSize
+		})
+
+		It("returns files the Cloud Controller can resource match up to the chunk size", func() {
+			Expect(NextUploadChunk(config)).To(Equal([]v2action.Resource{
+				{Filename: "file-1", SHA1: "sha-1", Size: MinimumMatchableResourceSize},
+				{Filename: "file-2", SHA1: "sha-2", Size: MinimumMatchableResourceSize},
+			}))
+		})
+
+		Context("when a file is too large to be resource matched", func() {
+			BeforeEach(func() {
+				config.UnmatchedResources[2].Size = MaximumMatchableResourceSize + 1
+				UploadChunkSize = MinimumMatchableResourceSize
+			})
+
+			It("leaves it out of the chunk", func() {
+				Expect(NextUploadChunk(config)).To(Equal([]v2action.Resource{
+					{Filename: "file-2", SHA1: "sha-2", Size: MinimumMatchableResourceSize},
+				}))
+			})
+		})
+
+		Context("when the files that can be resource matched fit in one upload", func() {
+			BeforeEach(func() {
+				config.UnmatchedResources = config.UnmatchedResources[:4]
+			})
+
+			It("returns nil", func() {
+				Expect(NextUploadChunk(config)).To(BeNil())
+			})
+		})
+	})
+
+	Describe("UploadResources", func() {
+		var (
+			config          ApplicationConfig
+			fakeProgressBar *pushactionfakes.FakeProgressBar
+			eventStream     chan Event
+			warningsStream  chan Warnings
+			stateDir        string
+			oldChunkSize    int64
+			packagedFiles   map[string]string
+			packageBytes    []byte
+
+			returnedConfig ApplicationConfig
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			oldChunkSize = UploadChunkSize
+			UploadChunkSize = MinimumMatchableResourceSize
+
+			var err error
+			stateDir, err = ioutil.TempDir("", "upload-state")
+			Expect(err).ToNot(HaveOccurred())
+			actor.UploadStateDir = stateDir
+
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+				},
+				TargetedSpaceGUID: "some-space-guid",
+				AllResources: []v2action.Resource{
+					{Filename: "some-dir", Mode: v2action.DefaultFolderPermissions | os.ModeDir},
+					{Filename: "some-dir/file-1", SHA1: contentSHA1("contents-1"), Size: MinimumMatchableResourceSize},
+					{Filename: "some-dir/file-2", SHA1: contentSHA1("contents-2"), Size: MinimumMatchableResourceSize},
+					{Filename: "file-3", SHA1: contentSHA1("contents-3"), Size: MinimumMatchableResourceSize},
+				},
+			}
+			config.UnmatchedResources = config.AllResources
+
+			packagedFiles = map[string]string{
+				"some-dir/file-1": "contents-1",
+				"some-dir/file-2": "contents-2",
+				"file-3":          "contents-3",
+			}
+
+			fakeProgressBar = new(pushactionfakes.FakeProgressBar)
+			fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+				return reader
+			}
+			eventStream = make(chan Event, 100)
+			warningsStream = make(chan Warnings, 100)
+
+			fakeV2Actor.ZipDirectoryResourcesStub = func(string, []v2action.Resource) (string, error) {
+				tmpfile, err := ioutil.TempFile("", "fake-archive")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tmpfile.Write([]byte("123456"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tmpfile.Close()).ToNot(HaveOccurred())
+				return tmpfile.Name(), nil
+			}
+			fakeV2Actor.UploadApplicationPackageStub = func(_ string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+				_, err := ioutil.ReadAll(reader)
+				Expect(err).ToNot(HaveOccurred())
+				return v2action.Job{}, v2action.Warnings{"upload-warning"}, nil
+			}
+			fakeV2Actor.CreateApplicationReturns(
+				v2action.Application{GUID: "scratch-app-guid", Name: "some-app-upload-some-words"},
+				v2action.Warnings{"create-app-warning"},
+				nil,
+			)
+			fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-app-warning"}, nil)
+
+			// The chunks uploaded so far are matched after every chunk.
+			fakeV2Actor.ResourceMatchStub = func(resources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
+				matched := 1 + fakeV2Actor.ResourceMatchCallCount()
+				return resources[:matched], resources[matched:], v2action.Warnings{"resource-match-warning"}, nil
+			}
+
+			fakeV2Actor.DownloadApplicationPackageStub = func(_ string, writer io.Writer) (v2action.Warnings, error) {
+				buffer := new(bytes.Buffer)
+				archive := zip.NewWriter(buffer)
+				_, err := archive.Create("some-dir/")
+				Expect(err).ToNot(HaveOccurred())
+				for name, contents := range packagedFiles {
+					file, err := archive.Create(name)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = file.Write([]byte(contents))
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(archive.Close()).To(Succeed())
+
+				packageBytes = buffer.Bytes()
+				_, err = writer.Write(packageBytes)
+				Expect(err).ToNot(HaveOccurred())
+				return v2action.Warnings{"download-warning"}, nil
+			}
+		})
+
+		AfterEach(func() {
+			UploadChunkSize = oldChunkSize
+			Expect(os.RemoveAll(stateDir)).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			returnedConfig, executeErr = actor.UploadResources(config, fakeProgressBar, eventStream, warningsStream)
+		})
+
+		stateFiles := func() []string {
+			files, err := ioutil.ReadDir(stateDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+			return names
+		}
+
+		It("uploads the chunks to a scratch app, resource matching after every chunk", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
+			scratchApp := fakeV2Actor.CreateApplicationArgsForCall(0)
+			Expect(scratchApp.Name).To(HavePrefix("some-app-upload-"))
+			Expect(scratchApp.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(scratchApp.State).To(Equal(ccv2.ApplicationStopped))
+
+			Expect(fakeV2Actor.ZipDirectoryResourcesCallCount()).To(Equal(3))
+			_, chunk := fakeV2Actor.ZipDirectoryResourcesArgsForCall(0)
+			Expect(chunk).To(Equal(config.AllResources[1:2]))
+			_, chunk = fakeV2Actor.ZipDirectoryResourcesArgsForCall(1)
+			Expect(chunk).To(Equal(config.AllResources[2:3]))
+			_, rest := fakeV2Actor.ZipDirectoryResourcesArgsForCall(2)
+			Expect(rest).To(Equal(config.AllResources[3:]))
+
+			Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(3))
+			appGUID, matched, _, _ := fakeV2Actor.UploadApplicationPackageArgsForCall(0)
+			Expect(appGUID).To(Equal("scratch-app-guid"))
+			Expect(matched).To(BeEmpty())
+			appGUID, _, _, _ = fakeV2Actor.UploadApplicationPackageArgsForCall(1)
+			Expect(appGUID).To(Equal("scratch-app-guid"))
+			appGUID, matched, _, _ = fakeV2Actor.UploadApplicationPackageArgsForCall(2)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(matched).To(Equal(config.AllResources[:3]))
+			Expect(returnedConfig.UnmatchedResources).To(Equal(config.AllResources[3:]))
+
+			Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("scratch-app-guid"))
+		})
+
+		It("verifies every pushed file against the package the Cloud Controller stores", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeV2Actor.DownloadApplicationPackageCallCount()).To(Equal(1))
+			appGUID, _ := fakeV2Actor.DownloadApplicationPackageArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(fakeProgressBar.VerifiedCallCount()).To(Equal(1))
+			files, size, packageSHA256 := fakeProgressBar.VerifiedArgsForCall(0)
+			Expect(files).To(Equal(3))
+			Expect(size).To(Equal(3 * MinimumMatchableResourceSize))
+			Expect(packageSHA256).To(Equal(fmt.Sprintf("%x", sha256.Sum256(packageBytes))))
+
+			Expect(warningsStream).To(Receive(ConsistOf("create-app-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("upload-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("resource-match-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("upload-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("resource-match-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("delete-app-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("upload-warning")))
+			Expect(warningsStream).To(Receive(ConsistOf("download-warning")))
+		})
+
+		It("removes the upload state once the upload succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(stateFiles()).To(BeEmpty())
+		})
+
+		Context("when the scratch app cannot be created", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateApplicationReturns(v2action.Application{}, v2action.Warnings{"create-app-warning"}, errors.New("app quota reached"))
+				fakeV2Actor.ResourceMatchStub = func(resources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
+					return resources, nil, nil, nil
+				}
+			})
+
+			It("uploads all the files to the app at once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(1))
+				appGUID, _, _, _ := fakeV2Actor.UploadApplicationPackageArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				_, resources := fakeV2Actor.ZipDirectoryResourcesArgsForCall(0)
+				Expect(resources).To(Equal(config.AllResources))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an uploaded chunk is not resource matched", func() {
+			BeforeEach(func() {
+				fakeV2Actor.ResourceMatchStub = func(resources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
+					return nil, resources, nil, nil
+				}
+			})
+
+			It("uploads the rest of the files to the app at once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(2))
+				_, rest := fakeV2Actor.ZipDirectoryResourcesArgsForCall(1)
+				Expect(rest).To(Equal(config.AllResources))
+				appGUID, _, _, _ := fakeV2Actor.UploadApplicationPackageArgsForCall(1)
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeProgressBar.VerifiedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the package the Cloud Controller stores does not match the pushed files", func() {
+			BeforeEach(func() {
+				UploadChunkSize = 100 * MinimumMatchableResourceSize
+				packagedFiles["some-dir/file-2"] = "changed-contents"
+				delete(packagedFiles, "file-3")
+			})
+
+			It("returns an UploadChecksumError and keeps the state of the upload", func() {
+				Expect(executeErr).To(MatchError(UploadChecksumError{Files: []string{"some-dir/file-2", "file-3"}}))
+				Expect(fakeProgressBar.VerifiedCallCount()).To(Equal(0))
+				Expect(stateFiles()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the package cannot be downloaded", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				UploadChunkSize = 100 * MinimumMatchableResourceSize
+				expectedErr = errors.New("some-download-error")
+				fakeV2Actor.DownloadApplicationPackageStub = nil
+				fakeV2Actor.DownloadApplicationPackageReturns(v2action.Warnings{"download-warning"}, expectedErr)
+			})
+
+			It("returns the error and keeps the state of the upload", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeProgressBar.VerifiedCallCount()).To(Equal(0))
+				Expect(stateFiles()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the package is not a zip archive", func() {
+			BeforeEach(func() {
+				UploadChunkSize = 100 * MinimumMatchableResourceSize
+				fakeV2Actor.DownloadApplicationPackageStub = func(_ string, writer io.Writer) (v2action.Warnings, error) {
+					_, err := writer.Write([]byte("not-a-zip"))
+					return nil, err
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeProgressBar.VerifiedCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the scratch app cannot be deleted", func() {
+			BeforeEach(func() {
+				fakeV2Actor.DeleteApplicationReturns(nil, errors.New("some-delete-error"))
+			})
+
+			It("records the scratch app for a later push to delete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				files := stateFiles()
+				Expect(files).To(HaveLen(1))
+				raw, err := ioutil.ReadFile(filepath.Join(stateDir, files[0]))
+				Expect(err).ToNot(HaveOccurred())
+				var state UploadState
+				Expect(json.Unmarshal(raw, &state)).To(Succeed())
+				Expect(state.ScratchAppGUID).To(Equal("scratch-app-guid"))
+				Expect(state.Attempts).To(BeEmpty())
+			})
+		})
+
+		Context("when an earlier push left a scratch app behind", func() {
+			BeforeEach(func() {
+				raw, err := json.Marshal(UploadState{
+					AppGUID:        "some-app-guid",
+					ArchiveKey:     v2action.ArchiveKey(config.AllResources),
+					ScratchAppGUID: "leftover-app-guid",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				path := filepath.Join(stateDir, fmt.Sprintf("some-app-guid-%s.json", v2action.ArchiveKey(config.AllResources)))
+				Expect(ioutil.WriteFile(path, raw, 0600)).To(Succeed())
+			})
+
+			It("deletes it before uploading", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("leftover-app-guid"))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(1)).To(Equal("scratch-app-guid"))
+				Expect(eventStream).ToNot(Receive(Equal(ResumingUpload)))
+				Expect(stateFiles()).To(BeEmpty())
+			})
+
+			Context("when it cannot be deleted", func() {
+				BeforeEach(func() {
+					fakeV2Actor.DeleteApplicationReturns(nil, errors.New("some-delete-error"))
+				})
+
+				It("keeps it recorded and uploads without chunks", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(0))
+					Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(1))
+					Expect(stateFiles()).To(HaveLen(1))
+				})
+			})
+
+			Context("when it no longer exists", func() {
+				BeforeEach(func() {
+					fakeV2Actor.DeleteApplicationReturnsOnCall(0, nil, v2action.ApplicationNotFoundError{GUID: "leftover-app-guid"})
+				})
+
+				It("forgets it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
+					Expect(stateFiles()).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the push is interrupted while uploading chunks", func() {
+			var (
+				exitCodes      chan int
+				restoreSignals func()
+				restoreExit    func()
+			)
+
+			BeforeEach(func() {
+				exitCodes = make(chan int, 1)
+				restoreSignals = SetInterruptSignals(syscall.SIGUSR1)
+				restoreExit = SetExit(func(code int) {
+					exitCodes <- code
+				})
+
+				fakeV2Actor.UploadApplicationPackageStub = func(_ string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+					_, err := ioutil.ReadAll(reader)
+					Expect(err).ToNot(HaveOccurred())
+					if fakeV2Actor.UploadApplicationPackageCallCount() == 1 {
+						Expect(syscall.Kill(os.Getpid(), syscall.SIGUSR1)).To(Succeed())
+						Eventually(exitCodes).Should(Receive(Equal(2)))
+					}
+					return v2action.Job{}, nil, nil
+				}
+			})
+
+			AfterEach(func() {
+				restoreSignals()
+				restoreExit()
+			})
+
+			It("deletes the scratch app before exiting", func() {
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(BeNumerically(">=", 1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("scratch-app-guid"))
+			})
+		})
+
+		Context("when the final upload fails after the chunks were uploaded", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-upload-error")
+				fakeV2Actor.UploadApplicationPackageStub = func(appGUID string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+					if appGUID == "some-app-guid" {
+						return v2action.Job{}, nil, expectedErr
+					}
+					_, err := ioutil.ReadAll(reader)
+					Expect(err).ToNot(HaveOccurred())
+					return v2action.Job{}, nil, nil
+				}
+			})
+
+			It("deletes the scratch app and keeps the state of the upload", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+
+				files := stateFiles()
+				Expect(files).To(HaveLen(1))
+				Expect(files[0]).To(HavePrefix("some-app-guid-"))
+
+				raw, err := ioutil.ReadFile(filepath.Join(stateDir, files[0]))
+				Expect(err).ToNot(HaveOccurred())
+				var state UploadState
+				Expect(json.Unmarshal(raw, &state)).To(Succeed())
+				Expect(state.Attempts).To(HaveLen(3))
+				Expect(state.Attempts[2].Error).To(Equal("some-upload-error"))
+				Expect(state.ScratchAppGUID).To(BeEmpty())
+			})
+
+			Context("when the bits are pushed again", func() {
+				var resumedErr error
+
+				JustBeforeEach(func() {
+					fakeV2Actor.UploadApplicationPackageStub = func(_ string, _ []v2action.Resource, reader io.Reader, _ int64) (v2action.Job, v2action.Warnings, error) {
+						_, err := ioutil.ReadAll(reader)
+						Expect(err).ToNot(HaveOccurred())
+						return v2action.Job{}, nil, nil
+					}
+					fakeV2Actor.ResourceMatchStub = func(resources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
+						return resources, nil, nil, nil
+					}
+
+					for len(eventStream) > 0 {
+						<-eventStream
+					}
+
+					// The push resource matches the chunks the Cloud Controller
+					// already has before uploading.
+					resumedConfig := config
+					resumedConfig.MatchedResources = config.AllResources[:3]
+					resumedConfig.UnmatchedResources = config.AllResources[3:]
+					_, resumedErr = actor.UploadResources(resumedConfig, fakeProgressBar, eventStream, warningsStream)
+				})
+
+				It("reports that it is resuming and only uploads the files that are left", func() {
+					Expect(resumedErr).ToNot(HaveOccurred())
+					Expect(eventStream).To(Receive(Equal(ResumingUpload)))
+
+					Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.ZipDirectoryResourcesCallCount()).To(Equal(4))
+					_, resources := fakeV2Actor.ZipDirectoryResourcesArgsForCall(3)
+					Expect(resources).To(Equal(config.AllResources[3:]))
+					Expect(stateFiles()).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the upload keeps failing with a request error", func() {
+			BeforeEach(func() {
+				UploadChunkSize = 100 * MinimumMatchableResourceSize
+				fakeV2Actor.UploadApplicationPackageStub = nil
+				fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, nil, ccerror.RequestError{Err: errors.New("connection reset")})
+			})
+
+			It("retries and then returns an UploadFailedError", func() {
+				Expect(executeErr).To(MatchError(UploadFailedError{Err: ccerror.RequestError{Err: errors.New("connection reset")}}))
+				Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(PushRetries))
+				Expect(fakeProgressBar.AttemptCompleteCallCount()).To(Equal(PushRetries))
+				Expect(stateFiles()).To(HaveLen(1))
+			})
+		})
+	})
 })
+
+func contentSHA1(contents string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(contents)))
+}
@@ -1,10 +1,25 @@
 package pushaction
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/sirupsen/logrus"
 )
 
+// NoMatchingDomainError is returned when a route does not match any of the
+// domains accessible to the organization.
+type NoMatchingDomainError struct {
+	Route string
+}
+
+func (e NoMatchingDomainError) Error() string {
+	return fmt.Sprintf("no matching domain found for route %s", e.Route)
+}
+
 func (actor Actor) BindRoutes(config ApplicationConfig) (ApplicationConfig, bool, Warnings, error) {
 	log.Info("binding routes")
 
@@ -31,6 +46,29 @@ func (actor Actor) BindRoutes(config ApplicationConfig) (ApplicationConfig, bool
 	return config, boundRoutes, allWarnings, nil
 }
 
+// UnbindRoutes unbinds all the current routes from the application.
+func (actor Actor) UnbindRoutes(config ApplicationConfig) (ApplicationConfig, bool, Warnings, error) {
+	log.Info("unbinding routes")
+
+	var unboundRoutes bool
+	var allWarnings Warnings
+
+	for _, route := range config.CurrentRoutes {
+		log.Debugf("unbinding route: %#v", route)
+		warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, config.DesiredApplication.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("unbinding route:", err)
+			return ApplicationConfig{}, false, allWarnings, err
+		}
+		unboundRoutes = true
+	}
+	log.Debug("unbinding routes complete")
+	config.CurrentRoutes = nil
+
+	return config, unboundRoutes, allWarnings, nil
+}
+
 func (actor Actor) getDefaultRoute(orgGUID string, spaceGUID string, appName string) (v2action.Route, Warnings, error) {
 	defaultDomain, domainWarnings, err := actor.DefaultDomain(orgGUID)
 	if err != nil {
@@ -137,15 +175,99 @@ func (actor Actor) GetRouteWithDefaultDomain(host string, orgGUID string, spaceG
 		SpaceGUID: spaceGUID,
 	}
 
-	if cachedRoute, found := actor.routeInListBySettings(defaultRoute, knownRoutes); !found {
-		route, routeWarnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
-		if _, ok := err.(v2action.RouteNotFoundError); ok {
-			return defaultRoute, append(Warnings(warnings), routeWarnings...), nil
+	route, routeWarnings, err := actor.findOrReturnPartialRoute(defaultRoute, knownRoutes)
+	return route, append(Warnings(warnings), routeWarnings...), err
+}
+
+// CalculateRoutes converts the provided routes, for example
+// 'host.domain.com/path' or 'tcp.domain.com:1234', into routes on the
+// organization's domains. Routes that do not exist yet are returned as partial
+// routes (ie no GUID).
+func (actor Actor) CalculateRoutes(routes []string, orgGUID string, spaceGUID string, knownRoutes []v2action.Route) ([]v2action.Route, Warnings, error) {
+	log.Infoln("calculating routes for org GUID:", orgGUID)
+	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		log.Errorln("searching for domains in org:", err)
+		return nil, allWarnings, err
+	}
+
+	var calculatedRoutes []v2action.Route
+	for _, route := range routes {
+		partialRoute, err := actor.parseRoute(route, domains, spaceGUID)
+		if err != nil {
+			log.Errorln("parsing route:", err)
+			return nil, allWarnings, err
+		}
+
+		calculatedRoute, routeWarnings, err := actor.findOrReturnPartialRoute(partialRoute, knownRoutes)
+		allWarnings = append(allWarnings, routeWarnings...)
+		if err != nil {
+			log.Errorln("route lookup:", err)
+			return nil, allWarnings, err
+		}
+		calculatedRoutes = append(calculatedRoutes, calculatedRoute)
+	}
+
+	return calculatedRoutes, allWarnings, nil
+}
+
+// HostAndDomainRoutes returns a route for every combination of the manifest
+// hosts and domains. Without hosts the application name is the host, without
+// domains the default domain is used and with no-hostname the routes have no
+// host.
+func (actor Actor) HostAndDomainRoutes(app manifest.Application, orgGUID string, spaceGUID string, knownRoutes []v2action.Route) ([]v2action.Route, Warnings, error) {
+	log.Infoln("calculating host and domain routes for org GUID:", orgGUID)
+	orgDomains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		log.Errorln("searching for domains in org:", err)
+		return nil, allWarnings, err
+	}
+
+	var domains []v2action.Domain
+	for _, name := range app.Domains {
+		domain, found := actor.domainInListByName(name, orgDomains)
+		if !found {
+			log.Errorln("domain not found:", name)
+			return nil, allWarnings, NoMatchingDomainError{Route: name}
+		}
+		domains = append(domains, domain)
+	}
+	if len(domains) == 0 {
+		if len(orgDomains) == 0 {
+			log.Error("no domains found")
+			return nil, allWarnings, NoDomainsFoundError{OrganizationGUID: orgGUID}
+		}
+		domains = orgDomains[:1]
+	}
+
+	hosts := app.Hosts
+	switch {
+	case app.NoHostname:
+		hosts = []string{""}
+	case len(hosts) == 0:
+		hosts = []string{app.Name}
+	}
+
+	var routes []v2action.Route
+	for _, domain := range domains {
+		for _, host := range hosts {
+			route, routeWarnings, err := actor.findOrReturnPartialRoute(v2action.Route{
+				Domain:    domain,
+				Host:      host,
+				SpaceGUID: spaceGUID,
+			}, knownRoutes)
+			allWarnings = append(allWarnings, routeWarnings...)
+			if err != nil {
+				log.Errorln("route lookup:", err)
+				return nil, allWarnings, err
+			}
+			routes = append(routes, route)
 		}
-		return route, append(Warnings(warnings), routeWarnings...), err
-	} else {
-		return cachedRoute, Warnings(warnings), nil
 	}
+
+	return routes, allWarnings, nil
 }
 
 func (actor Actor) BindRouteToApp(route v2action.Route, appGUID string) (v2action.Warnings, error) {
@@ -156,6 +278,78 @@ func (actor Actor) BindRouteToApp(route v2action.Route, appGUID string) (v2actio
 	return warnings, err
 }
 
+// findOrReturnPartialRoute returns the matching route from knownRoutes or the
+// space. If the route does not exist, the provided partial route is returned.
+func (actor Actor) findOrReturnPartialRoute(route v2action.Route, knownRoutes []v2action.Route) (v2action.Route, Warnings, error) {
+	if cachedRoute, found := actor.routeInListBySettings(route, knownRoutes); found {
+		return cachedRoute, nil, nil
+	}
+
+	foundRoute, warnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(route)
+	if _, ok := err.(v2action.RouteNotFoundError); ok {
+		return route, Warnings(warnings), nil
+	}
+	return foundRoute, Warnings(warnings), err
+}
+
+// parseRoute splits the route into host, domain, port and path. The longest
+// domain that the route ends with is used.
+func (Actor) parseRoute(route string, domains []v2action.Domain, spaceGUID string) (v2action.Route, error) {
+	hostAndDomain := route
+	var path string
+	if index := strings.Index(route, "/"); index != -1 {
+		hostAndDomain, path = route[:index], route[index:]
+	}
+
+	var port int
+	if index := strings.LastIndex(hostAndDomain, ":"); index != -1 {
+		parsedPort, err := strconv.Atoi(hostAndDomain[index+1:])
+		if err != nil {
+			return v2action.Route{}, NoMatchingDomainError{Route: route}
+		}
+		hostAndDomain, port = hostAndDomain[:index], parsedPort
+	}
+
+	var (
+		matchedDomain v2action.Domain
+		host          string
+		found         bool
+	)
+	for _, domain := range domains {
+		if found && len(domain.Name) <= len(matchedDomain.Name) {
+			continue
+		}
+
+		if hostAndDomain == domain.Name {
+			matchedDomain, host, found = domain, "", true
+		} else if strings.HasSuffix(hostAndDomain, "."+domain.Name) {
+			matchedDomain, host, found = domain, strings.TrimSuffix(hostAndDomain, "."+domain.Name), true
+		}
+	}
+
+	if !found {
+		return v2action.Route{}, NoMatchingDomainError{Route: route}
+	}
+
+	return v2action.Route{
+		Domain:    matchedDomain,
+		Host:      host,
+		Path:      path,
+		Port:      port,
+		SpaceGUID: spaceGUID,
+	}, nil
+}
+
+func (Actor) domainInListByName(name string, domains []v2action.Domain) (v2action.Domain, bool) {
+	for _, domain := range domains {
+		if domain.Name == name {
+			return domain, true
+		}
+	}
+
+	return v2action.Domain{}, false
+}
+
 func (Actor) routeInListByGUID(route v2action.Route, routes []v2action.Route) bool {
 	for _, r := range routes {
 		if r.GUID == route.GUID {
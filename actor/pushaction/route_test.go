@@ -688,4 +688,190 @@ var _ = Describe("Routes", func() {
 			})
 		})
 	})
+
+	Describe("UnbindRoutes", func() {
+		var (
+			config ApplicationConfig
+
+			returnedConfig ApplicationConfig
+			unboundRoutes  bool
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{GUID: "some-app-guid"},
+				},
+				CurrentRoutes: []v2action.Route{
+					{GUID: "some-route-guid-1"},
+					{GUID: "some-route-guid-2"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			returnedConfig, unboundRoutes, warnings, executeErr = actor.UnbindRoutes(config)
+		})
+
+		Context("when unbinding the routes is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, nil)
+			})
+
+			It("unbinds all current routes and returns warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind-warning", "unbind-warning"))
+				Expect(unboundRoutes).To(BeTrue())
+				Expect(returnedConfig.CurrentRoutes).To(BeEmpty())
+
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid-1"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("some-route-guid-2"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when there are no routes to unbind", func() {
+			BeforeEach(func() {
+				config.CurrentRoutes = nil
+			})
+
+			It("does not unbind anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(unboundRoutes).To(BeFalse())
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when unbinding a route errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind-warning"))
+			})
+		})
+	})
+
+	Describe("CalculateRoutes", func() {
+		var (
+			routes      []string
+			knownRoutes []v2action.Route
+
+			calculatedRoutes []v2action.Route
+			warnings         Warnings
+			executeErr       error
+
+			domain      v2action.Domain
+			childDomain v2action.Domain
+		)
+
+		BeforeEach(func() {
+			routes = []string{
+				"some-host.some-domain.com",
+				"some-host.child.some-domain.com/some-path",
+				"child.some-domain.com:1234",
+			}
+			knownRoutes = nil
+
+			domain = v2action.Domain{Name: "some-domain.com", GUID: "some-domain-guid"}
+			childDomain = v2action.Domain{Name: "child.some-domain.com", GUID: "child-domain-guid"}
+			fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{domain, childDomain}, v2action.Warnings{"domain-warning"}, nil)
+			fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"route-warning"}, v2action.RouteNotFoundError{})
+		})
+
+		JustBeforeEach(func() {
+			calculatedRoutes, warnings, executeErr = actor.CalculateRoutes(routes, "some-org-guid", "some-space-guid", knownRoutes)
+		})
+
+		Context("when the routes do not exist", func() {
+			It("returns partial routes on the longest matching domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-warning", "route-warning"))
+				Expect(calculatedRoutes).To(Equal([]v2action.Route{
+					{Host: "some-host", Domain: domain, SpaceGUID: "some-space-guid"},
+					{Host: "some-host", Domain: childDomain, Path: "/some-path", SpaceGUID: "some-space-guid"},
+					{Domain: childDomain, Port: 1234, SpaceGUID: "some-space-guid"},
+				}))
+
+				Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when a route exists in the space", func() {
+			var existingRoute v2action.Route
+
+			BeforeEach(func() {
+				routes = []string{"some-host.some-domain.com"}
+				existingRoute = v2action.Route{GUID: "some-route-guid", Host: "some-host", Domain: domain, SpaceGUID: "some-space-guid"}
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(existingRoute, v2action.Warnings{"route-warning"}, nil)
+			})
+
+			It("returns the existing route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(calculatedRoutes).To(ConsistOf(existingRoute))
+			})
+		})
+
+		Context("when a route is already known", func() {
+			var knownRoute v2action.Route
+
+			BeforeEach(func() {
+				routes = []string{"some-host.some-domain.com"}
+				knownRoute = v2action.Route{GUID: "some-route-guid", Host: "some-host", Domain: domain, SpaceGUID: "some-space-guid"}
+				knownRoutes = []v2action.Route{knownRoute}
+			})
+
+			It("returns the known route without looking it up", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(calculatedRoutes).To(ConsistOf(knownRoute))
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a route does not match any domain", func() {
+			BeforeEach(func() {
+				routes = []string{"some-host.other-domain.com"}
+			})
+
+			It("returns a NoMatchingDomainError", func() {
+				Expect(executeErr).To(MatchError(NoMatchingDomainError{Route: "some-host.other-domain.com"}))
+				Expect(warnings).To(ConsistOf("domain-warning"))
+			})
+		})
+
+		Context("when a route has an invalid port", func() {
+			BeforeEach(func() {
+				routes = []string{"some-domain.com:potato"}
+			})
+
+			It("returns a NoMatchingDomainError", func() {
+				Expect(executeErr).To(MatchError(NoMatchingDomainError{Route: "some-domain.com:potato"}))
+			})
+		})
+
+		Context("when getting the domains errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.GetOrganizationDomainsReturns(nil, v2action.Warnings{"domain-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("domain-warning"))
+			})
+		})
+	})
 })
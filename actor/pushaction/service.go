@@ -0,0 +1,34 @@
+package pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// BindServices binds the desired services that are not bound to the
+// application yet.
+func (actor Actor) BindServices(config ApplicationConfig) (ApplicationConfig, bool, Warnings, error) {
+	log.Info("binding services")
+
+	var boundServices bool
+	var allWarnings Warnings
+
+	for serviceName, serviceInstance := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceName]; ok {
+			log.WithField("service_instance", serviceName).Debug("already bound to app")
+			continue
+		}
+
+		log.WithField("service_instance", serviceName).Debug("binding service instance")
+		warnings, err := actor.V2Actor.BindServiceByApplicationAndServiceInstance(config.DesiredApplication.GUID, serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("binding service instance:", err)
+			return ApplicationConfig{}, false, allWarnings, err
+		}
+		boundServices = true
+	}
+	log.Debug("binding services complete")
+	config.CurrentServices = config.DesiredServices
+
+	return config, boundServices, allWarnings, nil
+}
@@ -0,0 +1,98 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Services", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+	})
+
+	Describe("BindServices", func() {
+		var (
+			config ApplicationConfig
+
+			returnedConfig ApplicationConfig
+			boundServices  bool
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{GUID: "some-app-guid"},
+				},
+				CurrentServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1", GUID: "service-guid-1"},
+				},
+				DesiredServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1", GUID: "service-guid-1"},
+					"service-2": {Name: "service-2", GUID: "service-guid-2"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			returnedConfig, boundServices, warnings, executeErr = actor.BindServices(config)
+		})
+
+		Context("when binding services is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warning"}, nil)
+			})
+
+			It("binds the services that are not bound yet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("bind-service-warning"))
+				Expect(boundServices).To(BeTrue())
+				Expect(returnedConfig.CurrentServices).To(Equal(config.DesiredServices))
+
+				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("service-guid-2"))
+			})
+		})
+
+		Context("when all services are already bound", func() {
+			BeforeEach(func() {
+				config.CurrentServices = config.DesiredServices
+			})
+
+			It("does not bind any services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(boundServices).To(BeFalse())
+				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when binding a service errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("bind-service-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,282 @@
+package pushaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeploymentStrategy is the way an existing application is replaced by a
+// push.
+type DeploymentStrategy string
+
+const (
+	// InPlaceStrategy updates and restarts the existing application.
+	InPlaceStrategy DeploymentStrategy = ""
+	// RollingStrategy pushes a replacement application, moves the routes over
+	// to it once it is healthy and deletes the original application.
+	RollingStrategy DeploymentStrategy = "rolling"
+	// BlueGreenStrategy pushes a replacement application, moves the routes over
+	// to it once it is healthy and keeps the original application stopped
+	// under the venerable name.
+	BlueGreenStrategy DeploymentStrategy = "blue-green"
+)
+
+const (
+	ReplacementApplicationSuffix = "-new"
+	VenerableApplicationSuffix   = "-venerable"
+)
+
+// ReplacementApplicationExistsError is returned when the application a
+// deployment would push into already exists.
+type ReplacementApplicationExistsError struct {
+	Name string
+}
+
+func (e ReplacementApplicationExistsError) Error() string {
+	return fmt.Sprintf("replacement application %s already exists", e.Name)
+}
+
+// PrepareReplacementApplication returns the config for the temporary
+// application that replaces the application in config. The replacement has
+// the desired settings and resources of config but no routes.
+func (actor Actor) PrepareReplacementApplication(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	name := config.DesiredApplication.Name + ReplacementApplicationSuffix
+
+	log.WithField("replacement", name).Info("preparing replacement application")
+	_, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(name, config.TargetedSpaceGUID)
+	if err == nil {
+		return ApplicationConfig{}, Warnings(warnings), ReplacementApplicationExistsError{Name: name}
+	}
+	if _, ok := err.(v2action.ApplicationNotFoundError); !ok {
+		return ApplicationConfig{}, Warnings(warnings), err
+	}
+
+	replacement := config
+	replacement.CurrentApplication = Application{}
+	replacement.DesiredApplication.GUID = ""
+	replacement.DesiredApplication.Name = name
+	replacement.DesiredApplication.State = ccv2.ApplicationStopped
+	replacement.CurrentRoutes = nil
+	replacement.DesiredRoutes = nil
+	replacement.CurrentServices = nil
+
+	return replacement, Warnings(warnings), nil
+}
+
+// DeleteReplacementApplication deletes the replacement application if it was
+// created. It is used to roll back a deployment whose replacement failed to
+// upload, stage or start.
+func (actor Actor) DeleteReplacementApplication(replacement ApplicationConfig) (Warnings, error) {
+	log.WithField("replacement", replacement.DesiredApplication.Name).Info("deleting replacement application")
+	app, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(replacement.DesiredApplication.Name, replacement.TargetedSpaceGUID)
+	if _, ok := err.(v2action.ApplicationNotFoundError); ok {
+		log.Debug("replacement application was never created")
+		return Warnings(warnings), nil
+	} else if err != nil {
+		return Warnings(warnings), err
+	}
+
+	deleteWarnings, err := actor.V2Actor.DeleteApplication(app.GUID)
+	return append(Warnings(warnings), deleteWarnings...), err
+}
+
+// CompleteDeployment moves the routes of the original application to the
+// running replacement application, renames the original to the venerable name
+// and gives the replacement the original name. Depending on the strategy, the
+// original is then deleted or stopped. An existing venerable application is
+// deleted before any routes are moved. If any later step fails, the routes
+// and names are restored, the replacement is deleted and the error is sent
+// after the RolledBack event.
+func (actor Actor) CompleteDeployment(strategy DeploymentStrategy, original ApplicationConfig, replacement ApplicationConfig) (<-chan Event, <-chan Warnings, <-chan error) {
+	eventStream := make(chan Event)
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+
+	go func() {
+		log.Debug("starting complete deployment go routine")
+		defer close(eventStream)
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		var state deploymentState
+		fail := func(err error) {
+			actor.rollbackDeployment(original, replacement, state, eventStream, warningsStream)
+			errorStream <- err
+		}
+
+		warnings, err := actor.deleteVenerableApplication(original, eventStream)
+		warningsStream <- warnings
+		if err != nil {
+			log.Errorln("deleting venerable:", err)
+			fail(err)
+			return
+		}
+
+		eventStream <- MappingReplacementRoutes
+		original, _, warnings, err = actor.CreateRoutes(original)
+		warningsStream <- warnings
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		for _, route := range actor.deploymentRoutes(original) {
+			warnings, err := actor.BindRouteToApp(route, replacement.CurrentApplication.GUID)
+			warningsStream <- Warnings(warnings)
+			if err != nil {
+				log.Errorln("mapping route to replacement:", err)
+				fail(err)
+				return
+			}
+			state.mappedRoutes = append(state.mappedRoutes, route)
+		}
+
+		eventStream <- UnmappingOriginalRoutes
+		for _, route := range original.CurrentRoutes {
+			warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, original.CurrentApplication.GUID)
+			warningsStream <- Warnings(warnings)
+			if err != nil {
+				log.Errorln("unmapping route from original:", err)
+				fail(err)
+				return
+			}
+			state.unmappedRoutes = append(state.unmappedRoutes, route)
+		}
+
+		eventStream <- RenamingOriginalApplication
+		_, v2Warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID: original.CurrentApplication.GUID,
+			Name: original.DesiredApplication.Name + VenerableApplicationSuffix,
+		})
+		warningsStream <- Warnings(v2Warnings)
+		if err != nil {
+			log.Errorln("renaming original:", err)
+			fail(err)
+			return
+		}
+		state.originalRenamed = true
+
+		eventStream <- RenamingReplacementApplication
+		_, v2Warnings, err = actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID: replacement.CurrentApplication.GUID,
+			Name: original.DesiredApplication.Name,
+		})
+		warningsStream <- Warnings(v2Warnings)
+		if err != nil {
+			log.Errorln("renaming replacement:", err)
+			fail(err)
+			return
+		}
+
+		if strategy == BlueGreenStrategy {
+			eventStream <- StoppingOriginalApplication
+			_, v2Warnings, err = actor.V2Actor.UpdateApplication(v2action.Application{
+				GUID:  original.CurrentApplication.GUID,
+				State: ccv2.ApplicationStopped,
+			})
+		} else {
+			eventStream <- DeletingOriginalApplication
+			v2Warnings, err = actor.V2Actor.DeleteApplication(original.CurrentApplication.GUID)
+		}
+		warningsStream <- Warnings(v2Warnings)
+		if err != nil {
+			log.Errorln("retiring original:", err)
+			fail(err)
+			return
+		}
+
+		log.Debug("completed deployment")
+		eventStream <- Complete
+	}()
+
+	return eventStream, warningsStream, errorStream
+}
+
+// deploymentState records the changes made by CompleteDeployment, so that
+// they can be rolled back.
+type deploymentState struct {
+	mappedRoutes    []v2action.Route
+	unmappedRoutes  []v2action.Route
+	originalRenamed bool
+}
+
+// deleteVenerableApplication deletes the application left under the venerable
+// name by a previous deployment, so that the original can be renamed to it.
+func (actor Actor) deleteVenerableApplication(original ApplicationConfig, eventStream chan<- Event) (Warnings, error) {
+	name := original.DesiredApplication.Name + VenerableApplicationSuffix
+	venerable, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(name, original.TargetedSpaceGUID)
+	if _, ok := err.(v2action.ApplicationNotFoundError); ok {
+		return Warnings(warnings), nil
+	} else if err != nil {
+		return Warnings(warnings), err
+	}
+
+	log.WithField("venerable", name).Info("deleting venerable application")
+	eventStream <- DeletingVenerableApplication
+	deleteWarnings, err := actor.V2Actor.DeleteApplication(venerable.GUID)
+	return append(Warnings(warnings), deleteWarnings...), err
+}
+
+// deploymentRoutes returns the routes currently bound to the original
+// application followed by the desired routes that are not bound yet. With
+// no-route the replacement gets no routes.
+func (actor Actor) deploymentRoutes(original ApplicationConfig) []v2action.Route {
+	if original.NoRoute {
+		return nil
+	}
+
+	routes := append([]v2action.Route{}, original.CurrentRoutes...)
+	for _, route := range original.DesiredRoutes {
+		if !actor.routeInListByGUID(route, routes) {
+			routes = append(routes, route)
+		}
+	}
+	return routes
+}
+
+// rollbackDeployment maps the unmapped routes back to the original
+// application, deletes the replacement application and restores the name of
+// the original. Errors are logged and otherwise ignored so that the error
+// that caused the rollback is returned.
+func (actor Actor) rollbackDeployment(original ApplicationConfig, replacement ApplicationConfig, state deploymentState, eventStream chan<- Event, warningsStream chan<- Warnings) {
+	eventStream <- RollingBack
+
+	for _, route := range state.unmappedRoutes {
+		warnings, err := actor.BindRouteToApp(route, original.CurrentApplication.GUID)
+		warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("remapping route to original:", err)
+		}
+	}
+
+	for _, route := range state.mappedRoutes {
+		warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, replacement.CurrentApplication.GUID)
+		warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("unmapping route from replacement:", err)
+		}
+	}
+
+	warnings, err := actor.V2Actor.DeleteApplication(replacement.CurrentApplication.GUID)
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		log.Errorln("deleting replacement:", err)
+	}
+
+	// The replacement is deleted first so that the original name is free.
+	if state.originalRenamed {
+		_, v2Warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID: original.CurrentApplication.GUID,
+			Name: original.DesiredApplication.Name,
+		})
+		warningsStream <- Warnings(v2Warnings)
+		if err != nil {
+			log.Errorln("restoring original name:", err)
+		}
+	}
+
+	eventStream <- RolledBack
+}
@@ -0,0 +1,499 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func deploymentStreamsDrainedAndClosed(eventStream <-chan Event, warningsStream <-chan Warnings, errorStream <-chan error) bool {
+	var eventStreamClosed, warningsStreamClosed, errorStreamClosed bool
+	for {
+		select {
+		case _, ok := <-eventStream:
+			if !ok {
+				eventStreamClosed = true
+			}
+		case _, ok := <-warningsStream:
+			if !ok {
+				warningsStreamClosed = true
+			}
+		case _, ok := <-errorStream:
+			if !ok {
+				errorStreamClosed = true
+			}
+		}
+		if eventStreamClosed && warningsStreamClosed && errorStreamClosed {
+			break
+		}
+	}
+	return true
+}
+
+func discardWarnings(warningsStream <-chan Warnings) {
+	for range warningsStream {
+	}
+}
+
+var _ = Describe("Deployment Strategies", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		config      ApplicationConfig
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+
+		app := Application{
+			Application: v2action.Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				SpaceGUID: "some-space-guid",
+				State:     ccv2.ApplicationStarted,
+			},
+		}
+		config = ApplicationConfig{
+			CurrentApplication: app,
+			DesiredApplication: app,
+			CurrentRoutes:      []v2action.Route{{GUID: "route-guid-1", Host: "some-app"}},
+			DesiredRoutes:      []v2action.Route{{GUID: "route-guid-1", Host: "some-app"}},
+			Path:               "some-path",
+			TargetedSpaceGUID:  "some-space-guid",
+		}
+	})
+
+	Describe("PrepareReplacementApplication", func() {
+		var (
+			replacement ApplicationConfig
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			replacement, warnings, executeErr = actor.PrepareReplacementApplication(config)
+		})
+
+		Context("when the replacement application does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app-new"})
+			})
+
+			It("returns a config that creates a stopped application without routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+
+				Expect(replacement.CreatingApplication()).To(BeTrue())
+				Expect(replacement.DesiredApplication.Name).To(Equal("some-app-new"))
+				Expect(replacement.DesiredApplication.GUID).To(BeEmpty())
+				Expect(replacement.DesiredApplication.SpaceGUID).To(Equal("some-space-guid"))
+				Expect(replacement.DesiredApplication.State).To(Equal(ccv2.ApplicationStopped))
+				Expect(replacement.CurrentRoutes).To(BeEmpty())
+				Expect(replacement.DesiredRoutes).To(BeEmpty())
+				Expect(replacement.Path).To(Equal("some-path"))
+
+				name, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-app-new"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the replacement application already exists", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-other-guid"}, v2action.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns a ReplacementApplicationExistsError", func() {
+				Expect(executeErr).To(MatchError(ReplacementApplicationExistsError{Name: "some-app-new"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		Context("when looking up the replacement application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get app failed")
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteReplacementApplication", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			config.DesiredApplication.Name = "some-app-new"
+			warnings, executeErr = actor.DeleteReplacementApplication(config)
+		})
+
+		Context("when the replacement application was created", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "replacement-guid"}, v2action.Warnings{"get-app-warning"}, nil)
+				fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "delete-warning"))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+			})
+		})
+
+		Context("when the replacement application was never created", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, v2action.ApplicationNotFoundError{})
+			})
+
+			It("does nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CompleteDeployment", func() {
+		var (
+			strategy    DeploymentStrategy
+			replacement ApplicationConfig
+
+			eventStream    <-chan Event
+			warningsStream <-chan Warnings
+			errorStream    <-chan error
+		)
+
+		BeforeEach(func() {
+			config.DesiredRoutes = append(config.DesiredRoutes, v2action.Route{Host: "new-route"})
+			fakeV2Actor.CreateRouteReturns(v2action.Route{GUID: "route-guid-2", Host: "new-route"}, v2action.Warnings{"create-route-warning"}, nil)
+			fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, nil)
+			fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, nil)
+			fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-warning"}, nil)
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+			fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-venerable-warning"}, v2action.ApplicationNotFoundError{Name: "some-app-venerable"})
+
+			replacement = ApplicationConfig{
+				CurrentApplication: Application{Application: v2action.Application{GUID: "replacement-guid", Name: "some-app-new"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			eventStream, warningsStream, errorStream = actor.CompleteDeployment(strategy, config, replacement)
+		})
+
+		AfterEach(func() {
+			Eventually(deploymentStreamsDrainedAndClosed(eventStream, warningsStream, errorStream)).Should(BeTrue())
+		})
+
+		Context("when using the rolling strategy", func() {
+			BeforeEach(func() {
+				strategy = RollingStrategy
+			})
+
+			It("moves the routes, swaps the names and deletes the original", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-venerable-warning")))
+				Eventually(eventStream).Should(Receive(Equal(MappingReplacementRoutes)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-warning")))
+				Eventually(eventStream).Should(Receive(Equal(UnmappingOriginalRoutes)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RenamingOriginalApplication)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RenamingReplacementApplication)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+				Eventually(eventStream).Should(Receive(Equal(DeletingOriginalApplication)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("delete-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				name, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-app-venerable"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid-1"))
+				Expect(appGUID).To(Equal("replacement-guid"))
+				routeGUID, appGUID = fakeV2Actor.BindRouteToApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("route-guid-2"))
+				Expect(appGUID).To(Equal("replacement-guid"))
+
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid-1"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+					GUID: "some-app-guid",
+					Name: "some-app-venerable",
+				}))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+					GUID: "replacement-guid",
+					Name: "some-app",
+				}))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when using the blue-green strategy", func() {
+			BeforeEach(func() {
+				strategy = BlueGreenStrategy
+			})
+
+			JustBeforeEach(func() {
+				go discardWarnings(warningsStream)
+			})
+
+			It("stops the original under the venerable name instead of deleting it", func() {
+				Eventually(eventStream).Should(Receive(Equal(MappingReplacementRoutes)))
+				Eventually(eventStream).Should(Receive(Equal(UnmappingOriginalRoutes)))
+				Eventually(eventStream).Should(Receive(Equal(RenamingOriginalApplication)))
+				Eventually(eventStream).Should(Receive(Equal(RenamingReplacementApplication)))
+				Eventually(eventStream).Should(Receive(Equal(StoppingOriginalApplication)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(3))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+					GUID: "some-app-guid",
+					Name: "some-app-venerable",
+				}))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+					GUID: "replacement-guid",
+					Name: "some-app",
+				}))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(2)).To(Equal(v2action.Application{
+					GUID:  "some-app-guid",
+					State: ccv2.ApplicationStopped,
+				}))
+			})
+
+			Context("when a venerable application exists from a previous deployment", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "venerable-guid"}, v2action.Warnings{"get-venerable-warning"}, nil)
+				})
+
+				It("deletes it before moving any routes", func() {
+					Eventually(eventStream).Should(Receive(Equal(DeletingVenerableApplication)))
+					Eventually(eventStream).Should(Receive(Equal(MappingReplacementRoutes)))
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("venerable-guid"))
+				})
+
+				Context("when deleting it fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("delete venerable failed")
+						fakeV2Actor.DeleteApplicationReturnsOnCall(0, v2action.Warnings{"delete-warning"}, expectedErr)
+					})
+
+					It("deletes the replacement without moving any routes and returns the error", func() {
+						Eventually(eventStream).Should(Receive(Equal(DeletingVenerableApplication)))
+						Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+						Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+						Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+						Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+						Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(0))
+						Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+						Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(2))
+						Expect(fakeV2Actor.DeleteApplicationArgsForCall(1)).To(Equal("replacement-guid"))
+					})
+				})
+			})
+
+			Context("when looking up the venerable application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get venerable failed")
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-venerable-warning"}, expectedErr)
+				})
+
+				It("deletes the replacement without moving any routes and returns the error", func() {
+					Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+					Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+					Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+				})
+			})
+
+			Context("when renaming the original application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("rename failed")
+					fakeV2Actor.UpdateApplicationReturnsOnCall(0, v2action.Application{}, v2action.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("moves the routes back, deletes the replacement and returns the error", func() {
+					Eventually(eventStream).Should(Receive(Equal(RenamingOriginalApplication)))
+					Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+					Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+					Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+					routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(2)
+					Expect(routeGUID).To(Equal("route-guid-1"))
+					Expect(appGUID).To(Equal("some-app-guid"))
+
+					Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(3))
+					routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+					Expect(routeGUID).To(Equal("route-guid-1"))
+					Expect(appGUID).To(Equal("replacement-guid"))
+					routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(2)
+					Expect(routeGUID).To(Equal("route-guid-2"))
+					Expect(appGUID).To(Equal("replacement-guid"))
+
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when renaming the replacement application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("rename failed")
+					fakeV2Actor.UpdateApplicationReturnsOnCall(1, v2action.Application{}, v2action.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("deletes the replacement, restores the original name and returns the error", func() {
+					Eventually(eventStream).Should(Receive(Equal(RenamingReplacementApplication)))
+					Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+					Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+					Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(3))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(2)).To(Equal(v2action.Application{
+						GUID: "some-app-guid",
+						Name: "some-app",
+					}))
+				})
+			})
+
+			Context("when stopping the original application fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("stop failed")
+					fakeV2Actor.UpdateApplicationReturnsOnCall(2, v2action.Application{}, v2action.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("rolls back the whole deployment and returns the error", func() {
+					Eventually(eventStream).Should(Receive(Equal(StoppingOriginalApplication)))
+					Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+					Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+					Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(4))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(3)).To(Equal(v2action.Application{
+						GUID: "some-app-guid",
+						Name: "some-app",
+					}))
+				})
+			})
+		})
+
+		Context("when deleting the original application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				strategy = RollingStrategy
+				expectedErr = errors.New("delete failed")
+				fakeV2Actor.DeleteApplicationReturnsOnCall(0, v2action.Warnings{"delete-warning"}, expectedErr)
+			})
+
+			JustBeforeEach(func() {
+				go discardWarnings(warningsStream)
+			})
+
+			It("rolls back the whole deployment and returns the error", func() {
+				Eventually(eventStream).Should(Receive(Equal(DeletingOriginalApplication)))
+				Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+				Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(1)).To(Equal("replacement-guid"))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(3))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(2)).To(Equal(v2action.Application{
+					GUID: "some-app-guid",
+					Name: "some-app",
+				}))
+			})
+		})
+
+		Context("when unmapping a route from the original application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				strategy = RollingStrategy
+				expectedErr = errors.New("unbind failed")
+				fakeV2Actor.UnbindRouteFromApplicationReturnsOnCall(0, v2action.Warnings{"unbind-warning"}, expectedErr)
+			})
+
+			It("moves the routes back, deletes the replacement and returns the error", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("get-venerable-warning")))
+				Eventually(eventStream).Should(Receive(Equal(MappingReplacementRoutes)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-warning")))
+				Eventually(eventStream).Should(Receive(Equal(UnmappingOriginalRoutes)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RollingBack)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("delete-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RolledBack)))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(3))
+				routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("route-guid-1"))
+				Expect(appGUID).To(Equal("replacement-guid"))
+				routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(2)
+				Expect(routeGUID).To(Equal("route-guid-2"))
+				Expect(appGUID).To(Equal("replacement-guid"))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("replacement-guid"))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package pushaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/sirupsen/logrus"
+)
+
+// UploadState is the local record of the attempts made to upload an
+// application's bits. It is persisted in the actor's UploadStateDir until the
+// upload succeeds. It does not decide what is uploaded: the files an earlier
+// attempt already sent are skipped because the Cloud Controller resource
+// matches them.
+type UploadState struct {
+	AppGUID    string          `json:"app_guid"`
+	ArchiveKey string          `json:"archive_key"`
+	Attempts   []UploadAttempt `json:"attempts"`
+
+	// ScratchAppGUID is the GUID of the scratch application the chunks are
+	// uploaded to while it exists, so that a later push can delete it if this
+	// one could not.
+	ScratchAppGUID string `json:"scratch_app_guid,omitempty"`
+}
+
+// UploadAttempt is a single request made while uploading an application's
+// bits.
+type UploadAttempt struct {
+	Bytes    int64         `json:"bytes"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Resumed returns true if the state was loaded from an earlier upload that
+// did not finish.
+func (state UploadState) Resumed() bool {
+	return len(state.Attempts) > 0
+}
+
+func (actor Actor) uploadStatePath(state UploadState) string {
+	return filepath.Join(actor.UploadStateDir, fmt.Sprintf("%s-%s.json", state.AppGUID, state.ArchiveKey))
+}
+
+// loadUploadState returns the persisted state of the upload of the config's
+// resources, or a new state if there is none.
+func (actor Actor) loadUploadState(config ApplicationConfig) UploadState {
+	state := UploadState{
+		AppGUID:    config.DesiredApplication.GUID,
+		ArchiveKey: v2action.ArchiveKey(config.AllResources),
+	}
+	if actor.UploadStateDir == "" {
+		return state
+	}
+
+	raw, err := ioutil.ReadFile(actor.uploadStatePath(state))
+	if err != nil {
+		return state
+	}
+
+	var persisted UploadState
+	if err := json.Unmarshal(raw, &persisted); err != nil {
+		log.WithField("path", actor.uploadStatePath(state)).Warnln("ignoring invalid upload state:", err)
+		return state
+	}
+	return persisted
+}
+
+// saveUploadState persists state. Failing to persist it only means a later
+// push cannot resume, so errors are logged and otherwise ignored.
+func (actor Actor) saveUploadState(state UploadState) {
+	if actor.UploadStateDir == "" {
+		return
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		log.Warnln("marshalling upload state:", err)
+		return
+	}
+
+	err = os.MkdirAll(actor.UploadStateDir, 0700)
+	if err == nil {
+		err = ioutil.WriteFile(actor.uploadStatePath(state), raw, 0600)
+	}
+	if err != nil {
+		log.WithField("path", actor.uploadStatePath(state)).Warnln("saving upload state:", err)
+	}
+}
+
+// updateUploadState persists state while it records attempts or a scratch
+// application for a later push, and removes it otherwise.
+func (actor Actor) updateUploadState(state UploadState) {
+	if state.Resumed() || state.ScratchAppGUID != "" {
+		actor.saveUploadState(state)
+		return
+	}
+	actor.removeUploadState(state)
+}
+
+func (actor Actor) removeUploadState(state UploadState) {
+	if actor.UploadStateDir == "" {
+		return
+	}
+
+	err := os.Remove(actor.uploadStatePath(state))
+	if err != nil && !os.IsNotExist(err) {
+		log.WithField("path", actor.uploadStatePath(state)).Warnln("removing upload state:", err)
+	}
+}
@@ -1,6 +1,16 @@
 // Package v2action contains the business logic for the commands/v2 package
 package v2action
 
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/pushcache"
+)
+
+// DefaultMaxConcurrentRequests is the number of requests an actor makes at
+// the same time when it looks up several independent resources.
+const DefaultMaxConcurrentRequests = 4
+
 // Warnings is a list of warnings returned back from the cloud controller
 type Warnings []string
 
@@ -10,7 +20,17 @@ type Actor struct {
 	Config                Config
 	UAAClient             UAAClient
 
-	domainCache map[string]Domain
+	// PushCache, if set, caches the SHA1s of gathered files and the archives
+	// zipped from directories.
+	PushCache *pushcache.Cache
+
+	// MaxConcurrentRequests is the number of requests made at the same time
+	// when looking up several independent resources, such as the parts of a
+	// summary. Values less than 1 make the requests one at a time.
+	MaxConcurrentRequests int
+
+	domainCache      map[string]Domain
+	domainCacheMutex *sync.RWMutex
 }
 
 // NewActor returns a new actor.
@@ -19,6 +39,8 @@ func NewActor(ccClient CloudControllerClient, uaaClient UAAClient, config Config
 		CloudControllerClient: ccClient,
 		Config:                config,
 		UAAClient:             uaaClient,
+		MaxConcurrentRequests: DefaultMaxConcurrentRequests,
 		domainCache:           map[string]Domain{},
+		domainCacheMutex:      new(sync.RWMutex),
 	}
 }
@@ -2,10 +2,12 @@ package v2action
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/tracing"
 )
 
 type ApplicationStateChange string
@@ -182,6 +184,25 @@ func (actor Actor) CreateApplication(application Application) (Application, Warn
 	return Application(app), Warnings(warnings), err
 }
 
+// DeleteApplication deletes the application.
+func (actor Actor) DeleteApplication(guid string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplication(guid)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), ApplicationNotFoundError{GUID: guid}
+	}
+	return Warnings(warnings), err
+}
+
+// DownloadApplicationPackage writes the package the Cloud Controller stores
+// for the application to writer.
+func (actor Actor) DownloadApplicationPackage(guid string, writer io.Writer) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DownloadApplicationPackage(guid, writer)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), ApplicationNotFoundError{GUID: guid}
+	}
+	return Warnings(warnings), err
+}
+
 // GetApplication returns the application.
 func (actor Actor) GetApplication(guid string) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.GetApplication(guid)
@@ -423,6 +444,9 @@ func (actor Actor) UpdateApplication(application Application) (Application, Warn
 }
 
 func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<- string) error {
+	span := tracing.Current().StartInternal("wait for staging")
+	defer span.Finish()
+
 	timeout := time.Now().Add(config.StagingTimeout())
 	for time.Now().Before(timeout) {
 		currentApplication, warnings, err := actor.GetApplication(app.GUID)
@@ -447,6 +471,9 @@ func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<
 }
 
 func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<- string) error {
+	span := tracing.Current().StartInternal("wait for start")
+	defer span.Finish()
+
 	timeout := time.Now().Add(config.StartupTimeout())
 	for time.Now().Before(timeout) {
 		currentInstances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
@@ -32,40 +32,45 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 
 	applicationSummary := ApplicationSummary{Application: app}
 
+	var fetches []func() (Warnings, error)
+
 	// cloud controller calls the instance reporter only when the desired
 	// application state is STARTED
 	if app.State == ccv2.ApplicationStarted {
-		var instances []ApplicationInstanceWithStats
-		instances, warnings, err = actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
-		allWarnings = append(allWarnings, warnings...)
-
-		switch err.(type) {
-		case nil:
-			applicationSummary.RunningInstances = instances
+		fetches = append(fetches, func() (Warnings, error) {
+			instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+			switch err.(type) {
+			case nil:
+				applicationSummary.RunningInstances = instances
 
-			if len(instances) > 0 {
-				applicationSummary.IsolationSegment = instances[0].IsolationSegment
+				if len(instances) > 0 {
+					applicationSummary.IsolationSegment = instances[0].IsolationSegment
+				}
+			case ApplicationInstancesNotFoundError:
+				// don't set instances in summary
+			default:
+				return warnings, err
 			}
-		case ApplicationInstancesNotFoundError:
-			// don't set instances in summary
-		default:
-			return ApplicationSummary{}, allWarnings, err
-		}
+			return warnings, nil
+		})
 	}
 
-	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return ApplicationSummary{}, allWarnings, err
-	}
-	applicationSummary.Routes = routes
+	fetches = append(fetches,
+		func() (warnings Warnings, err error) {
+			applicationSummary.Routes, warnings, err = actor.GetApplicationRoutes(app.GUID)
+			return warnings, err
+		},
+		func() (warnings Warnings, err error) {
+			applicationSummary.Stack, warnings, err = actor.GetStack(app.StackGUID)
+			return warnings, err
+		},
+	)
 
-	stack, warnings, err := actor.GetStack(app.StackGUID)
+	warnings, err = actor.fetchConcurrently(fetches...)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return ApplicationSummary{}, allWarnings, err
 	}
-	applicationSummary.Stack = stack
 
 	return applicationSummary, allWarnings, nil
 }
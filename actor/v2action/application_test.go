@@ -1,7 +1,9 @@
 package v2action_test
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -230,6 +232,75 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the application and returns all warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
+
+	Describe("DownloadApplicationPackage", func() {
+		var buffer *bytes.Buffer
+
+		BeforeEach(func() {
+			buffer = new(bytes.Buffer)
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadApplicationPackageStub = func(_ string, writer io.Writer) (ccv2.Warnings, error) {
+					_, err := writer.Write([]byte("some-package-bits"))
+					return ccv2.Warnings{"download-warning"}, err
+				}
+			})
+
+			It("writes the package to the writer and returns all warnings", func() {
+				warnings, err := actor.DownloadApplicationPackage("some-app-guid", buffer)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("download-warning"))
+				Expect(buffer.String()).To(Equal("some-package-bits"))
+
+				Expect(fakeCloudControllerClient.DownloadApplicationPackageCallCount()).To(Equal(1))
+				appGUID, _ := fakeCloudControllerClient.DownloadApplicationPackageArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadApplicationPackageReturns(ccv2.Warnings{"download-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				warnings, err := actor.DownloadApplicationPackage("some-app-guid", buffer)
+				Expect(err).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("download-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -1,6 +1,10 @@
 package v2action
 
-import "fmt"
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
 
 // Authenticate authenticates the user in UAA and sets the returned tokens in
 // the config.
@@ -19,5 +23,33 @@ func (actor Actor) Authenticate(config Config, username string, password string)
 
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	config.SetTokenInformation(accessToken, refreshToken, "")
+
+	// The tokens were issued to the CLI's own client, which must be used to
+	// refresh them. Client credentials are only kept for client logins.
+	config.UnsetUAAClientCredentials()
+	config.SetUAAGrantType("")
+	return nil
+}
+
+// AuthenticateClient authenticates a UAA client acting on its own behalf with
+// the client credentials grant. The client ID and secret are stored in the
+// config so that the token can be renewed when it expires.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateClient(config Config, clientID string, clientSecret string) error {
+	config.UnsetOrganizationInformation()
+	config.UnsetSpaceInformation()
+
+	accessToken, err := actor.UAAClient.AuthenticateClient(clientID, clientSecret)
+	if err != nil {
+		config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	config.SetTokenInformation(accessToken, "", "")
+	config.SetUAAClientCredentials(clientID, clientSecret)
+	config.SetUAAGrantType(string(uaa.GrantTypeClientCredentials))
 	return nil
 }
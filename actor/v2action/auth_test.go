@@ -54,6 +54,21 @@ var _ = Describe("Auth Actions", func() {
 
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+				Expect(fakeConfig.UnsetUAAClientCredentialsCallCount()).To(Equal(1))
+			})
+
+			Context("when previously authenticated as a client", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAGrantTypeReturns("client_credentials")
+				})
+
+				It("restores the CLI's own UAA client", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+					Expect(fakeConfig.UnsetUAAClientCredentialsCallCount()).To(Equal(1))
+				})
 			})
 		})
 
@@ -88,4 +103,65 @@ var _ = Describe("Auth Actions", func() {
 			})
 		})
 	})
+
+	Describe("AuthenticateClient", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateClient(fakeConfig, "some-client", "some-secret")
+		})
+
+		Context("when no API errors occur", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateClientReturns("some-access-token", nil)
+			})
+
+			It("authenticates the client and stores its token and credentials", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateClientCallCount()).To(Equal(1))
+				clientID, clientSecret := fakeUAAClient.AuthenticateClientArgsForCall(0)
+				Expect(clientID).To(Equal("some-client"))
+				Expect(clientSecret).To(Equal("some-secret"))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(1))
+				client, secret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+				Expect(client).To(Equal("some-client"))
+				Expect(secret).To(Equal("some-secret"))
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("client_credentials"))
+
+				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when an API error occurs", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeUAAClient.AuthenticateClientReturns("", expectedErr)
+			})
+
+			It("returns the error and clears the tokens", func() {
+				Expect(actualErr).To(MatchError(expectedErr))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,57 @@
+package v2action
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// fetchConcurrently calls each fetch, with at most MaxConcurrentRequests
+// running at the same time. Fetches are started in the order they are passed
+// in, and no more are started once one of them fails. The warnings are
+// returned in the same order as the fetches, up to and including the first
+// fetch that failed, along with its error.
+func (actor Actor) fetchConcurrently(fetches ...func() (Warnings, error)) (Warnings, error) {
+	limit := actor.MaxConcurrentRequests
+	if limit < 1 {
+		limit = 1
+	}
+
+	fetchWarnings := make([]Warnings, len(fetches))
+	fetchErrs := make([]error, len(fetches))
+
+	var (
+		wg        sync.WaitGroup
+		failed    int32
+		semaphore = make(chan struct{}, limit)
+	)
+
+	for i, fetch := range fetches {
+		semaphore <- struct{}{}
+		if atomic.LoadInt32(&failed) != 0 {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, fetch func() (Warnings, error)) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			fetchWarnings[i], fetchErrs[i] = fetch()
+			if fetchErrs[i] != nil {
+				atomic.StoreInt32(&failed, 1)
+			}
+		}(i, fetch)
+	}
+	wg.Wait()
+
+	var allWarnings Warnings
+	for i := range fetches {
+		allWarnings = append(allWarnings, fetchWarnings[i]...)
+		if fetchErrs[i] != nil {
+			return allWarnings, fetchErrs[i]
+		}
+	}
+
+	return allWarnings, nil
+}
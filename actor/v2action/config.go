@@ -8,11 +8,15 @@ type Config interface {
 	PollingInterval() time.Duration
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAGrantType(grantType string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
+	UAAGrantType() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
+	UnsetUAAClientCredentials()
 	Verbose() (bool, []string)
 }
@@ -121,11 +121,15 @@ func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, e
 
 func (actor Actor) saveDomain(domain ccv2.Domain) {
 	if domain.GUID != "" {
+		actor.domainCacheMutex.Lock()
+		defer actor.domainCacheMutex.Unlock()
 		actor.domainCache[domain.GUID] = Domain(domain)
 	}
 }
 
 func (actor Actor) loadDomain(domainGUID string) (Domain, bool) {
+	actor.domainCacheMutex.RLock()
+	defer actor.domainCacheMutex.RUnlock()
 	domain, found := actor.domainCache[domainGUID]
 	return domain, found
 }
@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/cloudfoundry/noaa"
@@ -61,6 +63,53 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// LogMessageFilter selects log messages. Empty fields match every message.
+type LogMessageFilter struct {
+	// SourceType matches messages from the source type, e.g. "APP", and any of
+	// its sub-sources, e.g. "APP/PROC/WEB". It is case insensitive.
+	SourceType     string
+	SourceInstance string
+	// MessageType is "OUT" or "ERR".
+	MessageType string
+	Pattern     *regexp.Regexp
+	// Since and Until bound the timestamps of messages.
+	Since time.Time
+	Until time.Time
+}
+
+// Matches returns true if message is selected by the filter.
+func (filter LogMessageFilter) Matches(message LogMessage) bool {
+	if filter.SourceType != "" {
+		sourceType := strings.ToUpper(message.SourceType())
+		wanted := strings.ToUpper(filter.SourceType)
+		if sourceType != wanted && !strings.HasPrefix(sourceType, wanted+"/") {
+			return false
+		}
+	}
+
+	if filter.SourceInstance != "" && message.SourceInstance() != filter.SourceInstance {
+		return false
+	}
+
+	if filter.MessageType != "" && !strings.EqualFold(message.Type(), filter.MessageType) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && message.Timestamp().After(filter.Until) {
+		return false
+	}
+
+	return true
+}
+
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
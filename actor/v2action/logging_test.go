@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -46,6 +47,89 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogMessageFilter", func() {
+		var (
+			filter  LogMessageFilter
+			message *LogMessage
+		)
+
+		BeforeEach(func() {
+			filter = LogMessageFilter{}
+			message = NewLogMessage("some-message", int(events.LogMessage_ERR), time.Unix(100, 0), "APP/PROC/WEB", "1")
+		})
+
+		Context("when the filter is empty", func() {
+			It("matches every message", func() {
+				Expect(filter.Matches(*message)).To(BeTrue())
+			})
+		})
+
+		Describe("SourceType", func() {
+			It("matches the source type and its sub-sources, ignoring case", func() {
+				filter.SourceType = "app"
+				Expect(filter.Matches(*message)).To(BeTrue())
+
+				filter.SourceType = "APP/PROC/WEB"
+				Expect(filter.Matches(*message)).To(BeTrue())
+			})
+
+			It("does not match other source types", func() {
+				filter.SourceType = "AP"
+				Expect(filter.Matches(*message)).To(BeFalse())
+
+				filter.SourceType = "RTR"
+				Expect(filter.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Describe("SourceInstance", func() {
+			It("matches the instance exactly", func() {
+				filter.SourceInstance = "1"
+				Expect(filter.Matches(*message)).To(BeTrue())
+
+				filter.SourceInstance = "10"
+				Expect(filter.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Describe("MessageType", func() {
+			It("matches the message type", func() {
+				filter.MessageType = "ERR"
+				Expect(filter.Matches(*message)).To(BeTrue())
+
+				filter.MessageType = "OUT"
+				Expect(filter.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Describe("Pattern", func() {
+			It("matches messages containing the pattern", func() {
+				filter.Pattern = regexp.MustCompile("^some-")
+				Expect(filter.Matches(*message)).To(BeTrue())
+
+				filter.Pattern = regexp.MustCompile("other")
+				Expect(filter.Matches(*message)).To(BeFalse())
+			})
+		})
+
+		Describe("Since and Until", func() {
+			It("matches messages within the window, inclusive", func() {
+				filter.Since = time.Unix(100, 0)
+				filter.Until = time.Unix(100, 0)
+				Expect(filter.Matches(*message)).To(BeTrue())
+			})
+
+			It("does not match messages outside the window", func() {
+				filter.Since = time.Unix(101, 0)
+				Expect(filter.Matches(*message)).To(BeFalse())
+
+				filter.Since = time.Time{}
+				filter.Until = time.Unix(99, 0)
+				Expect(filter.Matches(*message)).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
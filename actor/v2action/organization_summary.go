@@ -22,7 +22,26 @@ func (actor Actor) GetOrganizationSummaryByName(orgName string) (OrganizationSum
 		Organization: org,
 	}
 
-	domains, warnings, err := actor.GetOrganizationDomains(org.GUID)
+	var (
+		domains []Domain
+		quota   OrganizationQuota
+		spaces  []Space
+	)
+
+	warnings, err = actor.fetchConcurrently(
+		func() (warnings Warnings, err error) {
+			domains, warnings, err = actor.GetOrganizationDomains(org.GUID)
+			return warnings, err
+		},
+		func() (warnings Warnings, err error) {
+			quota, warnings, err = actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+			return warnings, err
+		},
+		func() (warnings Warnings, err error) {
+			spaces, warnings, err = actor.GetOrganizationSpaces(org.GUID)
+			return warnings, err
+		},
+	)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return OrganizationSummary{}, allWarnings, err
@@ -33,19 +52,8 @@ func (actor Actor) GetOrganizationSummaryByName(orgName string) (OrganizationSum
 	}
 	sort.Strings(orgSummary.DomainNames)
 
-	quota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return OrganizationSummary{}, allWarnings, err
-	}
 	orgSummary.QuotaName = quota.Name
 
-	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return OrganizationSummary{}, allWarnings, err
-	}
-
 	for _, space := range spaces {
 		orgSummary.SpaceNames = append(orgSummary.SpaceNames, space.Name)
 	}
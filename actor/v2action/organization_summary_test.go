@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -194,6 +195,45 @@ var _ = Describe("Organization Summary Actions", func() {
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2", "spaces warning"))
 				})
 			})
+
+			Context("when the quota lookup is waiting for the spaces lookup", func() {
+				BeforeEach(func() {
+					spacesRequested := make(chan struct{})
+					fakeCloudControllerClient.GetSpacesStub = func(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
+						close(spacesRequested)
+						return nil, nil, nil
+					}
+					fakeCloudControllerClient.GetOrganizationQuotaStub = func(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+						select {
+						case <-spacesRequested:
+							return ccv2.OrganizationQuota{Name: "some-org-quota"}, nil, nil
+						case <-time.After(time.Second):
+							return ccv2.OrganizationQuota{}, nil, errors.New("spaces were not requested concurrently")
+						}
+					}
+				})
+
+				It("looks up the domains, quota and spaces concurrently", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(orgSummary.QuotaName).To(Equal("some-org-quota"))
+				})
+			})
+
+			Context("when the actor makes one request at a time", func() {
+				BeforeEach(func() {
+					actor.MaxConcurrentRequests = 1
+
+					expectedErr = errors.New("shared domains error")
+					fakeCloudControllerClient.GetSharedDomainsReturns([]ccv2.Domain{}, ccv2.Warnings{"shared domains warning"}, expectedErr)
+				})
+
+				It("stops looking up the summary after the first error", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "shared domains warning"}))
+					Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 })
@@ -11,6 +11,8 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/util/pushcache"
 	"code.cloudfoundry.org/ykk"
 	ignore "github.com/sabhiram/go-gitignore"
 	log "github.com/sirupsen/logrus"
@@ -54,6 +56,9 @@ type Resource ccv2.Resource
 
 // GatherArchiveResources returns a list of resources for an archive.
 func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error) {
+	span := tracing.Current().StartInternal("hash files")
+	defer span.Finish()
+
 	var resources []Resource
 
 	archive, err := os.Open(archivePath)
@@ -107,6 +112,9 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 // GatherDirectoryResources returns a list of resources for a directory.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
+	span := tracing.Current().StartInternal("hash files")
+	defer span.Finish()
+
 	var (
 		resources []Resource
 		gitIgnore *ignore.GitIgnore
@@ -118,6 +126,16 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, err
 	}
 
+	var fileHashes *pushcache.FileHashes
+	if actor.PushCache != nil {
+		fileHashes = actor.PushCache.LoadFileHashes()
+		defer func() {
+			if saveErr := actor.PushCache.SaveFileHashes(fileHashes); saveErr != nil {
+				log.Warnln("saving push cache file hashes:", saveErr)
+			}
+		}()
+	}
+
 	walkErr := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -144,20 +162,13 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		if info.IsDir() {
 			resource.Mode = DefaultFolderPermissions
 		} else {
-			file, err := os.Open(path)
-			if err != nil {
-				return err
-			}
-			defer file.Close()
-
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
+			sha1Sum, err := actor.fileSHA1(path, info, fileHashes)
 			if err != nil {
 				return err
 			}
 
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
+			resource.SHA1 = sha1Sum
 			resource.Size = info.Size()
 		}
 		resources = append(resources, resource)
@@ -237,6 +248,9 @@ func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resourc
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
 func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude []Resource) (string, error) {
+	span := tracing.Current().StartInternal("zip files")
+	defer span.Finish()
+
 	log.WithField("sourceArchive", sourceArchivePath).Info("zipping source files from archive")
 	zipFile, err := ioutil.TempFile("", "cf-cli-")
 	if err != nil {
@@ -292,8 +306,37 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 // ZipDirectoryResources zips a directory and a sorted (based on full
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
+//
+// If the actor has a PushCache, an archive of the same resources zipped by an
+// earlier push is reused.
 func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Resource) (string, error) {
+	span := tracing.Current().StartInternal("zip files")
+	defer span.Finish()
+
 	log.WithField("sourceDir", sourceDir).Info("zipping source files from directory")
+
+	if actor.PushCache == nil {
+		return actor.zipDirectoryResources(sourceDir, filesToInclude)
+	}
+
+	key := ArchiveKey(filesToInclude)
+	if archivePath, ok := actor.PushCache.Archive(key); ok {
+		log.WithField("key", key).Info("reusing cached archive")
+		return archivePath, nil
+	}
+
+	archivePath, err := actor.zipDirectoryResources(sourceDir, filesToInclude)
+	if err != nil {
+		return "", err
+	}
+
+	if err := actor.PushCache.StoreArchive(key, archivePath); err != nil {
+		log.WithField("key", key).Warnln("caching archive:", err)
+	}
+	return archivePath, nil
+}
+
+func (actor Actor) zipDirectoryResources(sourceDir string, filesToInclude []Resource) (string, error) {
 	zipFile, err := ioutil.TempFile("", "cf-cli-")
 	if err != nil {
 		return "", err
@@ -336,6 +379,51 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	return zipFile.Name(), nil
 }
 
+// ArchiveKey identifies an archive by the names, contents and modes of the
+// resources in it.
+func ArchiveKey(resources []Resource) string {
+	sum := sha1.New()
+	for _, resource := range resources {
+		fmt.Fprintf(sum, "%s:%s:%o\n", resource.Filename, resource.SHA1, resource.Mode)
+	}
+	return fmt.Sprintf("%x", sum.Sum(nil))
+}
+
+// fileSHA1 returns the SHA1 of the file at path, using and updating
+// fileHashes if they are not nil.
+func (Actor) fileSHA1(path string, info os.FileInfo, fileHashes *pushcache.FileHashes) (string, error) {
+	var absPath string
+	if fileHashes != nil {
+		var err error
+		absPath, err = filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+
+		if sha1Sum, ok := fileHashes.Lookup(absPath, info); ok {
+			return sha1Sum, nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	sum := sha1.New()
+	_, err = io.Copy(sum, file)
+	if err != nil {
+		return "", err
+	}
+	sha1Sum := fmt.Sprintf("%x", sum.Sum(nil))
+
+	if fileHashes != nil {
+		fileHashes.Store(absPath, info, sha1Sum)
+	}
+	return sha1Sum, nil
+}
+
 func (Actor) actorToCCResources(resources []Resource) []ccv2.Resource {
 	apiResources := make([]ccv2.Resource, 0, len(resources)) // Explicitly done to prevent nils
 
@@ -6,10 +6,12 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/pushcache"
 	"code.cloudfoundry.org/ykk"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,6 +56,45 @@ var _ = Describe("Resource Actions", func() {
 
 	Describe("GatherDirectoryResources", func() {
 		// tests are under resource_unix_test.go and resource_windows_test.go
+
+		Context("when the actor has a push cache", func() {
+			var cacheDir string
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "push-cache")
+				Expect(err).ToNot(HaveOccurred())
+				actor = NewActor(fakeCloudControllerClient, nil, new(v2actionfakes.FakeConfig))
+				actor.PushCache = pushcache.NewCache(cacheDir)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cacheDir)).ToNot(HaveOccurred())
+			})
+
+			It("reuses the SHA1s of files whose size and modification time have not changed", func() {
+				_, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				path := filepath.Join(srcDir, "tmpFile3")
+				info, err := os.Stat(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(path, []byte("Bananarams"), 0600)).To(Succeed())
+				Expect(os.Chtimes(path, info.ModTime(), info.ModTime())).To(Succeed())
+
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[4].Filename).To(Equal("tmpFile3"))
+				Expect(resources[4].SHA1).To(Equal("f4c9ca85f3e084ffad3abbdabbd2a890c034c879"))
+
+				later := info.ModTime().Add(time.Minute)
+				Expect(os.Chtimes(path, later, later)).To(Succeed())
+
+				resources, err = actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[4].SHA1).ToNot(Equal("f4c9ca85f3e084ffad3abbdabbd2a890c034c879"))
+			})
+		})
 	})
 
 	Describe("ResourceMatch", func() {
@@ -364,6 +405,42 @@ var _ = Describe("Resource Actions", func() {
 				Expect(executeErr).To(Equal(FileChangedError{Filename: filepath.Join(srcDir, "tmpFile3")}))
 			})
 		})
+
+		Context("when the actor has a push cache", func() {
+			var cacheDir string
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "push-cache")
+				Expect(err).ToNot(HaveOccurred())
+				actor.PushCache = pushcache.NewCache(cacheDir)
+
+				resources = []Resource{
+					{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95"},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cacheDir)).ToNot(HaveOccurred())
+			})
+
+			It("reuses the archive of the same resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				firstZip, err := ioutil.ReadFile(resultZip)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.Remove(filepath.Join(srcDir, "tmpFile2"))).To(Succeed())
+
+				secondResultZip, err := actor.ZipDirectoryResources(srcDir, resources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(secondResultZip)
+				Expect(secondResultZip).ToNot(Equal(resultZip))
+				Expect(ioutil.ReadFile(secondResultZip)).To(Equal(firstZip))
+
+				_, err = actor.ZipDirectoryResources(srcDir, append(resources, Resource{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879"}))
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
 
@@ -3,6 +3,7 @@ package v2action
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -86,6 +87,12 @@ func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (War
 	return Warnings(warnings), err
 }
 
+// UnbindRouteFromApplication unbinds the route from the application.
+func (actor Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnbindRouteFromApplication(routeGUID, appGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings, error) {
 	returnedRoute, warnings, err := actor.CloudControllerClient.CreateRoute(ActorToCCRoute(route), generatePort)
 	return CCToActorRoute(returnedRoute, route.Domain), Warnings(warnings), err
@@ -253,16 +260,36 @@ func CCToActorRoute(ccv2Route ccv2.Route, domain Domain) Route {
 }
 
 func (actor Actor) applyDomain(ccv2Routes []ccv2.Route) (Routes, Warnings, error) {
-	var routes Routes
-	var allWarnings Warnings
-
+	var domainGUIDs []string
+	domains := map[string]Domain{}
 	for _, ccv2Route := range ccv2Routes {
-		domain, warnings, err := actor.GetDomain(ccv2Route.DomainGUID)
-		allWarnings = append(allWarnings, warnings...)
-		if err != nil {
-			return nil, allWarnings, err
+		if _, found := domains[ccv2Route.DomainGUID]; !found {
+			domains[ccv2Route.DomainGUID] = Domain{}
+			domainGUIDs = append(domainGUIDs, ccv2Route.DomainGUID)
+		}
+	}
+
+	var mutex sync.Mutex
+	fetches := make([]func() (Warnings, error), len(domainGUIDs))
+	for i, domainGUID := range domainGUIDs {
+		domainGUID := domainGUID
+		fetches[i] = func() (Warnings, error) {
+			domain, warnings, err := actor.GetDomain(domainGUID)
+			mutex.Lock()
+			domains[domainGUID] = domain
+			mutex.Unlock()
+			return warnings, err
 		}
-		routes = append(routes, CCToActorRoute(ccv2Route, domain))
+	}
+
+	allWarnings, err := actor.fetchConcurrently(fetches...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var routes Routes
+	for _, ccv2Route := range ccv2Routes {
+		routes = append(routes, CCToActorRoute(ccv2Route, domains[ccv2Route.DomainGUID]))
 	}
 
 	return routes, allWarnings, nil
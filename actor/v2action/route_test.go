@@ -102,6 +102,44 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					nil)
+			})
+
+			It("unbinds the route from the application and returns all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind warning"))
+
+				Expect(fakeCloudControllerClient.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind route failed")
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -306,11 +344,13 @@ var _ = Describe("Route Actions", func() {
 		Context("when there are warnings", func() {
 			BeforeEach(func() {
 				fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
-					ccv2.Route{GUID: "route-guid-1"},
-					ccv2.Route{GUID: "route-guid-2"},
+					ccv2.Route{GUID: "route-guid-1", DomainGUID: "domain-guid-1"},
+					ccv2.Route{GUID: "route-guid-2", DomainGUID: "domain-guid-2"},
 				}, ccv2.Warnings{"get-routes-warning"}, nil)
 				fakeCloudControllerClient.GetRouteApplicationsReturns(nil, ccv2.Warnings{"get-applications-warning"}, nil)
-				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "some-guid"}, ccv2.Warnings{"get-shared-domain-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+					return ccv2.Domain{GUID: domainGUID}, ccv2.Warnings{"get-shared-domain-warning"}, nil
+				}
 			})
 
 			It("returns all the warnings", func() {
@@ -407,8 +447,15 @@ var _ = Describe("Route Actions", func() {
 					},
 				}, ccv2.Warnings{"get-application-routes-warning"}, nil)
 
-				fakeCloudControllerClient.GetSharedDomainReturnsOnCall(0, ccv2.Domain{Name: "domain.com"}, nil, nil)
-				fakeCloudControllerClient.GetSharedDomainReturnsOnCall(1, ccv2.Domain{Name: "other-domain.com"}, nil, nil)
+				fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+					switch domainGUID {
+					case "domain-1-guid":
+						return ccv2.Domain{Name: "domain.com"}, nil, nil
+					case "domain-2-guid":
+						return ccv2.Domain{Name: "other-domain.com"}, nil, nil
+					}
+					return ccv2.Domain{}, nil, errors.New("Unexpected domain GUID")
+				}
 			})
 
 			It("returns the application routes and any warnings", func() {
@@ -416,8 +463,10 @@ var _ = Describe("Route Actions", func() {
 				Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetApplicationRoutesArgsForCall(0)).To(Equal("application-guid"))
 				Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(2))
-				Expect(fakeCloudControllerClient.GetSharedDomainArgsForCall(0)).To(Equal("domain-1-guid"))
-				Expect(fakeCloudControllerClient.GetSharedDomainArgsForCall(1)).To(Equal("domain-2-guid"))
+				Expect([]string{
+					fakeCloudControllerClient.GetSharedDomainArgsForCall(0),
+					fakeCloudControllerClient.GetSharedDomainArgsForCall(1),
+				}).To(ConsistOf("domain-1-guid", "domain-2-guid"))
 
 				Expect(warnings).To(ConsistOf("get-application-routes-warning"))
 				Expect(err).NotTo(HaveOccurred())
@@ -531,8 +580,15 @@ var _ = Describe("Route Actions", func() {
 						DomainGUID: "domain-2-guid",
 					},
 				}, ccv2.Warnings{"get-space-routes-warning"}, nil)
-				fakeCloudControllerClient.GetSharedDomainReturnsOnCall(0, ccv2.Domain{Name: "domain.com"}, nil, nil)
-				fakeCloudControllerClient.GetSharedDomainReturnsOnCall(1, ccv2.Domain{Name: "other-domain.com"}, nil, nil)
+				fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+					switch domainGUID {
+					case "domain-1-guid":
+						return ccv2.Domain{Name: "domain.com"}, nil, nil
+					case "domain-2-guid":
+						return ccv2.Domain{Name: "other-domain.com"}, nil, nil
+					}
+					return ccv2.Domain{}, nil, errors.New("Unexpected domain GUID")
+				}
 			})
 
 			It("returns the space routes and any warnings", func() {
@@ -540,8 +596,10 @@ var _ = Describe("Route Actions", func() {
 				Expect(fakeCloudControllerClient.GetSpaceRoutesCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)).To(Equal("space-guid"))
 				Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(2))
-				Expect(fakeCloudControllerClient.GetSharedDomainArgsForCall(0)).To(Equal("domain-1-guid"))
-				Expect(fakeCloudControllerClient.GetSharedDomainArgsForCall(1)).To(Equal("domain-2-guid"))
+				Expect([]string{
+					fakeCloudControllerClient.GetSharedDomainArgsForCall(0),
+					fakeCloudControllerClient.GetSharedDomainArgsForCall(1),
+				}).To(ConsistOf("domain-1-guid", "domain-2-guid"))
 
 				Expect(warnings).To(ConsistOf("get-space-routes-warning"))
 				Expect(err).NotTo(HaveOccurred())
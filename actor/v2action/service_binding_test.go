@@ -22,6 +22,45 @@ var _ = Describe("Service Binding Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
+	Describe("BindServiceByApplicationAndServiceInstance", func() {
+		var (
+			executeErr error
+			warnings   Warnings
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.BindServiceByApplicationAndServiceInstance("some-app-guid", "some-service-instance-guid")
+		})
+
+		Context("when the binding is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"some-warning"}, nil)
+			})
+
+			It("creates the service binding and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(parameters).To(BeNil())
+			})
+		})
+
+		Context("when the binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(errors.New("some-error")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("BindServiceBySpace", func() {
 		var (
 			executeErr error
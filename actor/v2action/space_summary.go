@@ -42,7 +42,42 @@ func (actor Actor) GetSpaceSummaryByOrganizationAndName(orgGUID string, name str
 		return SpaceSummary{}, allWarnings, err
 	}
 
-	apps, warnings, err := actor.GetApplicationsBySpace(space.GUID)
+	var (
+		apps                  []Application
+		serviceInstances      []ServiceInstance
+		spaceQuota            SpaceQuota
+		runningSecurityGroups []SecurityGroup
+		stagingSecurityGroups []SecurityGroup
+	)
+
+	fetches := []func() (Warnings, error){
+		func() (warnings Warnings, err error) {
+			apps, warnings, err = actor.GetApplicationsBySpace(space.GUID)
+			return warnings, err
+		},
+		func() (warnings Warnings, err error) {
+			serviceInstances, warnings, err = actor.GetServiceInstancesBySpace(space.GUID)
+			return warnings, err
+		},
+	}
+	if space.SpaceQuotaDefinitionGUID != "" {
+		fetches = append(fetches, func() (warnings Warnings, err error) {
+			spaceQuota, warnings, err = actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+			return warnings, err
+		})
+	}
+	fetches = append(fetches, func() (warnings Warnings, err error) {
+		runningSecurityGroups, warnings, err = actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+		return warnings, err
+	})
+	if includeStagingSecurityGroupsRules {
+		fetches = append(fetches, func() (warnings Warnings, err error) {
+			stagingSecurityGroups, warnings, err = actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+			return warnings, err
+		})
+	}
+
+	warnings, err = actor.fetchConcurrently(fetches...)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return SpaceSummary{}, allWarnings, err
@@ -54,60 +89,30 @@ func (actor Actor) GetSpaceSummaryByOrganizationAndName(orgGUID string, name str
 	}
 	sort.Strings(appNames)
 
-	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(space.GUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return SpaceSummary{}, allWarnings, err
-	}
-
 	serviceInstanceNames := make([]string, len(serviceInstances))
 	for i, serviceInstance := range serviceInstances {
 		serviceInstanceNames[i] = serviceInstance.Name
 	}
 	sort.Strings(serviceInstanceNames)
 
-	var spaceQuota SpaceQuota
-
-	if space.SpaceQuotaDefinitionGUID != "" {
-		spaceQuota, warnings, err = actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
-		allWarnings = append(allWarnings, warnings...)
-		if err != nil {
-			return SpaceSummary{}, allWarnings, err
-		}
-	}
-
-	securityGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return SpaceSummary{}, allWarnings, err
-	}
-
 	var runningSecurityGroupNames []string
 	var stagingSecurityGroupNames []string
 	var securityGroupRules []SecurityGroupRule
 
-	for _, securityGroup := range securityGroups {
+	for _, securityGroup := range runningSecurityGroups {
 		runningSecurityGroupNames = append(runningSecurityGroupNames, securityGroup.Name)
 		securityGroupRules = append(securityGroupRules, extractSecurityGroupRules(securityGroup, ccv2.SecurityGroupLifecycleRunning)...)
 	}
 
 	sort.Strings(runningSecurityGroupNames)
 
-	if includeStagingSecurityGroupsRules {
-		securityGroups, warnings, err = actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
-		allWarnings = append(allWarnings, warnings...)
-		if err != nil {
-			return SpaceSummary{}, allWarnings, err
-		}
-
-		for _, securityGroup := range securityGroups {
-			stagingSecurityGroupNames = append(stagingSecurityGroupNames, securityGroup.Name)
-			securityGroupRules = append(securityGroupRules, extractSecurityGroupRules(securityGroup, ccv2.SecurityGroupLifecycleStaging)...)
-		}
-
-		sort.Strings(stagingSecurityGroupNames)
+	for _, securityGroup := range stagingSecurityGroups {
+		stagingSecurityGroupNames = append(stagingSecurityGroupNames, securityGroup.Name)
+		securityGroupRules = append(securityGroupRules, extractSecurityGroupRules(securityGroup, ccv2.SecurityGroupLifecycleStaging)...)
 	}
 
+	sort.Strings(stagingSecurityGroupNames)
+
 	sort.Slice(securityGroupRules, func(i int, j int) bool {
 		if securityGroupRules[i].Name < securityGroupRules[j].Name {
 			return true
@@ -6,5 +6,6 @@ import "code.cloudfoundry.org/cli/api/uaa"
 
 type UAAClient interface {
 	Authenticate(username string, password string) (string, string, error)
+	AuthenticateClient(clientID string, clientSecret string) (string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 }
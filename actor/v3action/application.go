@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/clock"
 )
 
@@ -99,6 +100,9 @@ func (actor Actor) StartApplication(appGUID string, spaceGUID string) (Applicati
 }
 
 func (actor Actor) PollStart(appGUID string, warningsChannel chan<- Warnings) error {
+	span := tracing.Current().StartInternal("wait for start")
+	defer span.Finish()
+
 	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
 	warningsChannel <- Warnings(warnings)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/tracing"
 )
 
 type Build ccv3.Build
@@ -28,6 +29,9 @@ func (actor Actor) StagePackage(packageGUID string, appName string) (<-chan Buil
 		defer close(warningsStream)
 		defer close(errorStream)
 
+		span := tracing.Current().StartInternal("wait for staging")
+		defer span.Finish()
+
 		build := ccv3.Build{Package: ccv3.Package{GUID: packageGUID}}
 		build, allWarnings, err := actor.CloudControllerClient.CreateBuild(build)
 		warningsStream <- Warnings(allWarnings)
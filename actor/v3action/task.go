@@ -33,6 +33,16 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// TaskFailedError is returned when a task that is being waited on fails.
+type TaskFailedError struct {
+	Name          string
+	FailureReason string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %s failed: %s", e.Name, e.FailureReason)
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
@@ -92,3 +102,24 @@ func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
 }
+
+// CheckTaskCompletion gets the current state of the task, and returns whether
+// it has finished. When the task has failed, a TaskFailedError is returned.
+func (actor Actor) CheckTaskCompletion(appGUID string, task Task) (Task, bool, Warnings, error) {
+	currentTask, warnings, err := actor.GetTaskBySequenceIDAndApplication(task.SequenceID, appGUID)
+	if err != nil {
+		return task, false, warnings, err
+	}
+
+	switch currentTask.State {
+	case ccv3.TaskStateSucceeded:
+		return currentTask, true, warnings, nil
+	case ccv3.TaskStateFailed:
+		return currentTask, true, warnings, TaskFailedError{
+			Name:          currentTask.Name,
+			FailureReason: currentTask.FailureReason,
+		}
+	default:
+		return currentTask, false, warnings, nil
+	}
+}
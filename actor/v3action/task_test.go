@@ -305,4 +305,86 @@ var _ = Describe("Task Actions", func() {
 			})
 		})
 	})
+
+	Describe("CheckTaskCompletion", func() {
+		var (
+			task       Task
+			done       bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			task, done, warnings, executeErr = actor.CheckTaskCompletion("some-app-guid", Task{Name: "some-task", SequenceID: 3})
+		})
+
+		Context("when the task is still running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: "RUNNING"}},
+					ccv3.Warnings{"get-task-warning"},
+					nil,
+				)
+			})
+
+			It("returns that the task has not finished", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(done).To(BeFalse())
+				Expect(task.State).To(Equal("RUNNING"))
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"sequence_ids": []string{"3"}}))
+			})
+		})
+
+		Context("when the task has succeeded", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: ccv3.TaskStateSucceeded}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns that the task has finished", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(done).To(BeTrue())
+			})
+		})
+
+		Context("when the task has failed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: ccv3.TaskStateFailed, FailureReason: "Exited with status 1"}},
+					ccv3.Warnings{"get-task-warning"},
+					nil,
+				)
+			})
+
+			It("returns a TaskFailedError and warnings", func() {
+				Expect(executeErr).To(MatchError(TaskFailedError{Name: "some-task", FailureReason: "Exited with status 1"}))
+				Expect(done).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+			})
+		})
+
+		Context("when getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					nil,
+					ccv3.Warnings{"get-task-warning"},
+					errors.New("cc-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("cc-error"))
+				Expect(done).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+			})
+		})
+	})
 })
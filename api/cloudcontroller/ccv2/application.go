@@ -3,6 +3,8 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -45,9 +47,16 @@ type Application struct {
 	// DiskQuota is the disk given to each instance, in megabytes.
 	DiskQuota uint64 `json:"disk_quota,omitempty"`
 
+	// DockerCredentials is the authentication information for the docker
+	// image's registry. It is never returned by the Cloud Controller.
+	DockerCredentials *DockerCredentials `json:"docker_credentials,omitempty"`
+
 	// DockerImage is the docker image location.
 	DockerImage string `json:"docker_image,omitempty"`
 
+	// EnvironmentVariables are the user provided environment variables.
+	EnvironmentVariables map[string]string `json:"environment_json,omitempty"`
+
 	// GUID is the unique application identifier.
 	GUID string `json:"guid,omitempty"`
 
@@ -93,29 +102,37 @@ type Application struct {
 	State ApplicationState `json:"state,omitempty"`
 }
 
+// DockerCredentials are the username and password used to pull a docker image
+// from a private registry.
+type DockerCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
 func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Buildpack                string     `json:"buildpack"`
-			Command                  string     `json:"command"`
-			DetectedBuildpack        string     `json:"detected_buildpack"`
-			DetectedStartCommand     string     `json:"detected_start_command"`
-			DiskQuota                uint64     `json:"disk_quota"`
-			DockerImage              string     `json:"docker_image"`
-			HealthCheckHTTPEndpoint  string     `json:"health_check_http_endpoint"`
-			HealthCheckTimeout       int        `json:"health_check_timeout"`
-			HealthCheckType          string     `json:"health_check_type"`
-			Instances                int        `json:"instances"`
-			Memory                   uint64     `json:"memory"`
-			Name                     string     `json:"name"`
-			PackageState             string     `json:"package_state"`
-			PackageUpdatedAt         *time.Time `json:"package_updated_at"`
-			StackGUID                string     `json:"stack_guid"`
-			StagingFailedDescription string     `json:"staging_failed_description"`
-			StagingFailedReason      string     `json:"staging_failed_reason"`
-			State                    string     `json:"state"`
+			Buildpack                string                 `json:"buildpack"`
+			Command                  string                 `json:"command"`
+			DetectedBuildpack        string                 `json:"detected_buildpack"`
+			DetectedStartCommand     string                 `json:"detected_start_command"`
+			DiskQuota                uint64                 `json:"disk_quota"`
+			DockerImage              string                 `json:"docker_image"`
+			EnvironmentJSON          map[string]interface{} `json:"environment_json"`
+			HealthCheckHTTPEndpoint  string                 `json:"health_check_http_endpoint"`
+			HealthCheckTimeout       int                    `json:"health_check_timeout"`
+			HealthCheckType          string                 `json:"health_check_type"`
+			Instances                int                    `json:"instances"`
+			Memory                   uint64                 `json:"memory"`
+			Name                     string                 `json:"name"`
+			PackageState             string                 `json:"package_state"`
+			PackageUpdatedAt         *time.Time             `json:"package_updated_at"`
+			StackGUID                string                 `json:"stack_guid"`
+			StagingFailedDescription string                 `json:"staging_failed_description"`
+			StagingFailedReason      string                 `json:"staging_failed_reason"`
+			State                    string                 `json:"state"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccApp); err != nil {
@@ -141,6 +158,13 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.StagingFailedReason = ccApp.Entity.StagingFailedReason
 	application.State = ApplicationState(ccApp.Entity.State)
 
+	if ccApp.Entity.EnvironmentJSON != nil {
+		application.EnvironmentVariables = map[string]string{}
+		for name, value := range ccApp.Entity.EnvironmentJSON {
+			application.EnvironmentVariables[name] = fmt.Sprint(value)
+		}
+	}
+
 	if ccApp.Entity.PackageUpdatedAt != nil {
 		application.PackageUpdatedAt = *ccApp.Entity.PackageUpdatedAt
 	}
@@ -172,6 +196,40 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the application with the given GUID.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DownloadApplicationPackage writes the package the Cloud Controller stores
+// for the application with the given GUID to writer.
+func (client *Client) DownloadApplicationPackage(guid string, writer io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppDownloadRequest,
+		URIParams:   Params{"app_guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		Writer: writer,
+	}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
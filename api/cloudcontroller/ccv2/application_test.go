@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"bytes"
 	"net/http"
 	"time"
 
@@ -86,6 +87,106 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("DownloadApplicationPackage", func() {
+		var (
+			buffer   *bytes.Buffer
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			buffer = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = client.DownloadApplicationPackage("some-app-guid", buffer)
+		})
+
+		Context("when the app has a package", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/download"),
+						RespondWith(http.StatusOK, "some-package-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the package to the writer and returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buffer.String()).To(Equal("some-package-bits"))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+				"code": 100004,
+				"description": "The app could not be found: some-app-guid",
+				"error_code": "CF-AppNotFound"
+			}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(buffer.Len()).To(BeZero())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteApplication", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the app and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+				"code": 100004,
+				"description": "The app could not be found: some-app-guid",
+				"error_code": "CF-AppNotFound"
+			}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
@@ -288,6 +389,10 @@ var _ = Describe("Application", func() {
 					"disk_quota": 586,
 					"detected_buildpack": null,
 					"docker_image": "some-docker-path",
+					"environment_json": {
+						"some-key": "some-value",
+						"some-number": 1
+					},
 					"health_check_timeout": 120,
 					"health_check_type": "some-health-check-type",
 					"health_check_http_endpoint": "/anything",
@@ -300,10 +405,18 @@ var _ = Describe("Application", func() {
 				}
 			}`
 					expectedBody := map[string]interface{}{
-						"buildpack":                  "ruby 1.6.29",
-						"command":                    "some-command",
-						"disk_quota":                 586,
-						"docker_image":               "some-docker-path",
+						"buildpack":  "ruby 1.6.29",
+						"command":    "some-command",
+						"disk_quota": 586,
+						"docker_credentials": map[string]string{
+							"username": "some-docker-username",
+							"password": "some-docker-password",
+						},
+						"docker_image": "some-docker-path",
+						"environment_json": map[string]string{
+							"some-key":    "some-value",
+							"some-number": "1",
+						},
 						"health_check_http_endpoint": "/anything",
 						"health_check_type":          "some-health-check-type",
 						"instances":                  13,
@@ -323,11 +436,19 @@ var _ = Describe("Application", func() {
 
 				It("returns the updated object and warnings and sends all updated field", func() {
 					app, warnings, err := client.UpdateApplication(Application{
-						Buildpack:   "ruby 1.6.29",
-						Command:     "some-command",
-						DiskQuota:   586,
+						Buildpack: "ruby 1.6.29",
+						Command:   "some-command",
+						DiskQuota: 586,
+						DockerCredentials: &DockerCredentials{
+							Username: "some-docker-username",
+							Password: "some-docker-password",
+						},
 						DockerImage: "some-docker-path",
-						GUID:        "some-app-guid",
+						EnvironmentVariables: map[string]string{
+							"some-key":    "some-value",
+							"some-number": "1",
+						},
+						GUID:                    "some-app-guid",
 						HealthCheckHTTPEndpoint: "/anything",
 						HealthCheckType:         "some-health-check-type",
 						Instances:               13,
@@ -341,12 +462,16 @@ var _ = Describe("Application", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						Buildpack:               "ruby 1.6.29",
-						Command:                 "some-command",
-						DetectedBuildpack:       "",
-						DetectedStartCommand:    "echo 'I am a banana'",
-						DiskQuota:               586,
-						DockerImage:             "some-docker-path",
+						Buildpack:            "ruby 1.6.29",
+						Command:              "some-command",
+						DetectedBuildpack:    "",
+						DetectedStartCommand: "echo 'I am a banana'",
+						DiskQuota:            586,
+						DockerImage:          "some-docker-path",
+						EnvironmentVariables: map[string]string{
+							"some-key":    "some-value",
+							"some-number": "1",
+						},
 						GUID:                    "some-app-guid",
 						HealthCheckTimeout:      120,
 						HealthCheckType:         "some-health-check-type",
@@ -15,13 +15,16 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppRequest                       = "DeleteApp"
 	DeleteOrganizationRequest              = "DeleteOrganization"
+	DeleteRouteAppRequest                  = "DeleteRouteApp"
 	DeleteRouteRequest                     = "DeleteRoute"
 	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
 	DeleteSecurityGroupSpaceRequest        = "DeleteSecurityGroupSpace"
 	DeleteServiceBindingRequest            = "DeleteServiceBinding"
 	DeleteSpaceRequest                     = "DeleteSpaceRequest"
 	DeleteStagingSecurityGroupSpaceRequest = "DeleteStagingSecurityGroupSpace"
+	GetAppDownloadRequest                  = "GetAppDownload"
 	GetAppInstancesRequest                 = "GetAppInstances"
 	GetAppRequest                          = "GetApp"
 	GetAppRoutesRequest                    = "GetAppRoutes"
@@ -72,9 +75,11 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
+	{Path: "/v2/apps/:app_guid/download", Method: http.MethodGet, Name: GetAppDownloadRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: GetAppInstancesRequest},
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
@@ -92,6 +97,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutBindRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
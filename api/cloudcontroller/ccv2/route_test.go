@@ -802,6 +802,49 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when the route is bound to the app", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("unbinds the route and returns all warnings", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+				"code": 210002,
+				"description": "The route could not be found: some-route-guid",
+				"error_code": "CF-RouteNotFound"
+			}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The route could not be found: some-route-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("CheckRoute", func() {
 		Context("API Version < 2.55.0", func() {
 			// Figure it out
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/ccv2fakes"
 
@@ -82,6 +83,34 @@ var _ = Describe("Target", func() {
 			})
 		})
 
+		Context("when client has raw wrappers", func() {
+			var fakeRawWrapper *ccv2fakes.FakeConnectionWrapper
+
+			BeforeEach(func() {
+				fakeRawWrapper = new(ccv2fakes.FakeConnectionWrapper)
+				fakeRawWrapper.WrapStub = func(connection cloudcontroller.Connection) cloudcontroller.Connection {
+					return connection
+				}
+
+				client = NewClient(Config{
+					AppName:     "CF CLI API Target Test",
+					AppVersion:  "Unknown",
+					RawWrappers: []ConnectionWrapper{fakeRawWrapper},
+				})
+			})
+
+			It("wraps the unwrapped connection", func() {
+				_, err := client.TargetCF(TargetSettings{
+					SkipSSLValidation: true,
+					URL:               server.URL(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeRawWrapper.WrapCallCount()).To(Equal(1))
+				Expect(fakeRawWrapper.WrapArgsForCall(0)).To(BeAssignableToTypeOf(&cloudcontroller.CloudControllerConnection{}))
+			})
+		})
+
 		Context("when passed a valid API URL", func() {
 			BeforeEach(func() {
 				client = NewClient(Config{AppName: "CF CLI API Target Test", AppVersion: "Unknown"})
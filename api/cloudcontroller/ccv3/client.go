@@ -104,6 +104,10 @@ type Config struct {
 
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
+
+	// RawWrappers apply to the client connection before the Cloud Controller
+	// errors are parsed, so they see responses exactly as they were received.
+	RawWrappers []ConnectionWrapper
 }
 
 // NewClient returns a new Client.
@@ -111,6 +115,14 @@ func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
 		userAgent: userAgent,
-		wrappers:  append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		wrappers:  connectionWrappers(config),
 	}
 }
+
+// connectionWrappers returns the wrappers in the order they are applied to
+// the connection.
+func connectionWrappers(config Config) []ConnectionWrapper {
+	wrappers := append([]ConnectionWrapper{}, config.RawWrappers...)
+	wrappers = append(wrappers, newErrorWrapper())
+	return append(wrappers, config.Wrappers...)
+}
@@ -121,6 +121,34 @@ var _ = Describe("Target", func() {
 			})
 		})
 
+		Context("when client has raw wrappers", func() {
+			var fakeRawWrapper *ccv3fakes.FakeConnectionWrapper
+
+			BeforeEach(func() {
+				fakeRawWrapper = new(ccv3fakes.FakeConnectionWrapper)
+				fakeRawWrapper.WrapStub = func(connection cloudcontroller.Connection) cloudcontroller.Connection {
+					return connection
+				}
+
+				client = NewClient(Config{
+					AppName:     "CF CLI API Target Test",
+					AppVersion:  "Unknown",
+					RawWrappers: []ConnectionWrapper{fakeRawWrapper},
+				})
+			})
+
+			It("wraps the unwrapped connection", func() {
+				_, err := client.TargetCF(TargetSettings{
+					SkipSSLValidation: true,
+					URL:               server.URL(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeRawWrapper.WrapCallCount()).To(Equal(1))
+				Expect(fakeRawWrapper.WrapArgsForCall(0)).To(BeAssignableToTypeOf(&cloudcontroller.CloudControllerConnection{}))
+			})
+		})
+
 		Context("when passed a valid API URL", func() {
 			Context("when the server has unverified SSL", func() {
 				Context("when setting the skip ssl flag", func() {
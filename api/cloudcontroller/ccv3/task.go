@@ -10,6 +10,11 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+const (
+	TaskStateFailed    = "FAILED"
+	TaskStateSucceeded = "SUCCEEDED"
+)
+
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
 	GUID       string `json:"guid,omitempty"`
@@ -20,6 +25,41 @@ type Task struct {
 	CreatedAt  string `json:"created_at,omitempty"`
 	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
 	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+
+	// FailureReason explains why a task in the FAILED state failed.
+	FailureReason string `json:"-"`
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller V3 Task response.
+func (task *Task) UnmarshalJSON(data []byte) error {
+	var ccTask struct {
+		GUID       string `json:"guid"`
+		SequenceID int    `json:"sequence_id"`
+		Name       string `json:"name"`
+		Command    string `json:"command"`
+		State      string `json:"state"`
+		CreatedAt  string `json:"created_at"`
+		MemoryInMB uint64 `json:"memory_in_mb"`
+		DiskInMB   uint64 `json:"disk_in_mb"`
+		Result     struct {
+			FailureReason string `json:"failure_reason"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &ccTask); err != nil {
+		return err
+	}
+
+	task.GUID = ccTask.GUID
+	task.SequenceID = ccTask.SequenceID
+	task.Name = ccTask.Name
+	task.Command = ccTask.Command
+	task.State = ccTask.State
+	task.CreatedAt = ccTask.CreatedAt
+	task.MemoryInMB = ccTask.MemoryInMB
+	task.DiskInMB = ccTask.DiskInMB
+	task.FailureReason = ccTask.Result.FailureReason
+
+	return nil
 }
 
 // CreateApplicationTask runs a command in the Application environment
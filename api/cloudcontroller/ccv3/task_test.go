@@ -191,7 +191,10 @@ var _ = Describe("Task", func() {
 							"name": "task-2",
 							"command": "some-command",
 							"state": "FAILED",
-							"created_at": "2016-11-07T06:59:01Z"
+							"created_at": "2016-11-07T06:59:01Z",
+							"result": {
+								"failure_reason": "Exited with status 1"
+							}
 						}
 					]
 				}`, server.URL())
@@ -238,12 +241,13 @@ var _ = Describe("Task", func() {
 						Command:    "some-command",
 					},
 					Task{
-						GUID:       "task-2-guid",
-						SequenceID: 2,
-						Name:       "task-2",
-						State:      "FAILED",
-						CreatedAt:  "2016-11-07T06:59:01Z",
-						Command:    "some-command",
+						GUID:          "task-2-guid",
+						SequenceID:    2,
+						Name:          "task-2",
+						State:         "FAILED",
+						CreatedAt:     "2016-11-07T06:59:01Z",
+						Command:       "some-command",
+						FailureReason: "Exited with status 1",
 					},
 					Task{
 						GUID:       "task-3-guid",
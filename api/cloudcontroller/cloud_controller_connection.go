@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -95,6 +96,12 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		}
 	}
 
+	if passedResponse.Writer != nil && response.StatusCode < 400 {
+		defer response.Body.Close()
+		_, err := io.Copy(passedResponse.Writer, response.Body)
+		return err
+	}
+
 	rawBytes, err := ioutil.ReadAll(response.Body)
 	defer response.Body.Close()
 	if err != nil {
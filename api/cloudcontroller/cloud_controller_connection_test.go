@@ -1,6 +1,7 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -74,6 +75,24 @@ var _ = Describe("Cloud Controller Connection", func() {
 				})
 			})
 
+			Context("when passed a response with a writer", func() {
+				It("writes the body to the writer instead of unmarshalling it", func() {
+					var body DummyResponse
+					buffer := new(bytes.Buffer)
+					response := Response{
+						Result: &body,
+						Writer: buffer,
+					}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).To(ContainSubstring(`"val1":"2.59.0"`))
+					Expect(response.RawResponse).To(BeEmpty())
+					Expect(body).To(Equal(DummyResponse{}))
+				})
+			})
+
 			Context("when passed an empty response", func() {
 				It("skips the unmarshalling step", func() {
 					var response Response
@@ -251,6 +270,18 @@ var _ = Describe("Cloud Controller Connection", func() {
 
 					Expect(server.ReceivedRequests()).To(HaveLen(1))
 				})
+
+				It("does not write the error to the response's writer", func() {
+					req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+					request := &Request{Request: req}
+
+					buffer := new(bytes.Buffer)
+					response := Response{Writer: buffer}
+					err = connection.Make(request, &response)
+					Expect(err).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+					Expect(buffer.Len()).To(BeZero())
+				})
 			})
 		})
 	})
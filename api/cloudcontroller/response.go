@@ -1,6 +1,9 @@
 package cloudcontroller
 
-import "net/http"
+import (
+	"io"
+	"net/http"
+)
 
 // Response represents a Cloud Controller response object.
 type Response struct {
@@ -11,6 +14,10 @@ type Response struct {
 	// RawResponse represents the response body.
 	RawResponse []byte
 
+	// Writer, when set, receives the body of a successful response instead of
+	// RawResponse, so that large downloads are not held in memory.
+	Writer io.Writer
+
 	// Warnings represents warnings parsed from the custom warnings headers of a
 	// Cloud Controller response.
 	Warnings []string
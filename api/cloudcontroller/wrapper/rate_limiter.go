@@ -0,0 +1,42 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+)
+
+// RateLimiter is a wrapper that holds requests back so that no more than the
+// limiter's requests per second are sent to the Cloud Controller.
+type RateLimiter struct {
+	limiter    *ratelimit.Limiter
+	connection cloudcontroller.Connection
+}
+
+// NewRateLimiter returns a pointer to a RateLimiter wrapper.
+func NewRateLimiter(limiter *ratelimit.Limiter) *RateLimiter {
+	return &RateLimiter{
+		limiter: limiter,
+	}
+}
+
+// Wrap sets the connection in the RateLimiter and returns itself.
+func (rateLimiter *RateLimiter) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	rateLimiter.connection = innerconnection
+	return rateLimiter
+}
+
+// Make waits for the request's turn and then makes it. Requests that had to
+// wait are marked so that the request logger can show the delay.
+func (rateLimiter *RateLimiter) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	delay := rateLimiter.limiter.Wait()
+
+	_, wasThrottled := ratelimit.ThrottleFromRequest(request.Request)
+	if delay > 0 || wasThrottled {
+		request.Request = ratelimit.WithThrottle(request.Request, ratelimit.Throttle{
+			Delay:             delay,
+			RequestsPerSecond: rateLimiter.limiter.RequestsPerSecond,
+		})
+	}
+
+	return rateLimiter.connection.Make(request, passedResponse)
+}
@@ -0,0 +1,76 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rate Limiter", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		limiter        *ratelimit.Limiter
+		slept          []time.Duration
+
+		wrapper  cloudcontroller.Connection
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		now := time.Now()
+		slept = nil
+		limiter = ratelimit.NewLimiter(1)
+		limiter.Now = func() time.Time { return now }
+		limiter.Sleep = func(delay time.Duration) { slept = append(slept, delay) }
+
+		wrapper = NewRateLimiter(limiter).Wrap(fakeConnection)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+	})
+
+	It("makes the request and returns its error", func() {
+		fakeConnection.MakeReturns(errors.New("some-error"))
+
+		err := wrapper.Make(request, response)
+		Expect(err).To(MatchError("some-error"))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	Context("when the request is within the limit", func() {
+		It("does not wait or mark the request", func() {
+			Expect(wrapper.Make(request, response)).To(Succeed())
+			Expect(slept).To(BeEmpty())
+
+			madeRequest, _ := fakeConnection.MakeArgsForCall(0)
+			_, ok := ratelimit.ThrottleFromRequest(madeRequest.Request)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when the request is over the limit", func() {
+		It("waits and marks the request as throttled", func() {
+			Expect(wrapper.Make(request, response)).To(Succeed())
+			Expect(wrapper.Make(request, response)).To(Succeed())
+			Expect(slept).To(Equal([]time.Duration{time.Second}))
+
+			madeRequest, _ := fakeConnection.MakeArgsForCall(1)
+			throttle, ok := ratelimit.ThrottleFromRequest(madeRequest.Request)
+			Expect(ok).To(BeTrue())
+			Expect(throttle).To(Equal(ratelimit.Throttle{Delay: time.Second, RequestsPerSecond: 1}))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package wrapper
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+//go:generate counterfeiter . Cassette
+
+// Cassette records requests and their responses, and replays them.
+type Cassette interface {
+	Record(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error
+	Replaying() bool
+	RoundTrip(request *http.Request) (*http.Response, error)
+}
+
+// Recorder is a wrapper that records requests to and responses from the
+// Cloud Controller in a cassette or, when the cassette is replaying, responds
+// to requests from the cassette instead of the Cloud Controller.
+type Recorder struct {
+	cassette   Cassette
+	connection cloudcontroller.Connection
+}
+
+// NewRecorder returns a pointer to a Recorder wrapper.
+func NewRecorder(cassette Cassette) *Recorder {
+	return &Recorder{
+		cassette: cassette,
+	}
+}
+
+// Wrap sets the connection in the Recorder and returns itself. When the
+// cassette is replaying, the connection is replaced with one that sends
+// requests to the cassette.
+func (recorder *Recorder) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	recorder.connection = innerconnection
+	if recorder.cassette.Replaying() {
+		recorder.connection = &cloudcontroller.CloudControllerConnection{
+			HTTPClient: &http.Client{Transport: recorder.cassette},
+		}
+	}
+	return recorder
+}
+
+// Make records the request and its response in the cassette.
+func (recorder *Recorder) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if recorder.cassette.Replaying() {
+		return recorder.connection.Make(request, passedResponse)
+	}
+
+	var rawRequestBody []byte
+	if request.Body != nil && !strings.HasPrefix(request.Header.Get("Content-Type"), "multipart/") {
+		var err error
+		rawRequestBody, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return err
+		}
+
+		err = request.ResetBody()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := recorder.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		recordErr := recorder.cassette.Record(request.Request, rawRequestBody, passedResponse.HTTPResponse, passedResponse.RawResponse)
+		if recordErr != nil {
+			return recordErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,173 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeCassette   *wrapperfakes.FakeCassette
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeCassette = new(wrapperfakes.FakeCassette)
+
+		body := bytes.NewReader([]byte(`{"name":"some-app"}`))
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps", body)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		request = cloudcontroller.NewRequest(req, body)
+
+		var result map[string]string
+		response = &cloudcontroller.Response{Result: &result}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewRecorder(fakeCassette).Wrap(fakeConnection)
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when the cassette is recording", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusCreated}
+			fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"name":"some-app"}`))
+
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"guid":"some-guid"}`)
+				return nil
+			}
+		})
+
+		It("makes the request and records it with its response", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeCassette.RecordCallCount()).To(Equal(1))
+			recordedRequest, recordedRequestBody, recordedResponse, recordedResponseBody := fakeCassette.RecordArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request.Request))
+			Expect(string(recordedRequestBody)).To(Equal(`{"name":"some-app"}`))
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(string(recordedResponseBody)).To(Equal(`{"guid":"some-guid"}`))
+		})
+
+		Context("when the request fails with a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+					return ccerror.ResourceNotFoundError{}
+				}
+			})
+
+			It("records the response and returns the error", func() {
+				Expect(makeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+				Expect(fakeCassette.RecordCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request fails without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("no network")})
+			})
+
+			It("does not record anything", func() {
+				Expect(makeErr).To(MatchError(ccerror.RequestError{Err: errors.New("no network")}))
+				Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeCassette.RecordReturns(errors.New("disk full"))
+			})
+
+			It("returns the error", func() {
+				Expect(makeErr).To(MatchError("disk full"))
+			})
+		})
+
+		Context("when the request body is multipart", func() {
+			BeforeEach(func() {
+				request.Header.Set("Content-Type", "multipart/form-data; boundary=abc")
+				fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					passedResponse.HTTPResponse = httpResponse
+					return nil
+				}
+			})
+
+			It("does not record the body", func() {
+				Expect(fakeCassette.RecordCallCount()).To(Equal(1))
+				_, recordedRequestBody, _, _ := fakeCassette.RecordArgsForCall(0)
+				Expect(recordedRequestBody).To(BeNil())
+			})
+		})
+	})
+
+	Context("when the cassette is replaying", func() {
+		BeforeEach(func() {
+			fakeCassette.ReplayingReturns(true)
+			fakeCassette.RoundTripStub = func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Header:     http.Header{"X-Cf-Warnings": {"some-warning"}},
+					Body:       ioutil.NopCloser(strings.NewReader(`{"guid":"some-guid"}`)),
+				}, nil
+			}
+		})
+
+		It("responds from the cassette without making the request", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+
+			Expect(fakeCassette.RoundTripCallCount()).To(Equal(1))
+			Expect(fakeCassette.RoundTripArgsForCall(0).URL.Path).To(Equal("/v2/apps"))
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(*response.Result.(*map[string]string)).To(Equal(map[string]string{"guid": "some-guid"}))
+		})
+
+		Context("when the recorded response is an error", func() {
+			BeforeEach(func() {
+				fakeCassette.RoundTripStub = func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       ioutil.NopCloser(strings.NewReader(`{"code":100004}`)),
+					}, nil
+				}
+			})
+
+			It("returns the same error the Cloud Controller would have", func() {
+				Expect(makeErr).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+				Expect(makeErr.(ccerror.RawHTTPStatusError).StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
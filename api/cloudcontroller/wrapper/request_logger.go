@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -77,6 +79,18 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	if err != nil {
 		return err
 	}
+	if attempt, ok := retry.AttemptFromRequest(request.Request); ok {
+		err = logger.output.DisplayMessage(attempt.String())
+		if err != nil {
+			return err
+		}
+	}
+	if throttle, ok := ratelimit.ThrottleFromRequest(request.Request); ok {
+		err = logger.output.DisplayMessage(throttle.String())
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
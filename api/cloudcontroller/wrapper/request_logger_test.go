@@ -12,6 +12,8 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+	"code.cloudfoundry.org/cli/api/retry"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -95,6 +97,33 @@ var _ = Describe("Request Logger", func() {
 			Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(0))
 		})
 
+		Context("when the request is a retry", func() {
+			var attempt retry.Attempt
+
+			BeforeEach(func() {
+				attempt = retry.Attempt{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "503 Service Unavailable"}
+				request.Request = retry.WithAttempt(request.Request, attempt)
+			})
+
+			It("outputs the retry attempt after the request type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(BeNumerically(">=", 1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Retry 1 of 2 after 1s: 503 Service Unavailable]"))
+			})
+		})
+
+		Context("when the request was throttled", func() {
+			BeforeEach(func() {
+				request.Request = ratelimit.WithThrottle(request.Request, ratelimit.Throttle{Delay: 500 * time.Millisecond, RequestsPerSecond: 2})
+			})
+
+			It("outputs the delay after the request type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(BeNumerically(">=", 1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Throttled for 500ms by the limit of 2 requests per second]"))
+			})
+		})
+
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
 				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
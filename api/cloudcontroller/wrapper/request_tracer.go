@@ -0,0 +1,34 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/tracing"
+)
+
+// RequestTracer is a wrapper that records a span for each request to the
+// Cloud Controller and propagates it in the request's traceparent header.
+type RequestTracer struct {
+	connection cloudcontroller.Connection
+	tracer     *tracing.Tracer
+}
+
+// NewRequestTracer returns a pointer to a RequestTracer wrapper.
+func NewRequestTracer(tracer *tracing.Tracer) *RequestTracer {
+	return &RequestTracer{
+		tracer: tracer,
+	}
+}
+
+// Wrap sets the connection in the RequestTracer and returns itself.
+func (t *RequestTracer) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	t.connection = innerconnection
+	return t
+}
+
+// Make records a span for the request.
+func (t *RequestTracer) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	span := t.tracer.StartRequest("cloud_controller", request.Request)
+	err := t.connection.Make(request, passedResponse)
+	span.FinishRequest(passedResponse.HTTPResponse, int64(len(passedResponse.RawResponse)), err)
+	return err
+}
@@ -0,0 +1,78 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Tracer", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeExporter   *tracingfakes.FakeExporter
+		tracer         *tracing.Tracer
+		root           *tracing.Span
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = tracing.NewTracer(fakeExporter)
+		root = tracer.StartCommand("cf apps")
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+
+		fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			Expect(req.Header.Get("traceparent")).To(MatchRegexp("^00-%s-[0-9a-f]{16}-01$", root.TraceID))
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"X-Vcap-Request-Id": {"some-request-id"}},
+			}
+			return ccerror.ResourceNotFoundError{}
+		}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewRequestTracer(tracer).Wrap(fakeConnection)
+		makeErr = wrapper.Make(request, response)
+
+		root.Finish()
+		Expect(tracer.Flush()).To(Succeed())
+	})
+
+	It("records a child span of the command for the request", func() {
+		Expect(makeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+		Expect(fakeExporter.ExportCallCount()).To(Equal(1))
+		spans := fakeExporter.ExportArgsForCall(0)
+		Expect(spans).To(HaveLen(2))
+
+		span := spans[0]
+		Expect(span.Name).To(Equal("GET /v2/apps"))
+		Expect(span.Kind).To(Equal(tracing.SpanKindClient))
+		Expect(span.ParentSpanID).To(Equal(root.SpanID))
+		Expect(request.Header.Get("traceparent")).To(Equal(span.TraceParent()))
+		Expect(span.Attributes).To(HaveKeyWithValue("peer.service", "cloud_controller"))
+		Expect(span.Attributes).To(HaveKeyWithValue("http.response.status_code", "404"))
+		Expect(span.Attributes).To(HaveKeyWithValue("cf.request_id", "some-request-id"))
+		Expect(span.Error).To(Equal(ccerror.ResourceNotFoundError{}.Error()))
+	})
+})
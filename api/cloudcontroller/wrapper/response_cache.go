@@ -0,0 +1,158 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/httpcache"
+)
+
+//go:generate counterfeiter . ResponseStore
+
+// ResponseStore stores responses by namespace, resource collection and
+// request URL.
+type ResponseStore interface {
+	Get(namespace string, collection string, key string) (httpcache.Entry, bool)
+	Invalidate(namespace string, collection string) error
+	Put(namespace string, collection string, key string, entry httpcache.Entry) error
+}
+
+// ResponseCache is a wrapper that responds to GET requests for resources with
+// a TTL from a response store. Stored responses are used as they are until
+// their TTL has passed and are then revalidated with their ETag. Any other
+// request to a resource collection removes that collection's stored
+// responses.
+type ResponseCache struct {
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	store      ResponseStore
+	ttls       httpcache.TTLs
+	namespace  func() string
+	connection cloudcontroller.Connection
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper. namespace is
+// called for every request and should identify the targeted Cloud Controller
+// and the logged in user, so that responses are never shared between them.
+func NewResponseCache(store ResponseStore, ttls httpcache.TTLs, namespace func() string) *ResponseCache {
+	return &ResponseCache{
+		Now:       time.Now,
+		store:     store,
+		ttls:      ttls,
+		namespace: namespace,
+	}
+}
+
+// Wrap sets the connection in the ResponseCache and returns itself.
+func (responseCache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	responseCache.connection = innerconnection
+	return responseCache
+}
+
+// Make responds to cacheable requests from the store when it can, and makes
+// every other request.
+func (responseCache *ResponseCache) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	collection := httpcache.Collection(request.URL.Path)
+
+	switch request.Method {
+	case http.MethodGet:
+	case http.MethodHead, http.MethodOptions:
+		return responseCache.connection.Make(request, passedResponse)
+	default:
+		err := responseCache.connection.Make(request, passedResponse)
+		invalidateErr := responseCache.store.Invalidate(responseCache.namespace(), collection)
+		if err != nil {
+			return err
+		}
+		return invalidateErr
+	}
+
+	ttl, cacheable := responseCache.ttls.For(request.URL.Path)
+	if !cacheable {
+		return responseCache.connection.Make(request, passedResponse)
+	}
+
+	namespace := responseCache.namespace()
+	key := request.URL.String()
+
+	entry, found := responseCache.store.Get(namespace, collection, key)
+	if found && responseCache.Now().Sub(entry.StoredAt) < ttl {
+		return respondFromEntry(entry, request, passedResponse)
+	}
+
+	if found && entry.ETag() != "" {
+		request.Header.Set("If-None-Match", entry.ETag())
+		defer request.Header.Del("If-None-Match")
+	}
+
+	// The response is made without a result so that a 304 Not Modified, which
+	// has no body, is not decoded.
+	response := cloudcontroller.Response{}
+	err := responseCache.connection.Make(request, &response)
+	if err != nil {
+		passedResponse.RawResponse = response.RawResponse
+		passedResponse.Warnings = response.Warnings
+		passedResponse.HTTPResponse = response.HTTPResponse
+		return err
+	}
+
+	switch {
+	case response.HTTPResponse.StatusCode == http.StatusNotModified && found:
+		entry.StoredAt = responseCache.Now()
+	case response.HTTPResponse.StatusCode == http.StatusOK:
+		entry = httpcache.Entry{
+			StatusCode: response.HTTPResponse.StatusCode,
+			Header:     response.HTTPResponse.Header,
+			Body:       response.RawResponse,
+			StoredAt:   responseCache.Now(),
+		}
+	default:
+		return respondFromEntry(httpcache.Entry{
+			StatusCode: response.HTTPResponse.StatusCode,
+			Header:     response.HTTPResponse.Header,
+			Body:       response.RawResponse,
+		}, request, passedResponse)
+	}
+
+	err = responseCache.store.Put(namespace, collection, key, entry)
+	if err != nil {
+		return err
+	}
+
+	return respondFromEntry(entry, request, passedResponse)
+}
+
+// respondFromEntry populates passedResponse from entry the same way a
+// response from the Cloud Controller is, so that warnings and the result are
+// handled identically.
+func respondFromEntry(entry httpcache.Entry, request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	connection := &cloudcontroller.CloudControllerConnection{
+		HTTPClient: &http.Client{Transport: entryTransport(entry)},
+	}
+	return connection.Make(request, passedResponse)
+}
+
+type entryTransport httpcache.Entry
+
+func (entry entryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	header := http.Header{}
+	for key, values := range entry.Header {
+		header[key] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(entry.StatusCode),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       request,
+	}, nil
+}
@@ -0,0 +1,217 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/api/httpcache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeStore      *wrapperfakes.FakeResponseStore
+		now            time.Time
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		result   map[string]string
+		makeErr  error
+	)
+
+	newRequest := func(method string, url string) *cloudcontroller.Request {
+		req, err := http.NewRequest(method, url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return cloudcontroller.NewRequest(req, nil)
+	}
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeStore = new(wrapperfakes.FakeResponseStore)
+		now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		request = newRequest(http.MethodGet, "https://api.example.com/v2/stacks?page=1")
+		result = nil
+		response = &cloudcontroller.Response{Result: &result}
+	})
+
+	JustBeforeEach(func() {
+		cache := NewResponseCache(fakeStore, httpcache.TTLs{"/v2/stacks": time.Minute}, func() string { return "some-namespace" })
+		cache.Now = func() time.Time { return now }
+		wrapper = cache.Wrap(fakeConnection)
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when a response with a TTL is stored", func() {
+		var entry httpcache.Entry
+
+		BeforeEach(func() {
+			entry = httpcache.Entry{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Etag": {`"some-etag"`}, "X-Cf-Warnings": {"some-warning"}},
+				Body:       []byte(`{"name":"cached-stack"}`),
+				StoredAt:   now.Add(-30 * time.Second),
+			}
+			fakeStore.GetReturns(entry, true)
+		})
+
+		It("responds from the store without making the request", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+
+			Expect(fakeStore.GetCallCount()).To(Equal(1))
+			namespace, collection, key := fakeStore.GetArgsForCall(0)
+			Expect(namespace).To(Equal("some-namespace"))
+			Expect(collection).To(Equal("/v2/stacks"))
+			Expect(key).To(Equal("https://api.example.com/v2/stacks?page=1"))
+
+			Expect(result).To(Equal(map[string]string{"name": "cached-stack"}))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		Context("when the TTL has passed", func() {
+			BeforeEach(func() {
+				entry.StoredAt = now.Add(-2 * time.Minute)
+				fakeStore.GetReturns(entry, true)
+			})
+
+			Context("when the resource has not changed", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+						Expect(req.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+						passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotModified, Header: http.Header{}}
+						return nil
+					}
+				})
+
+				It("revalidates the stored response with its ETag and uses it", func() {
+					Expect(makeErr).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					Expect(result).To(Equal(map[string]string{"name": "cached-stack"}))
+
+					Expect(fakeStore.PutCallCount()).To(Equal(1))
+					_, _, _, storedEntry := fakeStore.PutArgsForCall(0)
+					Expect(storedEntry.Body).To(Equal(entry.Body))
+					Expect(storedEntry.StoredAt).To(Equal(now))
+				})
+			})
+
+			Context("when the resource has changed", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+						passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Etag": {`"new-etag"`}}}
+						passedResponse.RawResponse = []byte(`{"name":"new-stack"}`)
+						return nil
+					}
+				})
+
+				It("stores and uses the new response", func() {
+					Expect(makeErr).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "new-stack"}))
+
+					Expect(fakeStore.PutCallCount()).To(Equal(1))
+					namespace, collection, key, storedEntry := fakeStore.PutArgsForCall(0)
+					Expect(namespace).To(Equal("some-namespace"))
+					Expect(collection).To(Equal("/v2/stacks"))
+					Expect(key).To(Equal("https://api.example.com/v2/stacks?page=1"))
+					Expect(storedEntry.ETag()).To(Equal(`"new-etag"`))
+					Expect(string(storedEntry.Body)).To(Equal(`{"name":"new-stack"}`))
+				})
+			})
+		})
+	})
+
+	Context("when no response is stored", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				Expect(req.Header.Get("If-None-Match")).To(BeEmpty())
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+				passedResponse.RawResponse = []byte(`{"name":"some-stack"}`)
+				return nil
+			}
+		})
+
+		It("makes the request and stores the response", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(result).To(Equal(map[string]string{"name": "some-stack"}))
+			Expect(fakeStore.PutCallCount()).To(Equal(1))
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+					passedResponse.Warnings = []string{"some-warning"}
+					return ccerror.ResourceNotFoundError{}
+				}
+			})
+
+			It("returns the error without storing anything", func() {
+				Expect(makeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+				Expect(fakeStore.PutCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when storing the response fails", func() {
+			BeforeEach(func() {
+				fakeStore.PutReturns(errors.New("disk full"))
+			})
+
+			It("returns the error", func() {
+				Expect(makeErr).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Context("when the resource has no TTL", func() {
+		BeforeEach(func() {
+			request = newRequest(http.MethodGet, "https://api.example.com/v2/apps")
+		})
+
+		It("makes the request without using the store", func() {
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(fakeStore.GetCallCount()).To(Equal(0))
+			Expect(fakeStore.PutCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request changes a resource", func() {
+		BeforeEach(func() {
+			request = newRequest(http.MethodPut, "https://api.example.com/v2/stacks/some-guid")
+		})
+
+		It("makes the request and invalidates the resource's collection", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeStore.InvalidateCallCount()).To(Equal(1))
+			namespace, collection := fakeStore.InvalidateArgsForCall(0)
+			Expect(namespace).To(Equal("some-namespace"))
+			Expect(collection).To(Equal("/v2/stacks"))
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("no network")})
+			})
+
+			It("still invalidates the collection", func() {
+				Expect(makeErr).To(MatchError(ccerror.RequestError{Err: errors.New("no network")}))
+				Expect(fakeStore.InvalidateCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
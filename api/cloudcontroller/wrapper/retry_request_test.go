@@ -3,13 +3,18 @@ package wrapper_test
 import (
 	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -43,7 +48,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -59,6 +64,9 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -73,7 +81,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
@@ -105,7 +113,7 @@ var _ = Describe("Retry Request", func() {
 			expectedErr = errors.New("oh noes")
 			fakeConnection.MakeReturns(expectedErr)
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
@@ -114,4 +122,128 @@ var _ = Describe("Retry Request", func() {
 			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 		})
 	})
+
+	Context("when the policy has a backoff", func() {
+		var (
+			request  *cloudcontroller.Request
+			response *cloudcontroller.Response
+			sleeps   []time.Duration
+
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			wrapper        cloudcontroller.Connection
+		)
+
+		BeforeEach(func() {
+			sleeps = nil
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{
+				MaxRetries: 2,
+				Backoff:    time.Second,
+				Sleep:      func(delay time.Duration) { sleeps = append(sleeps, delay) },
+				Jitter:     func(n int64) int64 { return n / 2 },
+			}).Wrap(fakeConnection)
+		})
+
+		Context("when the server keeps failing", func() {
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				request = cloudcontroller.NewRequest(req, nil)
+				response = &cloudcontroller.Response{
+					HTTPResponse: &http.Response{StatusCode: http.StatusBadGateway},
+				}
+				fakeConnection.MakeReturns(ccerror.RawHTTPStatusError{StatusCode: http.StatusBadGateway})
+			})
+
+			It("waits exponentially longer between retries", func() {
+				_ = wrapper.Make(request, response)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(sleeps).To(Equal([]time.Duration{time.Second, 2 * time.Second}))
+			})
+
+			It("marks each retry on the request for the request logger", func() {
+				var attempts []retry.Attempt
+				fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					if attempt, ok := retry.AttemptFromRequest(req.Request); ok {
+						attempts = append(attempts, attempt)
+					}
+					return ccerror.RawHTTPStatusError{StatusCode: http.StatusBadGateway}
+				}
+
+				_ = wrapper.Make(request, response)
+				Expect(attempts).To(Equal([]retry.Attempt{
+					{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "502 Bad Gateway"},
+					{Retry: 2, MaxRetries: 2, Delay: 2 * time.Second, Reason: "502 Bad Gateway"},
+				}))
+			})
+		})
+
+		Context("when the server responds with a Retry-After header", func() {
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				request = cloudcontroller.NewRequest(req, nil)
+				response = &cloudcontroller.Response{
+					HTTPResponse: &http.Response{
+						StatusCode: http.StatusTooManyRequests,
+						Header:     http.Header{"Retry-After": {"7"}},
+					},
+				}
+				fakeConnection.MakeReturnsOnCall(0, ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+			})
+
+			It("waits as long as the server asks", func() {
+				err := wrapper.Make(request, response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(sleeps).To(Equal([]time.Duration{7 * time.Second}))
+			})
+		})
+
+		Context("when the connection is reset", func() {
+			var networkErr error
+
+			BeforeEach(func() {
+				response = &cloudcontroller.Response{}
+				networkErr = ccerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}
+				fakeConnection.MakeReturnsOnCall(0, networkErr)
+			})
+
+			It("retries idempotent requests", func() {
+				req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				request = cloudcontroller.NewRequest(req, nil)
+
+				err = wrapper.Make(request, response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			It("does not retry POST requests", func() {
+				req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				request = cloudcontroller.NewRequest(req, nil)
+
+				err = wrapper.Make(request, response)
+				Expect(err).To(MatchError(networkErr))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request fails with a non-network error", func() {
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				request = cloudcontroller.NewRequest(req, nil)
+				response = &cloudcontroller.Response{}
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("unsupported protocol scheme")})
+			})
+
+			It("does not retry", func() {
+				_ = wrapper.Make(request, response)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(sleeps).To(BeEmpty())
+			})
+		})
+	})
 })
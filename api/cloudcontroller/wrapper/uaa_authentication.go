@@ -1,6 +1,9 @@
 package wrapper
 
 import (
+	"sync"
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -15,10 +18,12 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SaveCredentials
+// persists refreshed tokens to the configured credential store.
 type TokenCache interface {
 	AccessToken() string
 	RefreshToken() string
+	SaveCredentials() error
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
 }
@@ -26,17 +31,24 @@ type TokenCache interface {
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
-	connection cloudcontroller.Connection
-	client     UAAClient
-	cache      TokenCache
+	connection  cloudcontroller.Connection
+	client      UAAClient
+	cache       TokenCache
+	refreshSkew time.Duration
+
+	// mutex keeps concurrent requests from refreshing the same token more
+	// than once.
+	mutex sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
-// the client and a token cache.
-func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+// the client and a token cache. Access tokens that expire within refreshSkew
+// are refreshed before the request is made.
+func NewUAAAuthentication(client UAAClient, cache TokenCache, refreshSkew time.Duration) *UAAAuthentication {
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:      client,
+		cache:       cache,
+		refreshSkew: refreshSkew,
 	}
 }
 
@@ -52,25 +64,29 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 }
 
 // Make adds authentication headers to the passed in request and then calls the
-// wrapped connection's Make. If the client is not set on the wrapper, it will
-// not add any header or handle any authentication errors.
+// wrapped connection's Make. An access token that is about to expire is
+// refreshed first, so that requests with bodies that cannot be replayed are not
+// rejected. If the client is not set on the wrapper, it will not add any
+// header or handle any authentication errors.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken, err := t.validAccessToken()
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err = t.replaceAccessToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -80,9 +96,52 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
+
+// validAccessToken returns the cached access token, refreshing it first if it
+// expires within the refresh skew.
+func (t *UAAAuthentication) validAccessToken() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !uaa.AccessTokenValidFor(t.cache.AccessToken(), t.refreshSkew) {
+		err := t.refreshToken()
+		if err != nil {
+			return "", err
+		}
+	}
+	return t.cache.AccessToken(), nil
+}
+
+// replaceAccessToken refreshes an access token the Cloud Controller rejected,
+// unless a concurrent request has already replaced it, and returns the new
+// token.
+func (t *UAAAuthentication) replaceAccessToken(rejectedToken string) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.cache.AccessToken() == rejectedToken {
+		err := t.refreshToken()
+		if err != nil {
+			return "", err
+		}
+	}
+	return t.cache.AccessToken(), nil
+}
+
+// refreshToken gets a new access token and saves it in the token cache.
+func (t *UAAAuthentication) refreshToken() error {
+	token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(token.AuthorizationToken())
+	t.cache.SetRefreshToken(token.RefreshToken)
+	return t.cache.SaveCredentials()
+}
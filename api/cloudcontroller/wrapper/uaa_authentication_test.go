@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -35,7 +36,7 @@ var _ = Describe("UAA Authentication", func() {
 		inMemoryCache = util.NewInMemoryTokenCache()
 		inMemoryCache.SetAccessToken("a-ok")
 
-		inner = NewUAAAuthentication(fakeClient, inMemoryCache)
+		inner = NewUAAAuthentication(fakeClient, inMemoryCache, time.Minute)
 		wrapper = inner.Wrap(fakeConnection)
 
 		request = &cloudcontroller.Request{
@@ -106,6 +107,64 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token expires within the refresh skew", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(accessTokenExpiringIn(30 * time.Second))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
+			})
+
+			Context("when refreshing the token fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("refresh failed")
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, expectedErr)
+				})
+
+				It("returns the error without making the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the token expires after the refresh skew", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(accessTokenExpiringIn(time.Hour))
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var (
 				expectedBody string
@@ -171,6 +230,59 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 
+			Context("when the token cache saves credentials to a store", func() {
+				var fakeCache *wrapperfakes.FakeTokenCache
+
+				BeforeEach(func() {
+					fakeCache = new(wrapperfakes.FakeTokenCache)
+					fakeCache.AccessTokenReturns("bearer foobar-2")
+					inner = NewUAAAuthentication(fakeClient, fakeCache, time.Minute)
+					wrapper = inner.Wrap(fakeConnection)
+				})
+
+				It("saves the refreshed tokens before resending the request", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCache.SetAccessTokenArgsForCall(0)).To(Equal("bearer foobar-2"))
+					Expect(fakeCache.SetRefreshTokenArgsForCall(0)).To(Equal("bananananananana"))
+					Expect(fakeCache.SaveCredentialsCallCount()).To(Equal(1))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				})
+
+				Context("when saving the credentials fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("credential helper failed")
+						fakeCache.SaveCredentialsReturns(expectedErr)
+					})
+
+					It("returns the error without resending the request", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when a concurrent request has already replaced the token", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+						if fakeConnection.MakeCallCount() == 1 {
+							inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+							return ccerror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with that token without refreshing again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+
+					request, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(request.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+				})
+			})
+
 			Context("when a PipeSeekError is returned from ResetBody", func() {
 				BeforeEach(func() {
 					body, writer := cloudcontroller.NewPipeBomb()
@@ -13,6 +13,10 @@ func (c InMemoryCache) RefreshToken() string {
 	return c.refreshToken
 }
 
+func (c InMemoryCache) SaveCredentials() error {
+	return nil
+}
+
 func (c *InMemoryCache) SetAccessToken(token string) {
 	c.accessToken = token
 }
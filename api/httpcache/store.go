@@ -0,0 +1,125 @@
+// Package httpcache stores Cloud Controller responses on disk so that
+// read-heavy commands can reuse them instead of requesting the same resources
+// again.
+//
+// Responses are stored by namespace, resource collection and request URL.
+// The namespace separates the responses seen by different users of different
+// Cloud Controllers, and the collection lets every response for a collection
+// be dropped at once when one of its resources changes.
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Entry is a stored response.
+type Entry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+}
+
+// ETag returns the entity tag the Cloud Controller sent with the response,
+// if any.
+func (entry Entry) ETag() string {
+	return entry.Header.Get("ETag")
+}
+
+// Store is a response cache stored in a directory.
+type Store struct {
+	Dir string
+}
+
+// NewStore returns a store in dir. The directory is created when the first
+// response is stored.
+func NewStore(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// Get returns the response stored for key. Entries that cannot be read are
+// treated as missing.
+func (store *Store) Get(namespace string, collection string, key string) (Entry, bool) {
+	raw, err := ioutil.ReadFile(store.entryPath(namespace, collection, key))
+	if err != nil {
+		return Entry{}, false
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// Put stores the response for key, replacing any response already stored.
+func (store *Store) Put(namespace string, collection string, key string, entry Entry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := store.entryPath(namespace, collection, key)
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	// Writing to a temporary file and renaming it means other processes never
+	// read a partially written entry.
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+	}
+	return err
+}
+
+// Invalidate removes every response stored for the collection.
+func (store *Store) Invalidate(namespace string, collection string) error {
+	return os.RemoveAll(filepath.Join(store.Dir, hash(namespace), hash(collection)))
+}
+
+// Clear removes every stored response.
+func (store *Store) Clear() error {
+	return os.RemoveAll(store.Dir)
+}
+
+func (store *Store) entryPath(namespace string, collection string, key string) string {
+	return filepath.Join(store.Dir, hash(namespace), hash(collection), hash(key)+".json")
+}
+
+// Collection returns the resource collection the resource at path belongs
+// to, which is made up of the API version and the resource type. For example
+// both /v2/apps and /v2/apps/some-guid/routes are in the /v2/apps collection.
+func Collection(path string) string {
+	segments := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,107 @@
+package httpcache_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/httpcache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		dir   string
+		store *Store
+		entry Entry
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "httpcache")
+		Expect(err).ToNot(HaveOccurred())
+		store = NewStore(filepath.Join(dir, "http-cache"))
+
+		entry = Entry{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": {`"some-etag"`}},
+			Body:       []byte(`{"name":"some-stack"}`),
+			StoredAt:   time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC),
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("returns stored entries", func() {
+		Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks", entry)).To(Succeed())
+
+		storedEntry, found := store.Get("some-user", "/v2/stacks", "https://api.example.com/v2/stacks")
+		Expect(found).To(BeTrue())
+		Expect(storedEntry).To(Equal(entry))
+		Expect(storedEntry.ETag()).To(Equal(`"some-etag"`))
+	})
+
+	It("keeps the entries of each namespace separate", func() {
+		Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks", entry)).To(Succeed())
+
+		_, found := store.Get("other-user", "/v2/stacks", "https://api.example.com/v2/stacks")
+		Expect(found).To(BeFalse())
+	})
+
+	It("only lets the user read the stored entries", func() {
+		Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks", entry)).To(Succeed())
+
+		err := filepath.Walk(store.Dir, func(path string, info os.FileInfo, err error) error {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm() & 0077).To(BeZero())
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Invalidate", func() {
+		It("removes the entries of the collection", func() {
+			Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks", entry)).To(Succeed())
+			Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks/some-guid", entry)).To(Succeed())
+			Expect(store.Put("some-user", "/v2/info", "https://api.example.com/v2/info", entry)).To(Succeed())
+
+			Expect(store.Invalidate("some-user", "/v2/stacks")).To(Succeed())
+
+			_, found := store.Get("some-user", "/v2/stacks", "https://api.example.com/v2/stacks")
+			Expect(found).To(BeFalse())
+			_, found = store.Get("some-user", "/v2/stacks", "https://api.example.com/v2/stacks/some-guid")
+			Expect(found).To(BeFalse())
+			_, found = store.Get("some-user", "/v2/info", "https://api.example.com/v2/info")
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Describe("Clear", func() {
+		It("removes every entry", func() {
+			Expect(store.Put("some-user", "/v2/stacks", "https://api.example.com/v2/stacks", entry)).To(Succeed())
+
+			Expect(store.Clear()).To(Succeed())
+			_, err := os.Stat(store.Dir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("succeeds when nothing is stored", func() {
+			Expect(store.Clear()).To(Succeed())
+		})
+	})
+
+	Describe("Collection", func() {
+		It("returns the API version and resource type of the path", func() {
+			Expect(Collection("/v2/apps")).To(Equal("/v2/apps"))
+			Expect(Collection("/v2/apps/some-guid/routes")).To(Equal("/v2/apps"))
+			Expect(Collection("/v2/info")).To(Equal("/v2/info"))
+			Expect(Collection("/")).To(Equal("/"))
+		})
+	})
+})
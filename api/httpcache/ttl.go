@@ -0,0 +1,43 @@
+package httpcache
+
+import (
+	"strings"
+	"time"
+)
+
+// TTLs are how long responses are used without being revalidated, by the
+// path of the resource. A TTL applies to the resource at the path and every
+// resource below it, except that the TTL of "/" only applies to the root
+// resource. Responses for resources without a TTL are not cached.
+type TTLs map[string]time.Duration
+
+// DefaultTTLs are the TTLs of the resources that most commands read and that
+// rarely change.
+var DefaultTTLs = TTLs{
+	"/":                        10 * time.Minute,
+	"/v2/info":                 10 * time.Minute,
+	"/v2/config/feature_flags": time.Minute,
+	"/v2/shared_domains":       5 * time.Minute,
+	"/v2/stacks":               10 * time.Minute,
+}
+
+// For returns the TTL of the resource at path, from the longest matching
+// path in ttls.
+func (ttls TTLs) For(path string) (time.Duration, bool) {
+	var (
+		ttl     time.Duration
+		matched string
+		found   bool
+	)
+
+	for prefix, prefixTTL := range ttls {
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		if !found || len(prefix) > len(matched) {
+			ttl, matched, found = prefixTTL, prefix, true
+		}
+	}
+
+	return ttl, found
+}
@@ -0,0 +1,38 @@
+package httpcache_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpcache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TTLs", func() {
+	var ttls httpcache.TTLs
+
+	BeforeEach(func() {
+		ttls = httpcache.TTLs{
+			"/":                    time.Hour,
+			"/v2/config":           time.Minute,
+			"/v2/config/some-flag": time.Second,
+		}
+	})
+
+	DescribeTable("For",
+		func(path string, expectedTTL time.Duration, expectedFound bool) {
+			ttl, found := ttls.For(path)
+			Expect(found).To(Equal(expectedFound))
+			Expect(ttl).To(Equal(expectedTTL))
+		},
+
+		Entry("the root resource", "/", time.Hour, true),
+		Entry("a resource with a TTL", "/v2/config", time.Minute, true),
+		Entry("a resource below a resource with a TTL", "/v2/config/other-flag", time.Minute, true),
+		Entry("the longest matching path", "/v2/config/some-flag", time.Second, true),
+		Entry("a resource that only shares a prefix", "/v2/configs", time.Duration(0), false),
+		Entry("a resource without a TTL", "/v2/apps", time.Duration(0), false),
+	)
+})
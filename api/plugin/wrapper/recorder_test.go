@@ -0,0 +1,114 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/plugin/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fakeConnection *pluginfakes.FakeConnection
+		fakeCassette   *wrapperfakes.FakeCassette
+
+		wrapper plugin.Connection
+
+		request  *http.Request
+		response *plugin.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(pluginfakes.FakeConnection)
+		fakeCassette = new(wrapperfakes.FakeCassette)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://plugins.example.com/list", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		var result map[string]string
+		response = &plugin.Response{Result: &result}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewRecorder(fakeCassette).Wrap(fakeConnection)
+		makeErr = wrapper.Make(request, response, nil)
+	})
+
+	Context("when the cassette is recording", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusOK}
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"plugins":"some-plugins"}`)
+				return nil
+			}
+		})
+
+		It("makes the request and records it with its response", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeCassette.RecordCallCount()).To(Equal(1))
+			recordedRequest, recordedRequestBody, recordedResponse, recordedResponseBody := fakeCassette.RecordArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(recordedRequestBody).To(BeNil())
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(string(recordedResponseBody)).To(Equal(`{"plugins":"some-plugins"}`))
+		})
+
+		Context("when the request fails without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(pluginerror.RequestError{Err: errors.New("no network")})
+			})
+
+			It("does not record anything", func() {
+				Expect(makeErr).To(MatchError(pluginerror.RequestError{Err: errors.New("no network")}))
+				Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeCassette.RecordReturns(errors.New("disk full"))
+			})
+
+			It("returns the error", func() {
+				Expect(makeErr).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Context("when the cassette is replaying", func() {
+		BeforeEach(func() {
+			fakeCassette.ReplayingReturns(true)
+			fakeCassette.RoundTripReturns(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"plugins":"some-plugins"}`)),
+			}, nil)
+		})
+
+		It("responds from the cassette without making the request", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+			Expect(fakeCassette.RoundTripCallCount()).To(Equal(1))
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(*response.Result.(*map[string]string)).To(Equal(map[string]string{"plugins": "some-plugins"}))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -18,6 +19,7 @@ type RequestLoggerOutput interface {
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
+	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
@@ -76,6 +78,12 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	if attempt, ok := retry.AttemptFromRequest(request); ok {
+		err = logger.output.DisplayMessage(attempt.String())
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
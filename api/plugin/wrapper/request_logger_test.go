@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
 	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/api/retry"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -99,6 +100,21 @@ var _ = Describe("Request Logger", func() {
 			Expect(proxyReader).To(Equal(fakeProxyReader))
 		})
 
+		Context("when the request is a retry", func() {
+			var attempt retry.Attempt
+
+			BeforeEach(func() {
+				attempt = retry.Attempt{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "503 Service Unavailable"}
+				request = retry.WithAttempt(request, attempt)
+			})
+
+			It("outputs the retry attempt after the request type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(BeNumerically(">=", 1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Retry 1 of 2 after 1s: 503 Service Unavailable]"))
+			})
+		})
+
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
 				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
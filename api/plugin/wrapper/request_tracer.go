@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/tracing"
+)
+
+// RequestTracer is a wrapper that records a span for each request to a
+// plugin repository and propagates it in the request's traceparent header.
+type RequestTracer struct {
+	connection plugin.Connection
+	tracer     *tracing.Tracer
+}
+
+// NewRequestTracer returns a pointer to a RequestTracer wrapper.
+func NewRequestTracer(tracer *tracing.Tracer) *RequestTracer {
+	return &RequestTracer{
+		tracer: tracer,
+	}
+}
+
+// Wrap sets the connection in the RequestTracer and returns itself.
+func (t *RequestTracer) Wrap(innerconnection plugin.Connection) plugin.Connection {
+	t.connection = innerconnection
+	return t
+}
+
+// Make records a span for the request.
+func (t *RequestTracer) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+	span := t.tracer.StartRequest("plugin_repository", request)
+	err := t.connection.Make(request, passedResponse, proxyReader)
+	span.FinishRequest(passedResponse.HTTPResponse, int64(len(passedResponse.RawResponse)), err)
+	return err
+}
@@ -0,0 +1,63 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Tracer", func() {
+	var (
+		fakeConnection *pluginfakes.FakeConnection
+		fakeExporter   *tracingfakes.FakeExporter
+		tracer         *tracing.Tracer
+		root           *tracing.Span
+
+		request *http.Request
+		makeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(pluginfakes.FakeConnection)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = tracing.NewTracer(fakeExporter)
+		root = tracer.StartCommand("cf repo-plugins")
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://plugins.example.com/list", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection.MakeStub = func(req *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusInternalServerError}
+			return pluginerror.RawHTTPStatusError{Status: "500"}
+		}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = NewRequestTracer(tracer).Wrap(fakeConnection).Make(request, &plugin.Response{}, nil)
+
+		root.Finish()
+		Expect(tracer.Flush()).To(Succeed())
+	})
+
+	It("records a child span of the command for the request", func() {
+		Expect(makeErr).To(MatchError(pluginerror.RawHTTPStatusError{Status: "500"}))
+
+		spans := fakeExporter.ExportArgsForCall(0)
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name).To(Equal("GET /list"))
+		Expect(spans[0].ParentSpanID).To(Equal(root.SpanID))
+		Expect(spans[0].Attributes).To(HaveKeyWithValue("peer.service", "plugin_repository"))
+		Expect(spans[0].Attributes).To(HaveKeyWithValue("http.response.status_code", "500"))
+		Expect(spans[0].Error).ToNot(BeEmpty())
+		Expect(request.Header.Get("traceparent")).To(Equal(spans[0].TraceParent()))
+	})
+})
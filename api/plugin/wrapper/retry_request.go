@@ -6,30 +6,34 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests according to a retry
+// policy.
 type RetryRequest struct {
-	maxRetries int
+	policy     retry.Policy
 	connection plugin.Connection
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+func NewRetryRequest(policy retry.Policy) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
 // Wrap sets the connection in the RetryRequest and returns itself.
-func (retry *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connection {
-	retry.connection = innerconnection
-	return retry
+func (retryRequest *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
 }
 
-// Make retries the request if it comes back with a 5XX status code.
-func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+// Make retries the request if it comes back with a retryable status code or
+// fails with a retryable network error, waiting between attempts as the
+// policy dictates.
+func (retryRequest *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
 	var err error
 	var rawRequestBody []byte
 
@@ -41,25 +45,33 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Re
 		}
 	}
 
-	for i := 0; i < retry.maxRetries+1; i += 1 {
+	for i := 0; i < retryRequest.policy.MaxRetries+1; i += 1 {
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		err = retry.connection.Make(request, passedResponse, proxyReader)
+		err = retryRequest.connection.Make(request, passedResponse, proxyReader)
 		if err == nil {
 			return nil
 		}
 
-		// do not retry if the request method is POST, or not one of the following
-		// http status codes: 500, 502, 503, 504
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
+		var networkErr error
+		if requestErr, ok := err.(pluginerror.RequestError); ok {
+			networkErr = requestErr.Err
+		}
+
+		if i == retryRequest.policy.MaxRetries ||
+			!retryRequest.policy.ShouldRetry(request.Method, passedResponse.HTTPResponse, networkErr) {
 			break
 		}
+
+		delay := retryRequest.policy.Delay(i+1, passedResponse.HTTPResponse)
+		*request = *retry.WithAttempt(request, retry.Attempt{
+			Retry:      i + 1,
+			MaxRetries: retryRequest.policy.MaxRetries,
+			Delay:      delay,
+			Reason:     retry.Reason(passedResponse.HTTPResponse, networkErr),
+		})
+		retryRequest.policy.Wait(delay)
 	}
 	return err
 }
@@ -1,15 +1,21 @@
 package wrapper_test
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
 	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -42,7 +48,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response, nil)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -58,6 +64,9 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -71,7 +80,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(pluginfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 		fakeProxyReader := new(pluginfakes.FakeProxyReader)
 
 		err = wrapper.Make(request, response, fakeProxyReader)
@@ -80,4 +89,128 @@ var _ = Describe("Retry Request", func() {
 		_, _, proxyReader := fakeConnection.MakeArgsForCall(0)
 		Expect(proxyReader).To(Equal(fakeProxyReader))
 	})
+
+	Context("when the policy has a backoff", func() {
+		var (
+			request  *http.Request
+			response *plugin.Response
+			sleeps   []time.Duration
+
+			fakeConnection *pluginfakes.FakeConnection
+			wrapper        plugin.Connection
+		)
+
+		BeforeEach(func() {
+			sleeps = nil
+			fakeConnection = new(pluginfakes.FakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{
+				MaxRetries: 2,
+				Backoff:    time.Second,
+				Sleep:      func(delay time.Duration) { sleeps = append(sleeps, delay) },
+				Jitter:     func(n int64) int64 { return n / 2 },
+			}).Wrap(fakeConnection)
+		})
+
+		Context("when the server keeps failing", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &plugin.Response{
+					HTTPResponse: &http.Response{StatusCode: http.StatusBadGateway},
+				}
+				fakeConnection.MakeReturns(pluginerror.RawHTTPStatusError{Status: fmt.Sprintf("%d", http.StatusBadGateway)})
+			})
+
+			It("waits exponentially longer between retries", func() {
+				_ = wrapper.Make(request, response, nil)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(sleeps).To(Equal([]time.Duration{time.Second, 2 * time.Second}))
+			})
+
+			It("marks each retry on the request for the request logger", func() {
+				var attempts []retry.Attempt
+				fakeConnection.MakeStub = func(req *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+					if attempt, ok := retry.AttemptFromRequest(req); ok {
+						attempts = append(attempts, attempt)
+					}
+					return pluginerror.RawHTTPStatusError{Status: fmt.Sprintf("%d", http.StatusBadGateway)}
+				}
+
+				_ = wrapper.Make(request, response, nil)
+				Expect(attempts).To(Equal([]retry.Attempt{
+					{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "502 Bad Gateway"},
+					{Retry: 2, MaxRetries: 2, Delay: 2 * time.Second, Reason: "502 Bad Gateway"},
+				}))
+			})
+		})
+
+		Context("when the server responds with a Retry-After header", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &plugin.Response{
+					HTTPResponse: &http.Response{
+						StatusCode: http.StatusTooManyRequests,
+						Header:     http.Header{"Retry-After": {"7"}},
+					},
+				}
+				fakeConnection.MakeReturnsOnCall(0, pluginerror.RawHTTPStatusError{Status: fmt.Sprintf("%d", http.StatusTooManyRequests)})
+			})
+
+			It("waits as long as the server asks", func() {
+				err := wrapper.Make(request, response, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(sleeps).To(Equal([]time.Duration{7 * time.Second}))
+			})
+		})
+
+		Context("when the connection is reset", func() {
+			var networkErr error
+
+			BeforeEach(func() {
+				response = &plugin.Response{}
+				networkErr = pluginerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}
+				fakeConnection.MakeReturnsOnCall(0, networkErr)
+			})
+
+			It("retries idempotent requests", func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = wrapper.Make(request, response, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			It("does not retry POST requests", func() {
+				var err error
+				request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = wrapper.Make(request, response, nil)
+				Expect(err).To(MatchError(networkErr))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request fails with a non-network error", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &plugin.Response{}
+				fakeConnection.MakeReturns(pluginerror.RequestError{Err: errors.New("unsupported protocol scheme")})
+			})
+
+			It("does not retry", func() {
+				_ = wrapper.Make(request, response, nil)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(sleeps).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,85 @@
+// Package ratelimit contains the client side rate limiter used by the Cloud
+// Controller connection wrapper.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket that allows RequestsPerSecond requests every
+// second on average, with bursts of up to RequestsPerSecond requests. It is
+// safe for concurrent use.
+type Limiter struct {
+	// RequestsPerSecond is the rate at which requests are allowed. Zero or less
+	// means requests are never limited.
+	RequestsPerSecond int
+
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	// Sleep waits for a request's turn. It defaults to time.Sleep.
+	Sleep func(time.Duration)
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter that allows requestsPerSecond requests every
+// second.
+func NewLimiter(requestsPerSecond int) *Limiter {
+	return &Limiter{
+		RequestsPerSecond: requestsPerSecond,
+	}
+}
+
+// Reserve takes a token from the bucket and returns how long the caller has
+// to wait before the token is available. Tokens are handed out in the order
+// they are reserved, so a caller that has to wait holds its place in line.
+func (limiter *Limiter) Reserve() time.Duration {
+	if limiter.RequestsPerSecond <= 0 {
+		return 0
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	rate := float64(limiter.RequestsPerSecond)
+	now := limiter.now()
+	if limiter.last.IsZero() {
+		limiter.tokens = rate
+	} else {
+		limiter.tokens += now.Sub(limiter.last).Seconds() * rate
+		if limiter.tokens > rate {
+			limiter.tokens = rate
+		}
+	}
+	limiter.last = now
+
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-limiter.tokens / rate * float64(time.Second))
+}
+
+// Wait blocks until a request is allowed and returns how long it waited.
+func (limiter *Limiter) Wait() time.Duration {
+	delay := limiter.Reserve()
+	if delay > 0 {
+		if limiter.Sleep != nil {
+			limiter.Sleep(delay)
+		} else {
+			time.Sleep(delay)
+		}
+	}
+	return delay
+}
+
+func (limiter *Limiter) now() time.Time {
+	if limiter.Now != nil {
+		return limiter.Now()
+	}
+	return time.Now()
+}
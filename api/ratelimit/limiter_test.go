@@ -0,0 +1,105 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/ratelimit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		limiter *Limiter
+		now     time.Time
+		slept   []time.Duration
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+		slept = nil
+
+		limiter = NewLimiter(2)
+		limiter.Now = func() time.Time { return now }
+		limiter.Sleep = func(delay time.Duration) { slept = append(slept, delay) }
+	})
+
+	It("allows a burst of up to the rate without waiting", func() {
+		Expect(limiter.Wait()).To(BeZero())
+		Expect(limiter.Wait()).To(BeZero())
+		Expect(slept).To(BeEmpty())
+	})
+
+	It("makes requests over the rate wait their turn", func() {
+		limiter.Wait()
+		limiter.Wait()
+
+		Expect(limiter.Wait()).To(Equal(500 * time.Millisecond))
+		Expect(limiter.Wait()).To(Equal(time.Second))
+		Expect(slept).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+
+	It("refills the bucket over time, up to the rate", func() {
+		limiter.Wait()
+		limiter.Wait()
+
+		now = now.Add(500 * time.Millisecond)
+		Expect(limiter.Wait()).To(BeZero())
+		Expect(limiter.Wait()).To(Equal(500 * time.Millisecond))
+
+		now = now.Add(time.Hour)
+		Expect(limiter.Wait()).To(BeZero())
+		Expect(limiter.Wait()).To(BeZero())
+		Expect(limiter.Wait()).To(Equal(500 * time.Millisecond))
+	})
+
+	It("is safe for concurrent use", func() {
+		limiter.Sleep = nil
+		limiter.Now = nil
+		limiter.RequestsPerSecond = 1000
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				limiter.Wait()
+			}()
+		}
+		wg.Wait()
+	})
+
+	Context("when the rate is zero", func() {
+		BeforeEach(func() {
+			limiter.RequestsPerSecond = 0
+		})
+
+		It("never waits", func() {
+			for i := 0; i < 10; i++ {
+				Expect(limiter.Wait()).To(BeZero())
+			}
+			Expect(slept).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("Throttle", func() {
+	It("is carried by the request", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, ok := ThrottleFromRequest(request)
+		Expect(ok).To(BeFalse())
+
+		throttled := WithThrottle(request, Throttle{Delay: 250 * time.Millisecond, RequestsPerSecond: 4})
+		throttle, ok := ThrottleFromRequest(throttled)
+		Expect(ok).To(BeTrue())
+		Expect(throttle.String()).To(Equal("[Throttled for 250ms by the limit of 4 requests per second]"))
+
+		_, ok = ThrottleFromRequest(WithThrottle(throttled, Throttle{}))
+		Expect(ok).To(BeFalse())
+	})
+})
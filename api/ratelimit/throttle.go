@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type throttleKey struct{}
+
+// Throttle describes how long a request was held back by the rate limiter,
+// so that request loggers can show it.
+type Throttle struct {
+	// Delay is how long the request waited.
+	Delay time.Duration
+
+	// RequestsPerSecond is the limit that caused the wait.
+	RequestsPerSecond int
+}
+
+func (throttle Throttle) String() string {
+	return fmt.Sprintf("[Throttled for %s by the limit of %d requests per second]", throttle.Delay, throttle.RequestsPerSecond)
+}
+
+// WithThrottle returns a copy of the request that carries the throttle.
+func WithThrottle(request *http.Request, throttle Throttle) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), throttleKey{}, throttle))
+}
+
+// ThrottleFromRequest returns the throttle carried by the request, if it was
+// held back by the rate limiter.
+func ThrottleFromRequest(request *http.Request) (Throttle, bool) {
+	throttle, ok := request.Context().Value(throttleKey{}).(Throttle)
+	return throttle, ok && throttle.Delay > 0
+}
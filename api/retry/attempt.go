@@ -0,0 +1,53 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type attemptKey struct{}
+
+// Attempt describes a retry of a request, so that request loggers can show
+// why the request is being sent again.
+type Attempt struct {
+	// Retry is the number of the retry, counting from 1.
+	Retry int
+
+	// MaxRetries is the number of retries the policy allows.
+	MaxRetries int
+
+	// Delay is how long the wrapper waited before the retry.
+	Delay time.Duration
+
+	// Reason is the response status or network error that caused the retry.
+	Reason string
+}
+
+func (attempt Attempt) String() string {
+	return fmt.Sprintf("[Retry %d of %d after %s: %s]", attempt.Retry, attempt.MaxRetries, attempt.Delay, attempt.Reason)
+}
+
+// WithAttempt returns a copy of the request that carries the attempt.
+func WithAttempt(request *http.Request, attempt Attempt) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), attemptKey{}, attempt))
+}
+
+// AttemptFromRequest returns the attempt carried by the request, if it is a
+// retry.
+func AttemptFromRequest(request *http.Request) (Attempt, bool) {
+	attempt, ok := request.Context().Value(attemptKey{}).(Attempt)
+	return attempt, ok
+}
+
+// Reason describes why a request failed, for use in an Attempt.
+func Reason(response *http.Response, networkErr error) string {
+	if networkErr != nil {
+		return networkErr.Error()
+	}
+	if response == nil {
+		return "unknown error"
+	}
+	return fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+}
@@ -0,0 +1,29 @@
+package retry
+
+import (
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+)
+
+// IsRetryableNetworkError returns true when the error happened before a
+// response was received and is likely to be temporary: the connection was
+// reset or closed by the server, or it timed out.
+func IsRetryableNetworkError(err error) bool {
+	switch e := err.(type) {
+	case *url.Error:
+		return IsRetryableNetworkError(e.Err)
+	case *net.OpError:
+		return e.Timeout() || IsRetryableNetworkError(e.Err)
+	case *os.SyscallError:
+		return IsRetryableNetworkError(e.Err)
+	case syscall.Errno:
+		return e == syscall.ECONNRESET || e == syscall.ECONNABORTED || e == syscall.EPIPE
+	case net.Error:
+		return e.Timeout()
+	}
+
+	return err == io.EOF || err == io.ErrUnexpectedEOF
+}
@@ -0,0 +1,37 @@
+package retry_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+
+	. "code.cloudfoundry.org/cli/api/retry"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ = Describe("IsRetryableNetworkError", func() {
+	DescribeTable("network errors",
+		func(err error, expected bool) {
+			Expect(IsRetryableNetworkError(err)).To(Equal(expected))
+		},
+
+		Entry("connection reset", &url.Error{Err: &net.OpError{Op: "read", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}}, true),
+		Entry("broken pipe", &net.OpError{Op: "write", Err: syscall.EPIPE}, true),
+		Entry("timeout", &url.Error{Err: timeoutError{}}, true),
+		Entry("server closed the connection", &url.Error{Err: io.EOF}, true),
+		Entry("connection refused", &url.Error{Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, false),
+		Entry("other errors", errors.New("unsupported protocol scheme"), false),
+	)
+})
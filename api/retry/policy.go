@@ -0,0 +1,140 @@
+// Package retry contains the retry policy shared by the Cloud Controller, UAA
+// and plugin repository connection wrappers.
+package retry
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried.
+	DefaultMaxRetries = 2
+
+	// DefaultBackoff is how long to wait before the first retry. The wait
+	// doubles for every retry after that.
+	DefaultBackoff = 500 * time.Millisecond
+
+	// DefaultMaxBackoff is the longest time to wait between retries.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// Policy decides which failed requests are retried and how long to wait
+// between them.
+type Policy struct {
+	// MaxRetries is the number of times a request is retried after the
+	// original attempt.
+	MaxRetries int
+
+	// Backoff is how long to wait before the first retry. Each following retry
+	// waits twice as long, plus or minus jitter.
+	Backoff time.Duration
+
+	// MaxBackoff caps the wait between retries, including waits requested by
+	// a Retry-After header. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Sleep waits between retries. It defaults to time.Sleep.
+	Sleep func(time.Duration)
+
+	// Jitter returns a random number in [0, n). It defaults to rand.Int63n.
+	Jitter func(n int64) int64
+}
+
+// NewPolicy returns a Policy that retries up to maxRetries times, starting
+// with the provided backoff.
+func NewPolicy(maxRetries int, backoff time.Duration) Policy {
+	return Policy{
+		MaxRetries: maxRetries,
+		Backoff:    backoff,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+}
+
+// ShouldRetry returns true when a request should be retried after it failed
+// with the response, or with networkErr before a response was received.
+//
+// 429 Too Many Requests is retried for every method, since the server did not
+// process the request. 500, 502, 503 and 504 responses and network errors are
+// only retried for methods other than POST.
+func (policy Policy) ShouldRetry(method string, response *http.Response, networkErr error) bool {
+	if networkErr != nil {
+		return method != http.MethodPost && IsRetryableNetworkError(networkErr)
+	}
+
+	if response == nil {
+		return false
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return method != http.MethodPost
+	default:
+		return false
+	}
+}
+
+// Delay returns how long to wait before the provided retry, counting from 1.
+// A Retry-After header on a 429 or 503 response is used when present;
+// otherwise the backoff doubles with every retry and is randomized by up to
+// half in either direction.
+func (policy Policy) Delay(retry int, response *http.Response) time.Duration {
+	if delay, ok := retryAfter(response); ok {
+		return policy.capDelay(delay)
+	}
+
+	if policy.Backoff <= 0 || retry < 1 {
+		return 0
+	}
+
+	delay := policy.Backoff
+	for i := 1; i < retry && (policy.MaxBackoff <= 0 || delay < policy.MaxBackoff); i++ {
+		delay *= 2
+	}
+	delay = policy.capDelay(delay)
+
+	jitter := policy.Jitter
+	if jitter == nil {
+		jitter = rand.Int63n
+	}
+	return delay/2 + time.Duration(jitter(int64(delay)))
+}
+
+// Wait sleeps for the provided delay.
+func (policy Policy) Wait(delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+
+	if policy.Sleep != nil {
+		policy.Sleep(delay)
+		return
+	}
+	time.Sleep(delay)
+}
+
+func (policy Policy) capDelay(delay time.Duration) time.Duration {
+	if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+		return policy.MaxBackoff
+	}
+	return delay
+}
+
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests &&
+		response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	return ParseRetryAfter(response.Header.Get("Retry-After"), time.Now())
+}
@@ -0,0 +1,125 @@
+package retry_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/retry"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var policy Policy
+
+	BeforeEach(func() {
+		policy = NewPolicy(2, time.Second)
+	})
+
+	DescribeTable("ShouldRetry for responses",
+		func(method string, statusCode int, expected bool) {
+			Expect(policy.ShouldRetry(method, &http.Response{StatusCode: statusCode}, nil)).To(Equal(expected))
+		},
+
+		Entry("GET 500", http.MethodGet, http.StatusInternalServerError, true),
+		Entry("GET 502", http.MethodGet, http.StatusBadGateway, true),
+		Entry("GET 503", http.MethodGet, http.StatusServiceUnavailable, true),
+		Entry("GET 504", http.MethodGet, http.StatusGatewayTimeout, true),
+		Entry("GET 429", http.MethodGet, http.StatusTooManyRequests, true),
+		Entry("GET 404", http.MethodGet, http.StatusNotFound, false),
+		Entry("PUT 503", http.MethodPut, http.StatusServiceUnavailable, true),
+		Entry("POST 503", http.MethodPost, http.StatusServiceUnavailable, false),
+		Entry("POST 429", http.MethodPost, http.StatusTooManyRequests, true),
+	)
+
+	Describe("ShouldRetry for network errors", func() {
+		var resetErr error
+
+		BeforeEach(func() {
+			resetErr = &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}
+		})
+
+		It("retries idempotent requests", func() {
+			Expect(policy.ShouldRetry(http.MethodGet, nil, resetErr)).To(BeTrue())
+		})
+
+		It("does not retry POST requests", func() {
+			Expect(policy.ShouldRetry(http.MethodPost, nil, resetErr)).To(BeFalse())
+		})
+
+		It("does not retry other errors", func() {
+			Expect(policy.ShouldRetry(http.MethodGet, nil, errors.New("unsupported protocol scheme"))).To(BeFalse())
+		})
+	})
+
+	Describe("Delay", func() {
+		BeforeEach(func() {
+			policy.Jitter = func(n int64) int64 { return n / 2 }
+		})
+
+		It("doubles the backoff for every retry", func() {
+			Expect(policy.Delay(1, nil)).To(Equal(time.Second))
+			Expect(policy.Delay(2, nil)).To(Equal(2 * time.Second))
+			Expect(policy.Delay(3, nil)).To(Equal(4 * time.Second))
+		})
+
+		It("adds up to half of the backoff in either direction", func() {
+			policy.Jitter = func(n int64) int64 { return 0 }
+			Expect(policy.Delay(2, nil)).To(Equal(time.Second))
+
+			policy.Jitter = func(n int64) int64 { return n - 1 }
+			Expect(policy.Delay(2, nil)).To(Equal(3*time.Second - 1))
+		})
+
+		It("caps the backoff at MaxBackoff", func() {
+			policy.MaxBackoff = 3 * time.Second
+			Expect(policy.Delay(10, nil)).To(Equal(3 * time.Second))
+		})
+
+		It("does not wait when there is no backoff", func() {
+			policy.Backoff = 0
+			Expect(policy.Delay(1, nil)).To(BeZero())
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			var response *http.Response
+
+			BeforeEach(func() {
+				response = &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{"Retry-After": {"10"}},
+				}
+			})
+
+			It("uses the Retry-After delay", func() {
+				Expect(policy.Delay(1, response)).To(Equal(10 * time.Second))
+			})
+
+			It("caps the Retry-After delay at MaxBackoff", func() {
+				policy.MaxBackoff = 5 * time.Second
+				Expect(policy.Delay(1, response)).To(Equal(5 * time.Second))
+			})
+
+			It("ignores Retry-After on other status codes", func() {
+				response.StatusCode = http.StatusInternalServerError
+				Expect(policy.Delay(1, response)).To(Equal(time.Second))
+			})
+		})
+	})
+
+	Describe("Wait", func() {
+		It("sleeps for the delay", func() {
+			var slept time.Duration
+			policy.Sleep = func(delay time.Duration) { slept = delay }
+
+			policy.Wait(3 * time.Second)
+			Expect(slept).To(Equal(3 * time.Second))
+		})
+	})
+})
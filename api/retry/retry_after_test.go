@@ -0,0 +1,48 @@
+package retry_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/retry"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseRetryAfter", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2017, time.June, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("parses a number of seconds", func() {
+		delay, ok := ParseRetryAfter("120", now)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(Equal(2 * time.Minute))
+	})
+
+	It("parses an HTTP date", func() {
+		delay, ok := ParseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(Equal(30 * time.Second))
+	})
+
+	It("does not wait for a date in the past", func() {
+		delay, ok := ParseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(BeZero())
+	})
+
+	It("returns false for an empty or invalid value", func() {
+		_, ok := ParseRetryAfter("", now)
+		Expect(ok).To(BeFalse())
+
+		_, ok = ParseRetryAfter("soon", now)
+		Expect(ok).To(BeFalse())
+
+		_, ok = ParseRetryAfter("-5", now)
+		Expect(ok).To(BeFalse())
+	})
+})
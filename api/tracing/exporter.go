@@ -0,0 +1,229 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServiceName is the service.name resource attribute of exported spans.
+const ServiceName = "cf"
+
+//go:generate counterfeiter . Exporter
+
+// Exporter sends finished spans somewhere they can be viewed.
+type Exporter interface {
+	Export(spans []*Span) error
+}
+
+// NewExporter returns an exporter that posts spans to destination when it is
+// an http or https URL, such as an OTLP collector's /v1/traces endpoint, and
+// otherwise appends them to the file at destination. serviceVersion is the
+// version of the CLI.
+func NewExporter(destination string, serviceVersion string) Exporter {
+	if strings.HasPrefix(destination, "http://") || strings.HasPrefix(destination, "https://") {
+		return &HTTPExporter{
+			URL:            destination,
+			ServiceVersion: serviceVersion,
+			Client:         &http.Client{Timeout: 10 * time.Second},
+		}
+	}
+	return &FileExporter{Path: destination, ServiceVersion: serviceVersion}
+}
+
+// MultiExporter exports spans with each of its exporters in turn, stopping at
+// the first error.
+type MultiExporter []Exporter
+
+// Export exports the spans with each exporter.
+func (exporters MultiExporter) Export(spans []*Span) error {
+	for _, exporter := range exporters {
+		err := exporter.Export(spans)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SpanRecorder keeps exported spans in memory, so that the CLI can summarize
+// them itself.
+type SpanRecorder struct {
+	Spans []*Span
+}
+
+// Export appends the spans to the recorder's spans.
+func (recorder *SpanRecorder) Export(spans []*Span) error {
+	recorder.Spans = append(recorder.Spans, spans...)
+	return nil
+}
+
+// FileExporter appends each export to a file as one line of OTLP JSON.
+type FileExporter struct {
+	Path           string
+	ServiceVersion string
+}
+
+// Export appends the spans to the file, creating it if necessary.
+func (exporter *FileExporter) Export(spans []*Span) error {
+	raw, err := MarshalOTLP(spans, exporter.ServiceVersion)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(exporter.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(raw, '\n'))
+	return err
+}
+
+// HTTPExporter posts each export to an OTLP/HTTP endpoint as JSON.
+type HTTPExporter struct {
+	URL            string
+	ServiceVersion string
+	Client         *http.Client
+}
+
+// ExportError is returned when the endpoint rejects the exported spans.
+type ExportError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e ExportError) Error() string {
+	return fmt.Sprintf("Exporting spans to %s failed with status %d", e.URL, e.StatusCode)
+}
+
+// Export posts the spans to the endpoint.
+func (exporter *HTTPExporter) Export(spans []*Span) error {
+	raw, err := MarshalOTLP(spans, exporter.ServiceVersion)
+	if err != nil {
+		return err
+	}
+
+	response, err := exporter.Client.Post(exporter.URL, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return ExportError{URL: exporter.URL, StatusCode: response.StatusCode}
+	}
+	return nil
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              SpanKind        `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// otlpStatusError is the OTLP status code of a failed span.
+const otlpStatusError = 2
+
+// MarshalOTLP encodes spans as an OTLP ExportTraceServiceRequest in JSON.
+func MarshalOTLP(spans []*Span, serviceVersion string) ([]byte, error) {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		span.mutex.Lock()
+		otlp := otlpSpan{
+			TraceID:           span.TraceID,
+			SpanID:            span.SpanID,
+			ParentSpanID:      span.ParentSpanID,
+			Name:              span.Name,
+			Kind:              span.Kind,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.Attributes),
+		}
+		if span.Error != "" {
+			otlp.Status = otlpStatus{Code: otlpStatusError, Message: span.Error}
+		}
+		span.mutex.Unlock()
+
+		otlpSpans = append(otlpSpans, otlp)
+	}
+
+	return json.Marshal(otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: otlpAttributes(map[string]string{
+					"service.name":    ServiceName,
+					"service.version": serviceVersion,
+				}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "code.cloudfoundry.org/cli", Version: serviceVersion},
+				Spans: otlpSpans,
+			}},
+		}},
+	})
+}
+
+func otlpAttributes(attributes map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	otlp := make([]otlpAttribute, 0, len(keys))
+	for _, key := range keys {
+		otlp = append(otlp, otlpAttribute{Key: key, Value: otlpValue{StringValue: attributes[key]}})
+	}
+	return otlp
+}
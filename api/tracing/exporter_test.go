@@ -0,0 +1,162 @@
+package tracing_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/tracing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Exporter", func() {
+	var spans []*Span
+
+	BeforeEach(func() {
+		tracer := NewTracer(nil)
+		tracer.Now = func() time.Time { return time.Unix(1496318400, 0) }
+
+		root := tracer.StartCommand("cf apps")
+		client := tracer.StartClient("GET /v2/apps")
+		client.SetAttribute("http.method", "GET")
+		client.Finish()
+		root.SetError(os.ErrNotExist)
+		root.Finish()
+		spans = []*Span{client, root}
+	})
+
+	Describe("MarshalOTLP", func() {
+		It("encodes the spans as an OTLP export request", func() {
+			raw, err := MarshalOTLP(spans, "6.28.0")
+			Expect(err).ToNot(HaveOccurred())
+
+			var request map[string]interface{}
+			Expect(json.Unmarshal(raw, &request)).To(Succeed())
+
+			resourceSpans := request["resourceSpans"].([]interface{})[0].(map[string]interface{})
+			Expect(resourceSpans["resource"]).To(Equal(map[string]interface{}{
+				"attributes": []interface{}{
+					map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "cf"}},
+					map[string]interface{}{"key": "service.version", "value": map[string]interface{}{"stringValue": "6.28.0"}},
+				},
+			}))
+
+			otlpSpans := resourceSpans["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+			Expect(otlpSpans).To(HaveLen(2))
+			Expect(otlpSpans[0]).To(Equal(map[string]interface{}{
+				"traceId":           spans[0].TraceID,
+				"spanId":            spans[0].SpanID,
+				"parentSpanId":      spans[1].SpanID,
+				"name":              "GET /v2/apps",
+				"kind":              float64(SpanKindClient),
+				"startTimeUnixNano": "1496318400000000000",
+				"endTimeUnixNano":   "1496318400000000000",
+				"attributes": []interface{}{
+					map[string]interface{}{"key": "http.method", "value": map[string]interface{}{"stringValue": "GET"}},
+				},
+				"status": map[string]interface{}{},
+			}))
+			Expect(otlpSpans[1].(map[string]interface{})["status"]).To(Equal(map[string]interface{}{
+				"code":    float64(2),
+				"message": os.ErrNotExist.Error(),
+			}))
+		})
+	})
+
+	Describe("MultiExporter", func() {
+		It("exports the spans with each exporter", func() {
+			first := new(SpanRecorder)
+			second := new(SpanRecorder)
+			Expect(MultiExporter{first, second}.Export(spans)).To(Succeed())
+			Expect(first.Spans).To(Equal(spans))
+			Expect(second.Spans).To(Equal(spans))
+		})
+
+		It("returns the first error", func() {
+			recorder := new(SpanRecorder)
+			exporter := MultiExporter{&FileExporter{Path: filepath.Join("does-not-exist", "spans.json")}, recorder}
+			Expect(exporter.Export(spans)).ToNot(Succeed())
+			Expect(recorder.Spans).To(BeEmpty())
+		})
+	})
+
+	Describe("SpanRecorder", func() {
+		It("keeps every export", func() {
+			recorder := new(SpanRecorder)
+			Expect(recorder.Export(spans[:1])).To(Succeed())
+			Expect(recorder.Export(spans[1:])).To(Succeed())
+			Expect(recorder.Spans).To(Equal(spans))
+		})
+	})
+
+	Describe("FileExporter", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "tracing")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("appends one line per export", func() {
+			path := filepath.Join(dir, "spans.json")
+			exporter := NewExporter(path, "6.28.0")
+			Expect(exporter.Export(spans)).To(Succeed())
+			Expect(exporter.Export(spans)).To(Succeed())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(raw)), "\n")).To(HaveLen(2))
+		})
+	})
+
+	Describe("HTTPExporter", func() {
+		var server *Server
+
+		BeforeEach(func() {
+			server = NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts the spans to the endpoint", func() {
+			expectedBody, err := MarshalOTLP(spans, "6.28.0")
+			Expect(err).ToNot(HaveOccurred())
+
+			server.AppendHandlers(CombineHandlers(
+				VerifyRequest(http.MethodPost, "/v1/traces"),
+				VerifyContentType("application/json"),
+				VerifyJSON(string(expectedBody)),
+				RespondWith(http.StatusOK, "{}"),
+			))
+
+			exporter := NewExporter(server.URL()+"/v1/traces", "6.28.0")
+			Expect(exporter.Export(spans)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the endpoint rejects the spans", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(RespondWith(http.StatusBadRequest, ""))
+			})
+
+			It("returns an ExportError", func() {
+				exporter := NewExporter(server.URL()+"/v1/traces", "6.28.0")
+				Expect(exporter.Export(spans)).To(MatchError(ExportError{URL: server.URL() + "/v1/traces", StatusCode: http.StatusBadRequest}))
+			})
+		})
+	})
+})
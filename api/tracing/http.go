@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// TraceParentHeader is the W3C header that carries the span of a request to
+// the server.
+const TraceParentHeader = "traceparent"
+
+// The attributes of request spans, named after the OpenTelemetry semantic
+// conventions.
+const (
+	AttributePeerService      = "peer.service"
+	AttributeMethod           = "http.request.method"
+	AttributeServerAddress    = "server.address"
+	AttributePath             = "url.path"
+	AttributeStatusCode       = "http.response.status_code"
+	AttributeRequestBodySize  = "http.request.body.size"
+	AttributeResponseBodySize = "http.response.body.size"
+	AttributeRequestID        = "cf.request_id"
+)
+
+// StartRequest starts a client span for a request to service and sets the
+// request's traceparent header to it. It returns nil when tracer is nil.
+func (tracer *Tracer) StartRequest(service string, request *http.Request) *Span {
+	if tracer == nil {
+		return nil
+	}
+
+	span := tracer.StartClient(request.Method + " " + request.URL.Path)
+	span.SetAttribute(AttributePeerService, service)
+	span.SetAttribute(AttributeMethod, request.Method)
+	span.SetAttribute(AttributeServerAddress, request.URL.Host)
+	span.SetAttribute(AttributePath, request.URL.Path)
+	if request.ContentLength > 0 {
+		span.SetAttribute(AttributeRequestBodySize, strconv.FormatInt(request.ContentLength, 10))
+	}
+
+	request.Header.Set(TraceParentHeader, span.TraceParent())
+	return span
+}
+
+// FinishRequest records the outcome of the span's request and finishes the
+// span. response may be nil if the request failed before a response was
+// received. responseBodySize is the size of the body that was read from the
+// response.
+func (span *Span) FinishRequest(response *http.Response, responseBodySize int64, err error) {
+	if response != nil {
+		span.SetAttribute(AttributeStatusCode, strconv.Itoa(response.StatusCode))
+		span.SetAttribute(AttributeResponseBodySize, strconv.FormatInt(responseBodySize, 10))
+		// The request ID lets the span be matched with the Cloud Controller's
+		// logs even when it does not record traces.
+		if requestID := response.Header.Get("X-Vcap-Request-Id"); requestID != "" {
+			span.SetAttribute(AttributeRequestID, requestID)
+		}
+	}
+	span.SetError(err)
+	span.Finish()
+}
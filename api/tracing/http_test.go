@@ -0,0 +1,78 @@
+package tracing_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request spans", func() {
+	var (
+		tracer  *Tracer
+		request *http.Request
+		span    *Span
+	)
+
+	BeforeEach(func() {
+		tracer = NewTracer(new(tracingfakes.FakeExporter))
+		tracer.StartCommand("cf apps")
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps?q=name:some-app", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		span = tracer.StartRequest("cloud_controller", request)
+	})
+
+	It("starts a client span and propagates it in the traceparent header", func() {
+		Expect(span.Kind).To(Equal(SpanKindClient))
+		Expect(span.Name).To(Equal("GET /v2/apps"))
+		Expect(span.Attributes).To(Equal(map[string]string{
+			"peer.service":        "cloud_controller",
+			"http.request.method": "GET",
+			"server.address":      "api.example.com",
+			"url.path":            "/v2/apps",
+		}))
+		Expect(request.Header.Get("traceparent")).To(Equal(span.TraceParent()))
+	})
+
+	It("records the response", func() {
+		span.FinishRequest(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{"X-Vcap-Request-Id": {"some-request-id"}},
+		}, 42, errors.New("not found"))
+
+		Expect(span.Attributes).To(HaveKeyWithValue("http.response.status_code", "404"))
+		Expect(span.Attributes).To(HaveKeyWithValue("http.response.body.size", "42"))
+		Expect(span.Attributes).To(HaveKeyWithValue("cf.request_id", "some-request-id"))
+		Expect(span.Error).To(Equal("not found"))
+		Expect(span.End).ToNot(BeZero())
+	})
+
+	It("records failures without a response", func() {
+		span.FinishRequest(nil, 0, errors.New("no network"))
+		Expect(span.Error).To(Equal("no network"))
+		Expect(span.Attributes).ToNot(HaveKey("http.response.body.size"))
+	})
+
+	It("records the size of the request body", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps", strings.NewReader(`{"name":"some-app"}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		span := tracer.StartRequest("cloud_controller", request)
+		Expect(span.Attributes).To(HaveKeyWithValue("http.request.body.size", "19"))
+	})
+
+	It("does nothing when tracing is off", func() {
+		var tracer *Tracer
+		span := tracer.StartRequest("cloud_controller", request)
+		span.FinishRequest(nil, 0, errors.New("no network"))
+		Expect(span).To(BeNil())
+	})
+})
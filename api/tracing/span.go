@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SpanKind is the role a span plays in a trace, with the same values as the
+// OpenTelemetry span kinds.
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+// Span is a timed operation in a trace, such as a command or one of the
+// requests it makes.
+type Span struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         SpanKind
+	Start        time.Time
+	End          time.Time
+	Attributes   map[string]string
+	Error        string
+
+	tracer *Tracer
+	mutex  sync.Mutex
+	ended  bool
+}
+
+// TraceParent returns the W3C traceparent header value that makes the span
+// the parent of the server's spans.
+func (span *Span) TraceParent() string {
+	return fmt.Sprintf("00-%s-%s-01", span.TraceID, span.SpanID)
+}
+
+// Duration returns how long the span took, or zero if it has not finished.
+func (span *Span) Duration() time.Duration {
+	if span.End.IsZero() {
+		return 0
+	}
+	return span.End.Sub(span.Start)
+}
+
+// SetAttribute sets an attribute on the span. Like SetError and Finish, it
+// does nothing on a nil span, which tracers that are off return.
+func (span *Span) SetAttribute(key string, value string) {
+	if span == nil {
+		return
+	}
+
+	span.mutex.Lock()
+	defer span.mutex.Unlock()
+	span.Attributes[key] = value
+}
+
+// SetError marks the span as failed with err. A nil err does nothing.
+func (span *Span) SetError(err error) {
+	if span == nil || err == nil {
+		return
+	}
+
+	span.mutex.Lock()
+	defer span.mutex.Unlock()
+	span.Error = err.Error()
+}
+
+// Finish ends the span and hands it to its tracer to be exported. Only the
+// first call has any effect.
+func (span *Span) Finish() {
+	if span == nil {
+		return
+	}
+
+	span.mutex.Lock()
+	if span.ended {
+		span.mutex.Unlock()
+		return
+	}
+	span.ended = true
+	span.End = span.tracer.Now()
+	span.mutex.Unlock()
+
+	span.tracer.finish(span)
+}
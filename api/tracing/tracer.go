@@ -0,0 +1,142 @@
+// Package tracing records OpenTelemetry style spans for a CLI command and the
+// requests it makes, and exports them in the OTLP JSON format.
+//
+// A command has a single root span, and every request made while it runs is a
+// client span under it. The span of a request is propagated to the server in
+// a W3C traceparent header so that server side traces can be correlated with
+// the command that caused them.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+var (
+	currentTracer *Tracer
+	currentMutex  sync.RWMutex
+)
+
+// SetCurrent sets the tracer of the running command. Passing nil turns
+// tracing off.
+func SetCurrent(tracer *Tracer) {
+	currentMutex.Lock()
+	defer currentMutex.Unlock()
+	currentTracer = tracer
+}
+
+// Current returns the tracer of the running command, or nil when tracing is
+// off.
+func Current() *Tracer {
+	currentMutex.RLock()
+	defer currentMutex.RUnlock()
+	return currentTracer
+}
+
+// Tracer creates the spans of a command and exports them once the command is
+// done.
+type Tracer struct {
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	exporter Exporter
+	mutex    sync.Mutex
+	root     *Span
+	finished []*Span
+}
+
+// NewTracer returns a tracer that exports its spans with exporter.
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{
+		Now:      time.Now,
+		exporter: exporter,
+	}
+}
+
+// StartCommand starts the root span of a new trace. Spans started later
+// with StartClient are its children.
+func (tracer *Tracer) StartCommand(name string) *Span {
+	span := tracer.newSpan(name, SpanKindInternal, newID(16), "")
+
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	tracer.root = span
+	return span
+}
+
+// StartClient starts a span for a request to another service.
+func (tracer *Tracer) StartClient(name string) *Span {
+	return tracer.startChild(name, SpanKindClient)
+}
+
+// StartServer starts a span for a request made to the CLI, such as a plugin
+// RPC call.
+func (tracer *Tracer) StartServer(name string) *Span {
+	return tracer.startChild(name, SpanKindServer)
+}
+
+// StartInternal starts a span for work the CLI does itself, such as zipping
+// files or waiting for an app to stage.
+func (tracer *Tracer) StartInternal(name string) *Span {
+	return tracer.startChild(name, SpanKindInternal)
+}
+
+// Flush exports the spans that have finished since the last flush.
+func (tracer *Tracer) Flush() error {
+	tracer.mutex.Lock()
+	spans := tracer.finished
+	tracer.finished = nil
+	tracer.mutex.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+	return tracer.exporter.Export(spans)
+}
+
+// startChild returns nil when tracer is nil, so that code can record spans
+// with Current() without checking whether tracing is on.
+func (tracer *Tracer) startChild(name string, kind SpanKind) *Span {
+	if tracer == nil {
+		return nil
+	}
+
+	tracer.mutex.Lock()
+	root := tracer.root
+	tracer.mutex.Unlock()
+
+	if root == nil {
+		return tracer.newSpan(name, kind, newID(16), "")
+	}
+	return tracer.newSpan(name, kind, root.TraceID, root.SpanID)
+}
+
+func (tracer *Tracer) newSpan(name string, kind SpanKind, traceID string, parentSpanID string) *Span {
+	return &Span{
+		TraceID:      traceID,
+		SpanID:       newID(8),
+		ParentSpanID: parentSpanID,
+		Name:         name,
+		Kind:         kind,
+		Start:        tracer.Now(),
+		Attributes:   map[string]string{},
+		tracer:       tracer,
+	}
+}
+
+func (tracer *Tracer) finish(span *Span) {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	tracer.finished = append(tracer.finished, span)
+}
+
+// newID returns a random ID of size bytes, hex encoded.
+func newID(size int) string {
+	id := make([]byte, size)
+	// crypto/rand only fails when the operating system has no source of
+	// randomness, in which case an ID of zeros is still a usable ID.
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
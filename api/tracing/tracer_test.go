@@ -0,0 +1,122 @@
+package tracing_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracer", func() {
+	var (
+		fakeExporter *tracingfakes.FakeExporter
+		tracer       *Tracer
+		now          time.Time
+	)
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = NewTracer(fakeExporter)
+		now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+		tracer.Now = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+	})
+
+	It("makes request spans children of the command span", func() {
+		root := tracer.StartCommand("cf apps")
+		client := tracer.StartClient("GET /v2/apps")
+		server := tracer.StartServer("CliRpcCmd.GetApps")
+
+		Expect(root.TraceID).To(MatchRegexp("^[0-9a-f]{32}$"))
+		Expect(root.SpanID).To(MatchRegexp("^[0-9a-f]{16}$"))
+		Expect(root.ParentSpanID).To(BeEmpty())
+		Expect(root.Kind).To(Equal(SpanKindInternal))
+
+		Expect(client.TraceID).To(Equal(root.TraceID))
+		Expect(client.ParentSpanID).To(Equal(root.SpanID))
+		Expect(client.SpanID).ToNot(Equal(root.SpanID))
+		Expect(client.Kind).To(Equal(SpanKindClient))
+
+		Expect(server.ParentSpanID).To(Equal(root.SpanID))
+		Expect(server.Kind).To(Equal(SpanKindServer))
+	})
+
+	It("makes internal spans children of the command span", func() {
+		root := tracer.StartCommand("cf push")
+		internal := tracer.StartInternal("zip files")
+
+		Expect(internal.ParentSpanID).To(Equal(root.SpanID))
+		Expect(internal.Kind).To(Equal(SpanKindInternal))
+
+		Expect(internal.Duration()).To(BeZero())
+		internal.Finish()
+		Expect(internal.Duration()).To(Equal(time.Second))
+	})
+
+	It("returns nil spans that do nothing when the tracer is nil", func() {
+		var tracer *Tracer
+		span := tracer.StartInternal("zip files")
+		Expect(span).To(BeNil())
+
+		span.SetAttribute("some-key", "some-value")
+		span.SetError(errors.New("some-error"))
+		span.Finish()
+	})
+
+	It("returns a W3C traceparent for a span", func() {
+		span := tracer.StartCommand("cf apps")
+		Expect(span.TraceParent()).To(Equal("00-" + span.TraceID + "-" + span.SpanID + "-01"))
+	})
+
+	Describe("Flush", func() {
+		It("exports the finished spans once", func() {
+			root := tracer.StartCommand("cf apps")
+			client := tracer.StartClient("GET /v2/apps")
+			client.SetAttribute("http.status_code", "200")
+			client.Finish()
+			root.SetError(errors.New("some-error"))
+			root.Finish()
+			root.Finish()
+
+			Expect(tracer.Flush()).To(Succeed())
+			Expect(fakeExporter.ExportCallCount()).To(Equal(1))
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans).To(Equal([]*Span{client, root}))
+			Expect(client.Attributes).To(Equal(map[string]string{"http.status_code": "200"}))
+			Expect(client.End).To(BeTemporally(">", client.Start))
+			Expect(root.Error).To(Equal("some-error"))
+
+			Expect(tracer.Flush()).To(Succeed())
+			Expect(fakeExporter.ExportCallCount()).To(Equal(1))
+		})
+
+		Context("when exporting fails", func() {
+			BeforeEach(func() {
+				fakeExporter.ExportReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				tracer.StartCommand("cf apps").Finish()
+				Expect(tracer.Flush()).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Describe("Current", func() {
+		AfterEach(func() {
+			SetCurrent(nil)
+		})
+
+		It("returns the tracer that was set", func() {
+			Expect(Current()).To(BeNil())
+			SetCurrent(tracer)
+			Expect(Current()).To(Equal(tracer))
+		})
+	})
+})
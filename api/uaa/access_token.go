@@ -0,0 +1,60 @@
+package uaa
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// AccessTokenExpiry returns the expiry time from the access token's exp
+// claim. The token may include its "bearer" type. ok is false when the token
+// cannot be decoded or has no exp claim. The token's signature is not
+// verified.
+func AccessTokenExpiry(accessToken string) (expiry time.Time, ok bool) {
+	if i := strings.Index(accessToken, " "); i >= 0 {
+		accessToken = accessToken[i+1:]
+	}
+
+	segments := strings.Split(accessToken, ".")
+	if len(segments) < 3 {
+		return time.Time{}, false
+	}
+
+	rawClaims, err := decodeSegment(segments[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Expiry float64 `json:"exp"`
+	}
+	err = json.Unmarshal(rawClaims, &claims)
+	if err != nil || claims.Expiry == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(claims.Expiry), 0), true
+}
+
+// AccessTokenValidFor returns true when the access token will still be valid
+// after the provided duration. Tokens without a readable expiry are assumed to
+// be valid; the server rejecting them is handled by refreshing afterwards.
+func AccessTokenValidFor(accessToken string, duration time.Duration) bool {
+	expiry, ok := AccessTokenExpiry(accessToken)
+	if !ok {
+		return true
+	}
+	return time.Now().Add(duration).Before(expiry)
+}
+
+// decodeSegment decodes a base64url JWT segment, tolerating padding and the
+// standard base64 alphabet that some servers use.
+func decodeSegment(segment string) ([]byte, error) {
+	segment = strings.TrimRight(segment, "=")
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return base64.RawStdEncoding.DecodeString(segment)
+	}
+	return data, nil
+}
@@ -0,0 +1,68 @@
+package uaa_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessToken", func() {
+	tokenExpiringAt := func(expiry time.Time) string {
+		encode := base64.RawURLEncoding.EncodeToString
+		claims := fmt.Sprintf(`{"exp":%d}`, expiry.Unix())
+		return encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(claims)) + "."
+	}
+
+	Describe("AccessTokenExpiry", func() {
+		var expiry time.Time
+
+		BeforeEach(func() {
+			expiry = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+		})
+
+		It("returns the exp claim", func() {
+			actualExpiry, ok := AccessTokenExpiry(tokenExpiringAt(expiry))
+			Expect(ok).To(BeTrue())
+			Expect(actualExpiry.Equal(expiry)).To(BeTrue())
+		})
+
+		It("ignores the token type", func() {
+			actualExpiry, ok := AccessTokenExpiry("bearer " + tokenExpiringAt(expiry))
+			Expect(ok).To(BeTrue())
+			Expect(actualExpiry.Equal(expiry)).To(BeTrue())
+		})
+
+		It("returns false when the token cannot be decoded", func() {
+			_, ok := AccessTokenExpiry("bearer not-a-jwt")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false when the token is empty", func() {
+			_, ok := AccessTokenExpiry("")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("AccessTokenValidFor", func() {
+		It("returns true when the token expires after the duration", func() {
+			Expect(AccessTokenValidFor(tokenExpiringAt(time.Now().Add(time.Hour)), time.Minute)).To(BeTrue())
+		})
+
+		It("returns false when the token expires within the duration", func() {
+			Expect(AccessTokenValidFor(tokenExpiringAt(time.Now().Add(30*time.Second)), time.Minute)).To(BeFalse())
+		})
+
+		It("returns false when the token has expired", func() {
+			Expect(AccessTokenValidFor(tokenExpiringAt(time.Now().Add(-time.Hour)), 0)).To(BeFalse())
+		})
+
+		It("returns true when the token has no readable expiry", func() {
+			Expect(AccessTokenValidFor("some-opaque-token", time.Minute)).To(BeTrue())
+		})
+	})
+})
@@ -16,12 +16,14 @@ type AuthResponse struct {
 }
 
 // Authenticate sends a username and password to UAA then returns an access
-// token and a refresh token.
+// token and a refresh token. The password grant always uses the CLI's own UAA
+// client, since the configured client may be one that was stored by a client
+// credentials login.
 func (client Client) Authenticate(username string, password string) (string, string, error) {
 	requestBody := url.Values{}
 	requestBody.Set("username", username)
 	requestBody.Set("password", password)
-	requestBody.Set("grant_type", "password")
+	requestBody.Set("grant_type", string(GrantTypePassword))
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -33,7 +35,7 @@ func (client Client) Authenticate(username string, password string) (string, str
 	if err != nil {
 		return "", "", err
 	}
-	request.SetBasicAuth(client.id, client.secret)
+	request.SetBasicAuth(DefaultClientID, DefaultClientSecret)
 
 	responseBody := AuthResponse{}
 	response := Response{
@@ -43,3 +45,40 @@ func (client Client) Authenticate(username string, password string) (string, str
 	err = client.connection.Make(request, &response)
 	return responseBody.AccessToken, responseBody.RefreshToken, err
 }
+
+// AuthenticateClient requests a token for the UAA client itself using the
+// client credentials grant and returns the access token. No refresh token is
+// issued for this grant.
+func (client Client) AuthenticateClient(clientID string, clientSecret string) (string, error) {
+	token, err := client.requestClientCredentialsToken(clientID, clientSecret)
+	return token.AccessToken, err
+}
+
+func (client Client) requestClientCredentialsToken(clientID string, clientSecret string) (RefreshToken, error) {
+	requestBody := url.Values{}
+	requestBody.Set("grant_type", string(GrantTypeClientCredentials))
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return RefreshToken{}, err
+	}
+	request.SetBasicAuth(clientID, clientSecret)
+
+	var token RefreshToken
+	response := Response{
+		Result: &token,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return RefreshToken{}, err
+	}
+
+	return token, nil
+}
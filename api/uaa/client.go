@@ -14,11 +14,21 @@ import (
 	"github.com/tedsuo/rata"
 )
 
+const (
+	// DefaultClientID is the CLI's own UAA client. User (password) grants
+	// always use it, regardless of the client the Client is configured with.
+	DefaultClientID = "cf"
+
+	// DefaultClientSecret is the secret of the CLI's own UAA client.
+	DefaultClientSecret = ""
+)
+
 // Client is the UAA client
 type Client struct {
-	URL    string
-	id     string
-	secret string
+	URL       string
+	id        string
+	secret    string
+	grantType GrantType
 
 	connection Connection
 	router     *rata.RequestGenerator
@@ -43,6 +53,15 @@ type Config struct {
 	// ClientSecret is the UAA client secret the client will use.
 	ClientSecret string
 
+	// GrantType is the grant the current tokens were obtained with. It
+	// determines how expired tokens are renewed. If not set, the password
+	// grant is assumed.
+	GrantType GrantType
+
+	// RawWrappers apply to the client connection before the UAA errors are
+	// parsed, so they see responses exactly as they were received.
+	RawWrappers []ConnectionWrapper
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -67,14 +86,18 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
-		URL:    config.URL,
-		id:     config.ClientID,
-		secret: config.ClientSecret,
+		URL:       config.URL,
+		id:        config.ClientID,
+		secret:    config.ClientSecret,
+		grantType: config.GrantType,
 
 		router:     rata.NewRequestGenerator(config.URL, internal.Routes),
 		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
 		userAgent:  userAgent,
 	}
+	for _, wrapper := range config.RawWrappers {
+		client.WrapConnection(wrapper)
+	}
 	client.WrapConnection(NewErrorWrapper())
 
 	return &client
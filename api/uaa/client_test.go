@@ -6,6 +6,7 @@ import (
 	"runtime"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -64,4 +65,28 @@ var _ = Describe("UAA Client", func() {
 			})
 		})
 	})
+
+	Describe("RawWrappers", func() {
+		var fakeRawWrapper *uaafakes.FakeConnectionWrapper
+
+		BeforeEach(func() {
+			fakeRawWrapper = new(uaafakes.FakeConnectionWrapper)
+			fakeRawWrapper.WrapStub = func(connection Connection) Connection {
+				return connection
+			}
+
+			client = NewClient(Config{
+				AppName:           "CF CLI UAA API Test",
+				AppVersion:        "Unknown",
+				RawWrappers:       []ConnectionWrapper{fakeRawWrapper},
+				SkipSSLValidation: true,
+				URL:               server.URL(),
+			})
+		})
+
+		It("wraps the unwrapped connection", func() {
+			Expect(fakeRawWrapper.WrapCallCount()).To(Equal(1))
+			Expect(fakeRawWrapper.WrapArgsForCall(0)).To(BeAssignableToTypeOf(&UAAConnection{}))
+		})
+	})
 })
@@ -0,0 +1,15 @@
+package uaa
+
+// GrantType is the OAuth grant a client's tokens were obtained with.
+type GrantType string
+
+const (
+	// GrantTypePassword is the grant used to authenticate users. It is the
+	// default.
+	GrantTypePassword GrantType = "password"
+
+	// GrantTypeClientCredentials is the grant used to authenticate UAA clients
+	// that act on their own behalf. Tokens obtained this way have no refresh
+	// token, so they are renewed by requesting a new token.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+)
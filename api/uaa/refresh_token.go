@@ -21,8 +21,14 @@ func (refreshTokenResponse RefreshToken) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. Tokens obtained with
+// the client credentials grant have no refresh token, so a new token is
+// requested with the client's credentials instead.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, error) {
+	if client.grantType == GrantTypeClientCredentials {
+		return client.requestClientCredentialsToken(client.id, client.secret)
+	}
+
 	body := strings.NewReader(url.Values{
 		"client_id":     {client.id},
 		"client_secret": {client.secret},
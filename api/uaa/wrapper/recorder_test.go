@@ -0,0 +1,118 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeCassette   *wrapperfakes.FakeCassette
+
+		wrapper uaa.Connection
+
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeCassette = new(wrapperfakes.FakeCassette)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("grant_type=password"))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var result map[string]string
+		response = &uaa.Response{Result: &result}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewRecorder(fakeCassette).Wrap(fakeConnection)
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when the cassette is recording", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusOK}
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal("grant_type=password"))
+
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"access_token":"some-token"}`)
+				return nil
+			}
+		})
+
+		It("makes the request and records it with its response", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeCassette.RecordCallCount()).To(Equal(1))
+			recordedRequest, recordedRequestBody, recordedResponse, recordedResponseBody := fakeCassette.RecordArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(string(recordedRequestBody)).To(Equal("grant_type=password"))
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(string(recordedResponseBody)).To(Equal(`{"access_token":"some-token"}`))
+		})
+
+		Context("when the request fails without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("no network")})
+			})
+
+			It("does not record anything", func() {
+				Expect(makeErr).To(MatchError(uaa.RequestError{Err: errors.New("no network")}))
+				Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeCassette.RecordReturns(errors.New("disk full"))
+			})
+
+			It("returns the error", func() {
+				Expect(makeErr).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Context("when the cassette is replaying", func() {
+		BeforeEach(func() {
+			fakeCassette.ReplayingReturns(true)
+			fakeCassette.RoundTripReturns(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"access_token":"some-token"}`)),
+			}, nil)
+		})
+
+		It("responds from the cassette without making the request", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			Expect(fakeCassette.RecordCallCount()).To(Equal(0))
+			Expect(fakeCassette.RoundTripCallCount()).To(Equal(1))
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(*response.Result.(*map[string]string)).To(Equal(map[string]string{"access_token": "some-token"}))
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 )
 
@@ -16,6 +17,7 @@ import (
 type RequestLoggerOutput interface {
 	DisplayBody(body []byte) error
 	DisplayJSONBody(body []byte) error
+	DisplayMessage(msg string) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
@@ -76,6 +78,12 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	if attempt, ok := retry.AttemptFromRequest(request); ok {
+		err = logger.output.DisplayMessage(attempt.String())
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
@@ -92,6 +93,21 @@ var _ = Describe("Request Logger", func() {
 			Expect(value).To(Equal("bar"))
 		})
 
+		Context("when the request is a retry", func() {
+			var attempt retry.Attempt
+
+			BeforeEach(func() {
+				attempt = retry.Attempt{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "503 Service Unavailable"}
+				request = retry.WithAttempt(request, attempt)
+			})
+
+			It("outputs the retry attempt after the request type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeOutput.DisplayMessageCallCount()).To(BeNumerically(">=", 1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Retry 1 of 2 after 1s: 503 Service Unavailable]"))
+			})
+		})
+
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
 				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
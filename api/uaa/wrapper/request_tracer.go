@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// RequestTracer is a wrapper that records a span for each request to the UAA
+// and propagates it in the request's traceparent header.
+type RequestTracer struct {
+	connection uaa.Connection
+	tracer     *tracing.Tracer
+}
+
+// NewRequestTracer returns a pointer to a RequestTracer wrapper.
+func NewRequestTracer(tracer *tracing.Tracer) *RequestTracer {
+	return &RequestTracer{
+		tracer: tracer,
+	}
+}
+
+// Wrap sets the connection in the RequestTracer and returns itself.
+func (t *RequestTracer) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	t.connection = innerconnection
+	return t
+}
+
+// Make records a span for the request.
+func (t *RequestTracer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	span := t.tracer.StartRequest("uaa", request)
+	err := t.connection.Make(request, passedResponse)
+	span.FinishRequest(passedResponse.HTTPResponse, int64(len(passedResponse.RawResponse)), err)
+	return err
+}
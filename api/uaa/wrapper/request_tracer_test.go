@@ -0,0 +1,83 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Tracer", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeExporter   *tracingfakes.FakeExporter
+		tracer         *tracing.Tracer
+		root           *tracing.Span
+
+		request *http.Request
+		makeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = tracing.NewTracer(fakeExporter)
+		root = tracer.StartCommand("cf login")
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		makeErr = NewRequestTracer(tracer).Wrap(fakeConnection).Make(request, &uaa.Response{})
+
+		root.Finish()
+		Expect(tracer.Flush()).To(Succeed())
+	})
+
+	Context("when the request succeeds", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+				return nil
+			}
+		})
+
+		It("records a child span of the command and propagates it to the UAA", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Name).To(Equal("POST /oauth/token"))
+			Expect(spans[0].ParentSpanID).To(Equal(root.SpanID))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("peer.service", "uaa"))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("http.response.status_code", "200"))
+			Expect(spans[0].Error).To(BeEmpty())
+
+			passedRequest, _ := fakeConnection.MakeArgsForCall(0)
+			Expect(passedRequest.Header.Get("traceparent")).To(Equal(spans[0].TraceParent()))
+		})
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("no network")})
+		})
+
+		It("records the error", func() {
+			Expect(makeErr).To(MatchError(uaa.RequestError{Err: errors.New("no network")}))
+
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans[0].Error).To(Equal(uaa.RequestError{Err: errors.New("no network")}.Error()))
+			Expect(spans[0].Attributes).ToNot(HaveKey("http.response.status_code"))
+		})
+	})
+})
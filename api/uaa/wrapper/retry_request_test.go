@@ -1,10 +1,16 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
@@ -40,7 +46,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -56,6 +62,9 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -69,10 +78,134 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Context("when the policy has a backoff", func() {
+		var (
+			request  *http.Request
+			response *uaa.Response
+			sleeps   []time.Duration
+
+			fakeConnection *uaafakes.FakeConnection
+			wrapper        uaa.Connection
+		)
+
+		BeforeEach(func() {
+			sleeps = nil
+			fakeConnection = new(uaafakes.FakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{
+				MaxRetries: 2,
+				Backoff:    time.Second,
+				Sleep:      func(delay time.Duration) { sleeps = append(sleeps, delay) },
+				Jitter:     func(n int64) int64 { return n / 2 },
+			}).Wrap(fakeConnection)
+		})
+
+		Context("when the server keeps failing", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &uaa.Response{
+					HTTPResponse: &http.Response{StatusCode: http.StatusBadGateway},
+				}
+				fakeConnection.MakeReturns(uaa.RawHTTPStatusError{StatusCode: http.StatusBadGateway})
+			})
+
+			It("waits exponentially longer between retries", func() {
+				_ = wrapper.Make(request, response)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(sleeps).To(Equal([]time.Duration{time.Second, 2 * time.Second}))
+			})
+
+			It("marks each retry on the request for the request logger", func() {
+				var attempts []retry.Attempt
+				fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+					if attempt, ok := retry.AttemptFromRequest(req); ok {
+						attempts = append(attempts, attempt)
+					}
+					return uaa.RawHTTPStatusError{StatusCode: http.StatusBadGateway}
+				}
+
+				_ = wrapper.Make(request, response)
+				Expect(attempts).To(Equal([]retry.Attempt{
+					{Retry: 1, MaxRetries: 2, Delay: time.Second, Reason: "502 Bad Gateway"},
+					{Retry: 2, MaxRetries: 2, Delay: 2 * time.Second, Reason: "502 Bad Gateway"},
+				}))
+			})
+		})
+
+		Context("when the server responds with a Retry-After header", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &uaa.Response{
+					HTTPResponse: &http.Response{
+						StatusCode: http.StatusTooManyRequests,
+						Header:     http.Header{"Retry-After": {"7"}},
+					},
+				}
+				fakeConnection.MakeReturnsOnCall(0, uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+			})
+
+			It("waits as long as the server asks", func() {
+				err := wrapper.Make(request, response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(sleeps).To(Equal([]time.Duration{7 * time.Second}))
+			})
+		})
+
+		Context("when the connection is reset", func() {
+			var networkErr error
+
+			BeforeEach(func() {
+				response = &uaa.Response{}
+				networkErr = uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}
+				fakeConnection.MakeReturnsOnCall(0, networkErr)
+			})
+
+			It("retries idempotent requests", func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = wrapper.Make(request, response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			It("does not retry POST requests", func() {
+				var err error
+				request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = wrapper.Make(request, response)
+				Expect(err).To(MatchError(networkErr))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request fails with a non-network error", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				response = &uaa.Response{}
+				fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("unsupported protocol scheme")})
+			})
+
+			It("does not retry", func() {
+				_ = wrapper.Make(request, response)
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(sleeps).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 )
@@ -18,10 +20,12 @@ type UAAClient interface {
 
 //go:generate counterfeiter . TokenCache
 
-// TokenCache is where the UAA token information is stored.
+// TokenCache is where the UAA token information is stored. SaveCredentials
+// persists refreshed tokens to the configured credential store.
 type TokenCache interface {
 	AccessToken() string
 	RefreshToken() string
+	SaveCredentials() error
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
 }
@@ -29,17 +33,23 @@ type TokenCache interface {
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
-	connection uaa.Connection
-	client     UAAClient
-	cache      TokenCache
+	connection  uaa.Connection
+	client      UAAClient
+	cache       TokenCache
+	refreshSkew time.Duration
+	// mutex keeps concurrent requests from refreshing the same token more
+	// than once.
+	mutex sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
-// the client and token cache.
-func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+// the client and token cache. Access tokens that expire within refreshSkew are
+// refreshed before the request is made.
+func NewUAAAuthentication(client UAAClient, cache TokenCache, refreshSkew time.Duration) *UAAAuthentication {
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:      client,
+		cache:       cache,
+		refreshSkew: refreshSkew,
 	}
 }
 
@@ -50,7 +60,8 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 }
 
 // Make adds authentication headers to the passed in request and then calls the
-// wrapped connection's Make
+// wrapped connection's Make. An access token that is about to expire is
+// refreshed first.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
@@ -69,36 +80,82 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken, err := t.validAccessToken()
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err = t.replaceAccessToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		return t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
 
+// validAccessToken returns the cached access token, refreshing it first if it
+// expires within the refresh skew.
+func (t *UAAAuthentication) validAccessToken() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !uaa.AccessTokenValidFor(t.cache.AccessToken(), t.refreshSkew) {
+		err := t.refreshToken()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return t.cache.AccessToken(), nil
+}
+
+// replaceAccessToken refreshes an access token UAA rejected, unless a
+// concurrent request has already replaced it, and returns the new token.
+func (t *UAAAuthentication) replaceAccessToken(rejectedToken string) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.cache.AccessToken() == rejectedToken {
+		err := t.refreshToken()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return t.cache.AccessToken(), nil
+}
+
+// refreshToken gets a new access token and saves it in the token cache.
+func (t *UAAAuthentication) refreshToken() error {
+	token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(token.AuthorizationToken())
+	t.cache.SetRefreshToken(token.RefreshToken)
+	return t.cache.SaveCredentials()
+}
+
 // The authentication header is not added to token refresh requests or login
-// requests.
+// requests, including client credentials logins.
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	stringBody := string(body)
 
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password"))
+			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
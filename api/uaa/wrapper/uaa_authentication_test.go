@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -32,7 +34,7 @@ var _ = Describe("UAA Authentication", func() {
 		fakeClient = new(wrapperfakes.FakeUAAClient)
 		inMemoryCache = util.NewInMemoryTokenCache()
 
-		inner := NewUAAAuthentication(fakeClient, inMemoryCache)
+		inner := NewUAAAuthentication(fakeClient, inMemoryCache, time.Minute)
 		wrapper = inner.Wrap(fakeConnection)
 	})
 
@@ -145,6 +147,163 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token expires within the refresh skew", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, server.URL(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				inMemoryCache.SetAccessToken(accessTokenExpiringIn(30 * time.Second))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+		})
+
+		Context("when a concurrent request has already replaced the rejected token", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, server.URL(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				inMemoryCache.SetAccessToken("what")
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					if fakeConnection.MakeCallCount() == 1 {
+						inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+			})
+
+			It("resends the request with that token without refreshing again", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+			})
+		})
+
+		Context("when several requests find the token expiring at the same time", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(accessTokenExpiringIn(30 * time.Second))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 5; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						request, err := http.NewRequest(http.MethodGet, server.URL(), nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+					}()
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(5))
+				for i := 0; i < 5; i++ {
+					authenticatedRequest, _ := fakeConnection.MakeArgsForCall(i)
+					Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+				}
+			})
+		})
+
+		Context("when the token expires after the refresh skew", func() {
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, server.URL(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				inMemoryCache.SetAccessToken(accessTokenExpiringIn(time.Hour))
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the token is invalid and the token cache saves credentials to a store", func() {
+			var fakeCache *wrapperfakes.FakeTokenCache
+
+			BeforeEach(func() {
+				fakeCache = new(wrapperfakes.FakeTokenCache)
+				wrapper = NewUAAAuthentication(fakeClient, fakeCache, time.Minute).Wrap(fakeConnection)
+
+				var err error
+				request, err = http.NewRequest(http.MethodGet, server.URL(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeConnection.MakeReturnsOnCall(0, uaa.InvalidAuthTokenError{})
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("saves the refreshed tokens before resending the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCache.SetAccessTokenArgsForCall(0)).To(Equal("bearer foobar-2"))
+				Expect(fakeCache.SetRefreshTokenArgsForCall(0)).To(Equal("bananananananana"))
+				Expect(fakeCache.SaveCredentialsCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			It("returns the error when saving the credentials fails", func() {
+				expectedErr := errors.New("credential helper failed")
+				fakeCache.SaveCredentialsReturns(expectedErr)
+
+				err := wrapper.Make(request, nil)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -196,5 +355,31 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 			})
 		})
+
+		Context("when logging in with client credentials", func() {
+			var originalAuthHeader string
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type": {"client_credentials"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth("some-client", "some-secret")
+				originalAuthHeader = request.Header.Get("Authorization")
+
+				inMemoryCache.SetAccessToken("some-access-token")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not change the 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+			})
+		})
 	})
 })
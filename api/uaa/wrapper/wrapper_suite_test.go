@@ -2,7 +2,10 @@ package wrapper_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"log"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,3 +37,11 @@ var _ = SynchronizedAfterSuite(func() {
 var _ = BeforeEach(func() {
 	server.Reset()
 })
+
+// accessTokenExpiringIn returns an unsigned bearer token whose exp claim is
+// the provided duration from now.
+func accessTokenExpiringIn(duration time.Duration) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	claims := fmt.Sprintf(`{"exp":%d}`, time.Now().Add(duration).Unix())
+	return "bearer " + encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(claims)) + "."
+}
@@ -26,6 +26,15 @@ type FakeTokenCache struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SaveCredentialsStub        func() error
+	saveCredentialsMutex       sync.RWMutex
+	saveCredentialsArgsForCall []struct{}
+	saveCredentialsReturns     struct {
+		result1 error
+	}
+	saveCredentialsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -120,6 +129,46 @@ func (fake *FakeTokenCache) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTokenCache) SaveCredentials() error {
+	fake.saveCredentialsMutex.Lock()
+	ret, specificReturn := fake.saveCredentialsReturnsOnCall[len(fake.saveCredentialsArgsForCall)]
+	fake.saveCredentialsArgsForCall = append(fake.saveCredentialsArgsForCall, struct{}{})
+	fake.recordInvocation("SaveCredentials", []interface{}{})
+	fake.saveCredentialsMutex.Unlock()
+	if fake.SaveCredentialsStub != nil {
+		return fake.SaveCredentialsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveCredentialsReturns.result1
+}
+
+func (fake *FakeTokenCache) SaveCredentialsCallCount() int {
+	fake.saveCredentialsMutex.RLock()
+	defer fake.saveCredentialsMutex.RUnlock()
+	return len(fake.saveCredentialsArgsForCall)
+}
+
+func (fake *FakeTokenCache) SaveCredentialsReturns(result1 error) {
+	fake.SaveCredentialsStub = nil
+	fake.saveCredentialsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTokenCache) SaveCredentialsReturnsOnCall(i int, result1 error) {
+	fake.SaveCredentialsStub = nil
+	if fake.saveCredentialsReturnsOnCall == nil {
+		fake.saveCredentialsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCredentialsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTokenCache) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -175,6 +224,8 @@ func (fake *FakeTokenCache) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.saveCredentialsMutex.RLock()
+	defer fake.saveCredentialsMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -27,6 +27,7 @@ type Repository interface {
 	net.RequestDumperInterface
 
 	RefreshAuthToken() (updatedToken string, apiErr error)
+	ValidAccessToken() (accessToken string, apiErr error)
 	Authenticate(credentials map[string]string) (apiErr error)
 	Authorize(token string) (string, error)
 	GetLoginPromptsAndSaveUAAServerURL() (map[string]coreconfig.AuthPrompt, error)
@@ -187,12 +188,31 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 		"scope":         {""},
 	}
 
+	// Client credentials sessions have no refresh token; a new token is
+	// requested with the client's credentials instead.
+	if uaa.config.UAAGrantType() == coreconfig.ClientCredentialsGrantType {
+		data = url.Values{
+			"grant_type": {coreconfig.ClientCredentialsGrantType},
+		}
+	}
+
 	apiErr := uaa.getAuthToken(data)
 	updatedToken := uaa.config.AccessToken()
 
 	return updatedToken, apiErr
 }
 
+// ValidAccessToken returns the current access token when it is valid for at
+// least the token refresh skew, and refreshes it otherwise.
+func (uaa UAARepository) ValidAccessToken() (string, error) {
+	accessToken := uaa.config.AccessToken()
+	if accessToken != "" && coreconfig.AccessTokenValidFor(accessToken, coreconfig.TokenRefreshSkew()) {
+		return accessToken, nil
+	}
+
+	return uaa.RefreshAuthToken()
+}
+
 func (uaa UAARepository) getAuthToken(data url.Values) error {
 	type uaaErrorResponse struct {
 		Code        string `json:"error"`
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/net"
@@ -222,6 +223,63 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 		})
+		Describe("getting a valid access token", func() {
+			var (
+				accessToken string
+				apiErr      error
+			)
+
+			tokenExpiringIn := func(duration time.Duration) string {
+				claims := fmt.Sprintf(`{"exp":%d}`, time.Now().Add(duration).Unix())
+				return "bearer e30." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2lnbmF0dXJl"
+			}
+
+			JustBeforeEach(func() {
+				accessToken, apiErr = auth.ValidAccessToken()
+			})
+
+			Context("when the access token is valid for longer than the refresh skew", func() {
+				var currentToken string
+
+				BeforeEach(func() {
+					setupTestServer(successfulRefreshRequest)
+					currentToken = tokenExpiringIn(time.Hour)
+					config.SetAccessToken(currentToken)
+				})
+
+				It("returns the current access token without refreshing it", func() {
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(accessToken).To(Equal(currentToken))
+					Expect(handler.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the access token expires within the refresh skew", func() {
+				BeforeEach(func() {
+					setupTestServer(successfulRefreshRequest)
+					config.SetAccessToken(tokenExpiringIn(30 * time.Second))
+					config.SetRefreshToken("my_refresh_token")
+				})
+
+				It("refreshes the access token", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(accessToken).To(Equal("BEARER my_refreshed_access_token"))
+					Expect(config.AccessToken()).To(Equal("BEARER my_refreshed_access_token"))
+				})
+			})
+
+			Context("when refreshing the access token fails", func() {
+				BeforeEach(func() {
+					setupTestServer(errorLoginRequest)
+					config.SetAccessToken(tokenExpiringIn(-time.Hour))
+				})
+
+				It("returns the API error", func() {
+					Expect(apiErr).To(HaveOccurred())
+				})
+			})
+		})
 	})
 
 	Describe("Authorize", func() {
@@ -368,6 +426,32 @@ var unsuccessfulLoginRequest = testnet.TestRequest{
 		Status: http.StatusUnauthorized,
 	},
 }
+var successfulRefreshRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: authHeaders,
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("refresh_token"))
+		Expect(request.Form.Get("refresh_token")).To(Equal("my_refresh_token"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_refreshed_access_token",
+  "token_type": "BEARER",
+  "refresh_token": "my_new_refresh_token",
+  "scope": "openid",
+  "expires_in": 98765
+} `},
+}
+
 var refreshTokenExpiredRequestError = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
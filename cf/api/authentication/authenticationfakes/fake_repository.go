@@ -27,6 +27,13 @@ type FakeRepository struct {
 		result1 string
 		result2 error
 	}
+	ValidAccessTokenStub        func() (accessToken string, apiErr error)
+	validAccessTokenMutex       sync.RWMutex
+	validAccessTokenArgsForCall []struct{}
+	validAccessTokenReturns     struct {
+		result1 string
+		result2 error
+	}
 	AuthenticateStub        func(credentials map[string]string) (apiErr error)
 	authenticateMutex       sync.RWMutex
 	authenticateArgsForCall []struct {
@@ -129,6 +136,32 @@ func (fake *FakeRepository) RefreshAuthTokenReturns(result1 string, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) ValidAccessToken() (accessToken string, apiErr error) {
+	fake.validAccessTokenMutex.Lock()
+	fake.validAccessTokenArgsForCall = append(fake.validAccessTokenArgsForCall, struct{}{})
+	fake.recordInvocation("ValidAccessToken", []interface{}{})
+	fake.validAccessTokenMutex.Unlock()
+	if fake.ValidAccessTokenStub != nil {
+		return fake.ValidAccessTokenStub()
+	} else {
+		return fake.validAccessTokenReturns.result1, fake.validAccessTokenReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ValidAccessTokenCallCount() int {
+	fake.validAccessTokenMutex.RLock()
+	defer fake.validAccessTokenMutex.RUnlock()
+	return len(fake.validAccessTokenArgsForCall)
+}
+
+func (fake *FakeRepository) ValidAccessTokenReturns(result1 string, result2 error) {
+	fake.ValidAccessTokenStub = nil
+	fake.validAccessTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) Authenticate(credentials map[string]string) (apiErr error) {
 	fake.authenticateMutex.Lock()
 	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
@@ -231,6 +264,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.dumpResponseMutex.RUnlock()
 	fake.refreshAuthTokenMutex.RLock()
 	defer fake.refreshAuthTokenMutex.RUnlock()
+	fake.validAccessTokenMutex.RLock()
+	defer fake.validAccessTokenMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
 	fake.authorizeMutex.RLock()
@@ -8,6 +8,7 @@ import (
 
 	"path/filepath"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commandsloader"
 	"code.cloudfoundry.org/cli/cf/configuration"
@@ -22,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/spellcheck"
+	"code.cloudfoundry.org/cli/version"
 
 	netrpc "net/rpc"
 )
@@ -45,7 +47,7 @@ func Main(traceEnv string, args []string) {
 	args = append([]string{args[0]}, handleHelp(args[1:])...)
 
 	newArgs, isVerbose := handleVerbose(args)
-	args = newArgs
+	args = handleTimings(handleNoCache(handleOutputFormat(handleContext(newArgs))))
 
 	errFunc := func(err error) {
 		if err != nil {
@@ -90,6 +92,7 @@ func Main(traceEnv string, args []string) {
 	cmd := cmdRegistry.FindCommand(cmdName)
 	if cmd != nil {
 		meta := cmd.MetaData()
+		finishTrace := startCommandTrace("cf " + meta.Name)
 		flagContext := flags.NewFlagContext(meta.Flags)
 		flagContext.SkipFlagParsing(meta.SkipFlagParsing)
 
@@ -98,6 +101,7 @@ func Main(traceEnv string, args []string) {
 		if err != nil {
 			usage := cmdRegistry.CommandUsage(cmdName)
 			deps.UI.Failed(T("Incorrect Usage") + "\n\n" + err.Error() + "\n\n" + usage)
+			finishTrace(err)
 			os.Exit(1)
 		}
 
@@ -107,6 +111,7 @@ func Main(traceEnv string, args []string) {
 		requirementsFactory := requirements.NewFactory(deps.Config, deps.RepoLocator)
 		reqs, reqErr := cmd.Requirements(requirementsFactory, flagContext)
 		if reqErr != nil {
+			finishTrace(reqErr)
 			os.Exit(1)
 		}
 
@@ -114,6 +119,7 @@ func Main(traceEnv string, args []string) {
 			err = req.Execute()
 			if err != nil {
 				deps.UI.Failed(err.Error())
+				finishTrace(err)
 				os.Exit(1)
 			}
 		}
@@ -121,9 +127,12 @@ func Main(traceEnv string, args []string) {
 		err = cmd.Execute(flagContext)
 		if err != nil {
 			deps.UI.Failed(err.Error())
+			finishTrace(err)
 			os.Exit(1)
 		}
 
+		finishTrace(nil)
+
 		err = warningsCollector.PrintWarnings()
 		if err != nil {
 			deps.UI.Failed(err.Error())
@@ -141,6 +150,10 @@ func Main(traceEnv string, args []string) {
 		os.Exit(1)
 	}
 
+	if traceExport := os.Getenv("CF_TRACE_EXPORT"); traceExport != "" {
+		rpcService.Tracer = tracing.NewTracer(tracing.NewExporter(traceExport, version.VersionString()))
+	}
+
 	pluginPath := filepath.Join(confighelpers.PluginRepoDir(), ".cf", "plugins")
 	pluginConfig := pluginconfig.NewPluginConfig(
 		func(err error) {
@@ -159,6 +172,32 @@ func Main(traceEnv string, args []string) {
 	}
 }
 
+// startCommandTrace starts a trace for a legacy command when CF_TRACE_EXPORT
+// is set, so that the requests its gateways make are traced. It replaces any
+// trace the command wrapper started, since Main exits before that one could be
+// exported. It returns a function that finishes the span with the command's
+// error and exports the trace; os.Exit skips deferred calls, so it has to be
+// called before exiting.
+func startCommandTrace(name string) func(error) {
+	traceExport := os.Getenv("CF_TRACE_EXPORT")
+	if traceExport == "" {
+		return func(error) {}
+	}
+
+	tracer := tracing.NewTracer(tracing.NewExporter(traceExport, version.VersionString()))
+	tracing.SetCurrent(tracer)
+	span := tracer.StartCommand(name)
+
+	return func(err error) {
+		span.SetError(err)
+		span.Finish()
+		flushErr := tracer.Flush()
+		if flushErr != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting trace: %s\n", flushErr.Error())
+		}
+	}
+}
+
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
 	cmdSuggester := spellcheck.NewCommandSuggester(cmdsList)
 	recommendedCmds := cmdSuggester.Recommend(cmdName)
@@ -222,3 +261,57 @@ func handleVerbose(args []string) ([]string, bool) {
 
 	return args, verbose
 }
+
+func handleContext(args []string) []string {
+	for i, arg := range args {
+		switch {
+		case arg == "--context" && i+1 < len(args):
+			os.Setenv("CF_CONTEXT", args[i+1])
+			return append(args[:i], args[i+2:]...)
+		case strings.HasPrefix(arg, "--context="):
+			os.Setenv("CF_CONTEXT", strings.TrimPrefix(arg, "--context="))
+			return append(args[:i], args[i+1:]...)
+		}
+	}
+
+	return args
+}
+
+// handleOutputFormat removes '--output table', the only output format the
+// legacy commands support.
+func handleOutputFormat(args []string) []string {
+	for i, arg := range args {
+		switch {
+		case arg == "--output" && i+1 < len(args) && args[i+1] == "table":
+			return append(args[:i], args[i+2:]...)
+		case arg == "--output=table":
+			return append(args[:i], args[i+1:]...)
+		}
+	}
+
+	return args
+}
+
+// handleNoCache removes '--no-cache', since the legacy commands do not cache
+// responses.
+func handleNoCache(args []string) []string {
+	for i, arg := range args {
+		if arg == "--no-cache" {
+			return append(args[:i], args[i+1:]...)
+		}
+	}
+
+	return args
+}
+
+// handleTimings removes '--timings', since the legacy commands do not record
+// timings.
+func handleTimings(args []string) []string {
+	for i, arg := range args {
+		if arg == "--timings" || strings.HasPrefix(arg, "--timings=") {
+			return append(args[:i], args[i+1:]...)
+		}
+	}
+
+	return args
+}
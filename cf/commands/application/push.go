@@ -27,6 +27,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	"code.cloudfoundry.org/cli/util/words/generator"
 )
 
@@ -76,6 +77,8 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["no-start"] = &flags.BoolFlag{Name: "no-start", Usage: T("Do not start an app after pushing")}
 	fs["random-route"] = &flags.BoolFlag{Name: "random-route", Usage: T("Create a random route for this app")}
 	fs["route-path"] = &flags.StringFlag{Name: "route-path", Usage: T("Path for the route")}
+	fs["var"] = &flags.StringSliceFlag{Name: "var", Usage: T("Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times")}
+	fs["vars-file"] = &flags.StringSliceFlag{Name: "vars-file", Usage: T("Path to a variable substitution file for manifest; can specify multiple times")}
 	// Hidden:true to hide app-ports for release #117189491
 	fs["app-ports"] = &flags.StringFlag{Name: "app-ports", Usage: T("Comma delimited list of ports the application may listen on"), Hidden: true}
 
@@ -84,7 +87,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 		ShortName:   "p",
 		Description: T("Push a new app or sync changes to an existing app"),
 		// strings.Replace \\n with newline so this string matches the new usage string but still gets displayed correctly
-		Usage: []string{strings.Replace(T("cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"), "\\n", "\n", -1)},
+		Usage: []string{strings.Replace(T("cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]\\n   [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]..."), "\\n", "\n", -1)},
 		Flags: fs,
 	}
 }
@@ -577,13 +580,18 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppPara
 		}
 	}
 
-	m, err := cmd.manifestRepo.ReadManifest(path)
+	vars, err := manifestvars.ReadVars(c.StringSlice("vars-file"), c.StringSlice("var"))
+	if err != nil {
+		return nil, translateManifestVarsError(err)
+	}
+
+	m, err := cmd.manifestRepo.ReadManifest(path, vars)
 
 	if err != nil {
 		if m.Path == "" && c.String("f") == "" {
 			return []models.AppParams{}, nil
 		}
-		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": translateManifestVarsError(err).Error()}))
 	}
 
 	apps, err := m.Applications()
@@ -880,3 +888,18 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 
 	return cmd.actor.UploadApp(appGUID, zipFile, remoteFiles)
 }
+
+// translateManifestVarsError translates the errors of reading the --var and
+// --vars-file values and of substituting them into the manifest.
+func translateManifestVarsError(err error) error {
+	switch e := err.(type) {
+	case manifestvars.InvalidVarError:
+		return errors.New(T("Incorrect Usage: '--var {{.Var}}' must be in the format KEY=VALUE.", map[string]interface{}{"Var": e.Var}))
+	case manifestvars.InvalidVarsFileError:
+		return errors.New(T("Invalid vars file {{.Path}}: expected a map of variable names to values.", map[string]interface{}{"Path": e.Path}))
+	case manifestvars.UnresolvedVariablesError:
+		return errors.New(T("Expected to find variables: {{.Names}}", map[string]interface{}{"Names": strings.Join(e.Names, ", ")}))
+	default:
+		return err
+	}
+}
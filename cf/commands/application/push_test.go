@@ -28,6 +28,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/util/generic"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
 	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
@@ -1063,8 +1064,8 @@ var _ = Describe("Push Command", func() {
 							deps.UI = uiWithContents
 
 							expectedDomain = models.DomainFields{
-								GUID: "some-guid",
-								Name: "some-name",
+								GUID:                   "some-guid",
+								Name:                   "some-name",
 								OwningOrganizationGUID: "some-organization-guid",
 								RouterGroupGUID:        "some-router-group-guid",
 								RouterGroupType:        "tcp",
@@ -1276,7 +1277,57 @@ var _ = Describe("Push Command", func() {
 						Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("Using manifest file manifest.yml"))
 
 						cwd, _ := os.Getwd()
-						Expect(manifestRepo.ReadManifestArgsForCall(0)).To(Equal(cwd))
+						path, _ := manifestRepo.ReadManifestArgsForCall(0)
+						Expect(path).To(Equal(cwd))
+					})
+				})
+
+				Context("when --var flags are passed", func() {
+					BeforeEach(func() {
+						m := &manifest.Manifest{
+							Path: "manifest.yml",
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name": "manifest-app-name",
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+
+						args = []string{"--var", "name=some-app", "--var", "instances=3"}
+					})
+
+					It("reads the manifest with the vars", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						_, vars := manifestRepo.ReadManifestArgsForCall(0)
+						Expect(vars).To(Equal(manifestvars.Vars{
+							"name":      "some-app",
+							"instances": "3",
+						}))
+					})
+				})
+
+				Context("when a --var flag is not KEY=VALUE", func() {
+					BeforeEach(func() {
+						args = []string{"--var", "name"}
+					})
+
+					It("returns an error", func() {
+						Expect(executeErr).To(MatchError("Incorrect Usage: '--var name' must be in the format KEY=VALUE."))
+						Expect(manifestRepo.ReadManifestCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the manifest has variables without a value", func() {
+					BeforeEach(func() {
+						manifestRepo.ReadManifestReturns(&manifest.Manifest{Path: "manifest.yml"}, manifestvars.UnresolvedVariablesError{Names: []string{"name", "instances"}})
+					})
+
+					It("returns an error that lists every missing variable", func() {
+						Expect(executeErr).To(MatchError("Error reading manifest file:\nExpected to find variables: name, instances"))
 					})
 				})
 
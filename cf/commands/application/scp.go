@@ -0,0 +1,169 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+type SCP struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	gateway       net.Gateway
+	appReq        requirements.ApplicationRequirement
+	sshCodeGetter commands.SSHCodeGetter
+	opts          *options.SCPOptions
+	secureShell   sshCmd.SecureShell
+}
+
+func init() {
+	commandregistry.Register(&SCP{})
+}
+
+func (cmd *SCP) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
+	fs["recursive"] = &flags.BoolFlag{Name: "recursive", ShortName: "r", Usage: T("Copy directories recursively")}
+	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "scp",
+		Description: T("Copy files to or from an application container instance"),
+		Usage: []string{
+			T("CF_NAME scp [-i app-instance-index] [--recursive] [--skip-host-validation] SOURCE TARGET\n\n   Either SOURCE or TARGET must be APP_NAME:PATH. Paths on the application instance are relative to its home directory."),
+		},
+		Examples: []string{
+			"CF_NAME scp ./config.yml my-app:app/config.yml",
+			"CF_NAME scp -i 1 my-app:logs/app.log .",
+			"CF_NAME scp -r my-app:app/tmp ./tmp",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SCP) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires SOURCE and TARGET as arguments") + "\n\n" + commandregistry.Commands.CommandUsage("scp"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
+	}
+
+	var err error
+	cmd.opts, err = options.NewSCPOptions(fc)
+
+	if err != nil {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", err.Error(), commandregistry.Commands.CommandUsage("scp")))
+		return nil, err
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.opts.AppName)
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs, nil
+}
+
+func (cmd *SCP) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.gateway = deps.Gateways["cloud-controller"]
+
+	if deps.WildcardDependency != nil {
+		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
+	}
+
+	//get ssh-code for dependency
+	sshCodeGetter := commandregistry.Commands.FindCommand("ssh-code")
+	sshCodeGetter = sshCodeGetter.SetDependency(deps, false)
+	cmd.sshCodeGetter = sshCodeGetter.(commands.SSHCodeGetter)
+
+	return cmd
+}
+
+func (cmd *SCP) Execute(fc flags.FlagContext) error {
+	if fc.IsSet("i") {
+		instanceIndex := fc.Int("i")
+		if instanceIndex < 0 {
+			return errors.New(T("The application instance index cannot be negative"))
+		}
+		if instanceIndex >= cmd.appReq.GetApplication().InstanceCount {
+			return errors.New(T("The specified application instance does not exist"))
+		}
+	}
+
+	app := cmd.appReq.GetApplication()
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
+	if err != nil {
+		return errors.New(T("Error getting SSH info:") + err.Error())
+	}
+
+	sshAuthCode, err := cmd.sshCodeGetter.Get()
+	if err != nil {
+		return errors.New(T("Error getting one time auth code: ") + err.Error())
+	}
+
+	//init secureShell if it is not already set by SetDependency() with fakes
+	if cmd.secureShell == nil {
+		cmd.secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		)
+	}
+
+	err = cmd.secureShell.Connect(cmd.opts.SSHOptions())
+	if err != nil {
+		return errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+	defer cmd.secureShell.Close()
+
+	progress := progressbar.NewFileProgressBar(cmd.ui.Writer())
+	if cmd.opts.Upload {
+		cmd.ui.Say(T("Copying {{.Source}} to {{.Target}} on instance {{.Index}} of app {{.AppName}} as {{.Username}}...", map[string]interface{}{
+			"Source":   terminal.EntityNameColor(cmd.opts.LocalPath),
+			"Target":   terminal.EntityNameColor(cmd.opts.RemotePath),
+			"Index":    cmd.opts.Index,
+			"AppName":  terminal.EntityNameColor(app.Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+		err = cmd.secureShell.CopyToRemote(cmd.opts.LocalPath, cmd.opts.RemotePath, cmd.opts.Recursive, progress)
+	} else {
+		cmd.ui.Say(T("Copying {{.Source}} on instance {{.Index}} of app {{.AppName}} to {{.Target}} as {{.Username}}...", map[string]interface{}{
+			"Source":   terminal.EntityNameColor(cmd.opts.RemotePath),
+			"Target":   terminal.EntityNameColor(cmd.opts.LocalPath),
+			"Index":    cmd.opts.Index,
+			"AppName":  terminal.EntityNameColor(app.Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+		err = cmd.secureShell.CopyFromRemote(cmd.opts.RemotePath, cmd.opts.LocalPath, cmd.opts.Recursive, progress)
+	}
+	progress.Complete()
+
+	if err != nil {
+		return errors.New(T("Error copying files: ") + err.Error())
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
@@ -0,0 +1,231 @@
+package application_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		applicationReq      *requirementsfakes.FakeApplicationRequirement
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+		testServer          *httptest.Server
+
+		fakeSecureShell *sshfakes.FakeSecureShell
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		deps.Gateways = make(map[string]net.Gateway)
+
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+		app := models.Application{}
+		app.Name = "my-app"
+		app.State = "started"
+		app.GUID = "my-app-guid"
+		app.Diego = true
+		app.InstanceCount = 2
+
+		applicationReq = new(requirementsfakes.FakeApplicationRequirement)
+		applicationReq.GetApplicationReturns(app)
+		requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+		//save original command and restore later
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		deps.WildcardDependency = fakeSecureShell
+
+		getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/info",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body:   getInfoResponseBody,
+			},
+		})
+
+		testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+		configRepo.SetAPIEndpoint(testServer.URL)
+		deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+
+		//restore original command
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		//inject fake 'sshCodeGetter' into registry
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("scp").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("scp", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly two args", func() {
+			Expect(runCommand("my-app:file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires SOURCE and TARGET as arguments"},
+			))
+		})
+
+		It("fails with usage when neither argument is on the application instance", func() {
+			Expect(runCommand("file", "other-file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "APP_NAME:PATH"},
+				[]string{"USAGE:"},
+			))
+		})
+
+		It("requires the application named in the remote argument", func() {
+			Expect(runCommand("file", "my-app:file")).To(BeTrue())
+			Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+
+		It("fails requirements when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("file", "my-app:file")).To(BeFalse())
+		})
+	})
+
+	Context("when the app index exceeds the last valid index", func() {
+		It("returns an error", func() {
+			Expect(runCommand("-i", "2", "file", "my-app:file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"The specified application instance does not exist"},
+			))
+		})
+	})
+
+	Context("when getting the one time auth code fails", func() {
+		BeforeEach(func() {
+			sshCodeGetter.GetReturns("", errors.New("auth api error"))
+		})
+
+		It("notifies users", func() {
+			Expect(runCommand("file", "my-app:file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error getting one time auth code", "auth api error"},
+			))
+		})
+	})
+
+	Context("when connecting fails", func() {
+		BeforeEach(func() {
+			fakeSecureShell.ConnectReturns(errors.New("dial error"))
+		})
+
+		It("notifies users", func() {
+			Expect(runCommand("file", "my-app:file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error opening SSH connection", "dial error"},
+			))
+			Expect(fakeSecureShell.CopyToRemoteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the target is on the application instance", func() {
+		It("connects to the instance and uploads the source", func() {
+			Expect(runCommand("-i", "1", "-k", "-r", "local-dir", "my-app:app/dir")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(*fakeSecureShell.ConnectArgsForCall(0)).To(Equal(options.SSHOptions{
+				AppName:            "my-app",
+				Index:              1,
+				SkipHostValidation: true,
+			}))
+
+			Expect(fakeSecureShell.CopyToRemoteCallCount()).To(Equal(1))
+			localPath, remotePath, recursive, progress := fakeSecureShell.CopyToRemoteArgsForCall(0)
+			Expect(localPath).To(Equal("local-dir"))
+			Expect(remotePath).To(Equal("app/dir"))
+			Expect(recursive).To(BeTrue())
+			Expect(progress).NotTo(BeNil())
+
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Copying local-dir to app/dir on instance 1 of app my-app as my-user..."},
+				[]string{"OK"},
+			))
+		})
+	})
+
+	Context("when the source is on the application instance", func() {
+		It("downloads the source", func() {
+			Expect(runCommand("my-app:logs/app.log", ".")).To(BeTrue())
+
+			Expect(fakeSecureShell.CopyFromRemoteCallCount()).To(Equal(1))
+			remotePath, localPath, recursive, _ := fakeSecureShell.CopyFromRemoteArgsForCall(0)
+			Expect(remotePath).To(Equal("logs/app.log"))
+			Expect(localPath).To(Equal("."))
+			Expect(recursive).To(BeFalse())
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Copying logs/app.log on instance 0 of app my-app to . as my-user..."},
+				[]string{"OK"},
+			))
+		})
+
+		Context("when the copy fails", func() {
+			BeforeEach(func() {
+				fakeSecureShell.CopyFromRemoteReturns(errors.New("No such file or directory"))
+			})
+
+			It("notifies users", func() {
+				Expect(runCommand("my-app:logs/app.log", ".")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error copying files", "No such file or directory"},
+				))
+			})
+		})
+	})
+})
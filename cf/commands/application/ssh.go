@@ -8,11 +8,13 @@ import (
 
 	"golang.org/x/crypto/ssh"
 
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
@@ -22,13 +24,14 @@ import (
 )
 
 type SSH struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	gateway       net.Gateway
-	appReq        requirements.ApplicationRequirement
-	sshCodeGetter commands.SSHCodeGetter
-	opts          *options.SSHOptions
-	secureShell   sshCmd.SecureShell
+	ui               terminal.UI
+	config           coreconfig.Reader
+	gateway          net.Gateway
+	appReq           requirements.ApplicationRequirement
+	appInstancesRepo appinstances.Repository
+	sshCodeGetter    commands.SSHCodeGetter
+	opts             *options.SSHOptions
+	secureShell      sshCmd.SecureShell
 }
 
 type sshInfo struct {
@@ -43,10 +46,15 @@ func init() {
 func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["L"] = &flags.StringSliceFlag{ShortName: "L", Usage: T("Local port forward specification. This flag can be defined more than once.")}
+	fs["R"] = &flags.StringSliceFlag{ShortName: "R", Usage: T("Remote port forward specification, listening on the application instance. This flag can be defined more than once.")}
+	fs["D"] = &flags.StringSliceFlag{ShortName: "D", Usage: T("Dynamic port forward specification, starting a local SOCKS5 proxy. This flag can be defined more than once.")}
 	fs["command"] = &flags.StringSliceFlag{Name: "command", ShortName: "c", Usage: T("Command to run. This flag can be defined more than once.")}
 	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
 	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
 	fs["skip-remote-execution"] = &flags.BoolFlag{Name: "skip-remote-execution", ShortName: "N", Usage: T("Do not execute a remote command")}
+	fs["forward-only"] = &flags.BoolFlag{Name: "forward-only", Usage: T("Keep port forwards open without running a shell or command, until interrupted")}
+	fs["all-instances"] = &flags.BoolFlag{Name: "all-instances", Usage: T("Run the command on every running instance of the application")}
+	fs["concurrency"] = &flags.IntFlag{Name: "concurrency", Usage: T("Maximum number of instances to run the command on at once with --all-instances (Default: 8)")}
 	fs["request-pseudo-tty"] = &flags.BoolFlag{Name: "request-pseudo-tty", ShortName: "t", Usage: T("Request pseudo-tty allocation")}
 	fs["force-pseudo-tty"] = &flags.BoolFlag{Name: "force-pseudo-tty", ShortName: "tt", Usage: T("Force pseudo-tty allocation")}
 	fs["disable-pseudo-tty"] = &flags.BoolFlag{Name: "disable-pseudo-tty", ShortName: "T", Usage: T("Disable pseudo-tty allocation")}
@@ -55,7 +63,9 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--forward-only] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			"\n\n   ",
+			T("CF_NAME ssh APP_NAME --all-instances -c command [--concurrency max-instances] [--skip-host-validation] [--disable-pseudo-tty]"),
 		},
 		Flags: fs,
 	}
@@ -90,6 +100,7 @@ func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.gateway = deps.Gateways["cloud-controller"]
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 
 	if deps.WildcardDependency != nil {
 		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
@@ -115,11 +126,15 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	}
 
 	app := cmd.appReq.GetApplication()
-	info, err := cmd.getSSHEndpointInfo()
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
 	if err != nil {
 		return errors.New(T("Error getting SSH info:") + err.Error())
 	}
 
+	if cmd.opts.AllInstances {
+		return cmd.runOnAllInstances(app, info)
+	}
+
 	sshAuthCode, err := cmd.sshCodeGetter.Get()
 	if err != nil {
 		return errors.New(T("Error getting one time auth code: ") + err.Error())
@@ -150,8 +165,29 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error forwarding port: ") + err.Error())
 	}
 
+	err = cmd.secureShell.RemotePortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding remote port: ") + err.Error())
+	}
+
+	err = cmd.secureShell.DynamicPortForward()
+	if err != nil {
+		return errors.New(T("Error starting SOCKS proxy: ") + err.Error())
+	}
+
 	if cmd.opts.SkipRemoteExecution {
+		forwards := cmd.secureShell.Forwards()
+		if len(forwards) > 0 {
+			cmd.ui.Say(T("Forwarding ports. Press Ctrl-C to stop."))
+		}
+
 		err = cmd.secureShell.Wait()
+		if err == nil || err == sshCmd.ErrInterrupted {
+			cmd.displayForwards(cmd.secureShell.Forwards())
+		}
+		if err == sshCmd.ErrInterrupted {
+			return errors.New(T("Port forwarding interrupted"))
+		}
 	} else {
 		err = cmd.secureShell.InteractiveSession()
 	}
@@ -173,8 +209,113 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	return nil
 }
 
-func (cmd *SSH) getSSHEndpointInfo() (sshInfo, error) {
+func (cmd *SSH) runOnAllInstances(app models.Application, info sshInfo) error {
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return errors.New(T("Error getting application instances: ") + err.Error())
+	}
+
+	indexes := []int{}
+	for index, instance := range instances {
+		if instance.State == models.InstanceRunning {
+			indexes = append(indexes, index)
+		}
+	}
+
+	if len(indexes) == 0 {
+		return errors.New(T("Application {{.AppName}} has no running instances", map[string]interface{}{
+			"AppName": app.Name,
+		}))
+	}
+
+	newSecureShell := func() (sshCmd.SecureShell, error) {
+		//use the secureShell set by SetDependency() with fakes for every instance
+		if cmd.secureShell != nil {
+			return cmd.secureShell, nil
+		}
+
+		sshAuthCode, err := cmd.sshCodeGetter.Get()
+		if err != nil {
+			return nil, errors.New(T("Error getting one time auth code: ") + err.Error())
+		}
+
+		return sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		), nil
+	}
+
+	cmd.ui.Say(T("Running command on {{.Count}} instances of app {{.AppName}} as {{.Username}}...", map[string]interface{}{
+		"Count":    len(indexes),
+		"AppName":  terminal.EntityNameColor(app.Name),
+		"Username": terminal.EntityNameColor(cmd.config.Username()),
+	}))
+
+	results := sshCmd.RunOnInstances(newSecureShell, cmd.opts, indexes, cmd.ui.Writer(), os.Stderr)
+
+	failed := 0
+	for _, result := range results {
+		if !result.Failed() {
+			continue
+		}
+		failed++
+
+		switch {
+		case result.Err != nil:
+			cmd.ui.Say(T("Instance {{.Index}} failed: {{.Error}}", map[string]interface{}{
+				"Index": result.Index,
+				"Error": result.Err.Error(),
+			}))
+		case result.Signal != "":
+			cmd.ui.Say(T("Instance {{.Index}} terminated by signal: {{.Signal}}. Exited with {{.ExitCode}}", map[string]interface{}{
+				"Index":    result.Index,
+				"Signal":   result.Signal,
+				"ExitCode": result.ExitStatus,
+			}))
+		default:
+			cmd.ui.Say(T("Instance {{.Index}} exited with {{.ExitCode}}", map[string]interface{}{
+				"Index":    result.Index,
+				"ExitCode": result.ExitStatus,
+			}))
+		}
+	}
+
+	if failed > 0 {
+		return errors.New(T("Command failed on {{.Failed}} of {{.Total}} instances", map[string]interface{}{
+			"Failed": failed,
+			"Total":  len(results),
+		}))
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
+func (cmd *SSH) displayForwards(forwards []sshCmd.ForwardStatus) {
+	for _, forward := range forwards {
+		connectAddress := forward.ConnectAddress
+		if forward.Type == sshCmd.DynamicForward {
+			connectAddress = "SOCKS"
+		}
+
+		cmd.ui.Say(T("Closed {{.Type}} forward {{.ListenAddress}} -> {{.ConnectAddress}}: {{.Total}} connections, {{.Failed}} failed", map[string]interface{}{
+			"Type":           forward.Type,
+			"ListenAddress":  forward.ListenAddress,
+			"ConnectAddress": connectAddress,
+			"Total":          forward.TotalConnections,
+			"Failed":         forward.FailedConnections,
+		}))
+	}
+}
+
+func getSSHEndpointInfo(gateway net.Gateway, config coreconfig.Reader) (sshInfo, error) {
 	info := sshInfo{}
-	err := cmd.gateway.GetResource(cmd.config.APIEndpoint()+"/v2/info", &info)
+	err := gateway.GetResource(config.APIEndpoint()+"/v2/info", &info)
 	return info, err
 }
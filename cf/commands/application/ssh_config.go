@@ -0,0 +1,153 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type SSHConfig struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	gateway       net.Gateway
+	appReq        requirements.ApplicationRequirement
+	sshCodeGetter commands.SSHCodeGetter
+}
+
+func init() {
+	commandregistry.Register(&SSHConfig{})
+}
+
+func (cmd *SSHConfig) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Only print the configuration for this application instance index")}
+	fs["askpass"] = &flags.BoolFlag{Name: "askpass", Usage: T("Print a one time passcode for an ssh client, ignoring any prompt argument")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "ssh-config",
+		Description: T("Print OpenSSH client configuration for application container instances"),
+		Usage: []string{
+			T("CF_NAME ssh-config APP_NAME [-i app-instance-index]\n\n   CF_NAME ssh-config --askpass [PROMPT]\n\n   Prints a Host entry named APP_NAME.INDEX for each instance, which can be added to ~/.ssh/config.\n   The password for each connection is a one time passcode, which ssh can get from CF_NAME ssh-config --askpass."),
+		},
+		Examples: []string{
+			"CF_NAME ssh-config my-app >> ~/.ssh/config",
+			"ssh my-app.0",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SSHConfig) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if fc.Bool("askpass") {
+		if len(fc.Args()) > 1 {
+			cmd.ui.Failed(T("Incorrect Usage. --askpass takes at most one PROMPT argument") + "\n\n" + commandregistry.Commands.CommandUsage("ssh-config"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of at most %d allowed", len(fc.Args()), 1)
+		}
+
+		return []requirements.Requirement{
+			requirementsFactory.NewAPIEndpointRequirement(),
+		}, nil
+	}
+
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires APP_NAME as argument") + "\n\n" + commandregistry.Commands.CommandUsage("ssh-config"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs, nil
+}
+
+func (cmd *SSHConfig) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.gateway = deps.Gateways["cloud-controller"]
+
+	//get ssh-code for dependency
+	sshCodeGetter := commandregistry.Commands.FindCommand("ssh-code")
+	sshCodeGetter = sshCodeGetter.SetDependency(deps, false)
+	cmd.sshCodeGetter = sshCodeGetter.(commands.SSHCodeGetter)
+
+	return cmd
+}
+
+func (cmd *SSHConfig) Execute(fc flags.FlagContext) error {
+	if fc.Bool("askpass") {
+		code, err := cmd.sshCodeGetter.Get()
+		if err != nil {
+			return errors.New(T("Error getting one time auth code: ") + err.Error())
+		}
+
+		cmd.ui.Say(code)
+		return nil
+	}
+
+	app := cmd.appReq.GetApplication()
+
+	indexes := []int{}
+	if fc.IsSet("i") {
+		instanceIndex := fc.Int("i")
+		if instanceIndex < 0 {
+			return errors.New(T("The application instance index cannot be negative"))
+		}
+		if instanceIndex >= app.InstanceCount {
+			return errors.New(T("The specified application instance does not exist"))
+		}
+		indexes = append(indexes, instanceIndex)
+	} else {
+		for index := 0; index < app.InstanceCount; index++ {
+			indexes = append(indexes, index)
+		}
+	}
+
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
+	if err != nil {
+		return errors.New(T("Error getting SSH info:") + err.Error())
+	}
+
+	if info.SSHEndpoint == "" {
+		return errors.New(T("SSH is not available: the Cloud Controller did not return an SSH endpoint"))
+	}
+
+	stanzas := []string{}
+	for _, index := range indexes {
+		stanza, err := sshCmd.OpenSSHHost(fmt.Sprintf("%s.%d", app.Name, index), app.GUID, index, info.SSHEndpoint, info.SSHEndpointFingerprint)
+		if err != nil {
+			return err
+		}
+		stanzas = append(stanzas, stanza)
+	}
+
+	cmd.ui.Say(T("# OpenSSH configuration for app {{.AppName}}, generated by {{.Command}}.", map[string]interface{}{
+		"AppName": app.Name,
+		"Command": cf.Name + " ssh-config",
+	}))
+	cmd.ui.Say(T("# Each connection needs a one time passcode. To have ssh get one, save this as an executable script:"))
+	cmd.ui.Say("#   #!/bin/sh")
+	cmd.ui.Say("#   exec %s ssh-config --askpass \"$@\"", cf.Name)
+	cmd.ui.Say(T("# and set SSH_ASKPASS to its path and SSH_ASKPASS_REQUIRE=force (OpenSSH 8.4 or later)."))
+	cmd.ui.Say(T("# Otherwise, enter the output of {{.Command}} at the password prompt.", map[string]interface{}{
+		"Command": cf.Name + " ssh-code",
+	}))
+	cmd.ui.Say("")
+	cmd.ui.Say(strings.TrimSuffix(strings.Join(stanzas, "\n"), "\n"))
+	return nil
+}
@@ -0,0 +1,201 @@
+package application_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ssh-config command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+		testServer          *httptest.Server
+		infoResponseBody    string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		deps.Gateways = make(map[string]net.Gateway)
+
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewAPIEndpointRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+		app := models.Application{}
+		app.Name = "my-app"
+		app.GUID = "my-app-guid"
+		app.InstanceCount = 2
+
+		applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+		applicationReq.GetApplicationReturns(app)
+		requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+		//save original command and restore later
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+
+		infoResponseBody = getInfoResponseBody
+	})
+
+	JustBeforeEach(func() {
+		getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/info",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body:   infoResponseBody,
+			},
+		})
+
+		testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+		configRepo.SetAPIEndpoint(testServer.URL)
+		deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+
+		//restore original command
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		//inject fake 'sshCodeGetter' into registry
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("ssh-config").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("ssh-config", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided an app name", func() {
+			Expect(runCommand()).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires APP_NAME as argument"},
+			))
+		})
+
+		It("requires the application", func() {
+			Expect(runCommand("my-app")).To(BeTrue())
+			Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+
+		It("fails requirements when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("my-app")).To(BeFalse())
+		})
+	})
+
+	It("prints a Host entry for each instance", func() {
+		Expect(runCommand("my-app")).To(BeTrue())
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"# OpenSSH configuration for app my-app, generated by cf ssh-config."},
+			[]string{"#   exec cf ssh-config --askpass \"$@\""},
+			[]string{"Host my-app.0"},
+			[]string{"HostName ssh.run.pivotal.io"},
+			[]string{"Port 2222"},
+			[]string{"User cf:my-app-guid/0"},
+			[]string{"FingerprintHash md5"},
+			[]string{"# Host key fingerprint: 11:11:11:11:11:11:11:11:11:11:11:11:11:11:11:11"},
+			[]string{"Host my-app.1"},
+			[]string{"User cf:my-app-guid/1"},
+		))
+		Expect(sshCodeGetter.GetCallCount()).To(Equal(0))
+	})
+
+	Context("when an instance index is provided", func() {
+		It("only prints the Host entry for that instance", func() {
+			Expect(runCommand("my-app", "-i", "1")).To(BeTrue())
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Host my-app.1"}))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Host my-app.0"}))
+		})
+
+		It("fails when the instance does not exist", func() {
+			Expect(runCommand("my-app", "-i", "2")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"The specified application instance does not exist"},
+			))
+		})
+	})
+
+	Context("when the Cloud Controller does not have an SSH endpoint", func() {
+		BeforeEach(func() {
+			infoResponseBody = `{"api_version": "2.35.0"}`
+		})
+
+		It("fails", func() {
+			Expect(runCommand("my-app")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"SSH is not available"},
+			))
+		})
+	})
+
+	Describe("--askpass", func() {
+		BeforeEach(func() {
+			sshCodeGetter.GetReturns("abc123", nil)
+		})
+
+		It("prints a one time passcode, ignoring the prompt", func() {
+			Expect(runCommand("--askpass", "cf:my-app-guid/0@ssh.run.pivotal.io's password: ")).To(BeTrue())
+
+			Expect(requirementsFactory.NewAPIEndpointRequirementCallCount()).To(Equal(1))
+			Expect(requirementsFactory.NewApplicationRequirementCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(Equal([]string{"abc123"}))
+		})
+
+		Context("when getting the passcode fails", func() {
+			BeforeEach(func() {
+				sshCodeGetter.GetReturns("", errors.New("auth api error"))
+			})
+
+			It("notifies users", func() {
+				Expect(runCommand("--askpass")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error getting one time auth code", "auth api error"},
+				))
+			})
+		})
+	})
+})
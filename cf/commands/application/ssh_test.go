@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -14,6 +15,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -305,6 +308,159 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding remote port", "tcpip-forward request denied by peer"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("Error starting the SOCKS proxy when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error starting SOCKS proxy", "listen error"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --forward-only is provided", func() {
+				BeforeEach(func() {
+					fakeSecureShell.ForwardsReturns([]sshCmd.ForwardStatus{
+						{
+							Type:             sshCmd.LocalForward,
+							ListenAddress:    "localhost:8000",
+							ConnectAddress:   "localhost:8000",
+							TotalConnections: 3,
+						},
+						{
+							Type:              sshCmd.DynamicForward,
+							ListenAddress:     "localhost:1080",
+							TotalConnections:  5,
+							FailedConnections: 1,
+						},
+					})
+				})
+
+				It("waits for the forwards to be closed and summarizes them", func() {
+					Expect(runCommand("my-app", "--forward-only", "-L", "8000:localhost:8000", "-D", "1080")).To(BeTrue())
+
+					Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.WaitCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Forwarding ports. Press Ctrl-C to stop."},
+						[]string{"Closed local forward localhost:8000 -> localhost:8000: 3 connections, 0 failed"},
+						[]string{"Closed dynamic forward localhost:1080 -> SOCKS: 5 connections, 1 failed"},
+					))
+				})
+			})
+
+			Context("when --all-instances is provided", func() {
+				var appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
+
+				BeforeEach(func() {
+					appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning},
+						{State: models.InstanceCrashed},
+						{State: models.InstanceRunning},
+					}, nil)
+					deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
+				})
+
+				It("runs the command on each running instance", func() {
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeTrue())
+
+					Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("my-app-guid"))
+
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(2))
+					indexes := []uint{
+						fakeSecureShell.ConnectArgsForCall(0).Index,
+						fakeSecureShell.ConnectArgsForCall(1).Index,
+					}
+					Expect(indexes).To(ConsistOf(uint(0), uint(2)))
+					Expect(fakeSecureShell.RunCommandCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Running command on 2 instances of app my-app as my-user..."},
+						[]string{"OK"},
+					))
+				})
+
+				It("limits how many instances it runs on at once", func() {
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime", "--concurrency", "1")).To(BeTrue())
+
+					Expect(fakeSecureShell.ConnectArgsForCall(0).Concurrency).To(Equal(1))
+				})
+
+				Context("when the command fails on an instance", func() {
+					BeforeEach(func() {
+						fakeSecureShell.ConnectStub = func(opts *options.SSHOptions) error {
+							if opts.Index == 2 {
+								return errors.New("dial error")
+							}
+							return nil
+						}
+					})
+
+					It("reports the failed instance and fails", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+
+						Expect(fakeSecureShell.RunCommandCallCount()).To(Equal(1))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Instance 2 failed: dial error"},
+							[]string{"FAILED"},
+							[]string{"Command failed on 1 of 2 instances"},
+						))
+					})
+				})
+
+				Context("when no instances are running", func() {
+					BeforeEach(func() {
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceCrashed},
+						}, nil)
+					})
+
+					It("fails", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+
+						Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Application my-app has no running instances"},
+						))
+					})
+				})
+
+				Context("when getting the instances fails", func() {
+					BeforeEach(func() {
+						appInstancesRepo.GetInstancesReturns(nil, errors.New("cc error"))
+					})
+
+					It("notifies users", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Error getting application instances", "cc error"},
+						))
+					})
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -316,6 +472,27 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("when -N is provided and the forwards are interrupted", func() {
+				BeforeEach(func() {
+					fakeSecureShell.WaitReturns(sshCmd.ErrInterrupted)
+					fakeSecureShell.ForwardsReturns([]sshCmd.ForwardStatus{{
+						Type:             sshCmd.LocalForward,
+						ListenAddress:    "localhost:8080",
+						ConnectAddress:   "localhost:9090",
+						TotalConnections: 2,
+					}})
+				})
+
+				It("displays the forwards and fails", func() {
+					Expect(runCommand("my-app", "-N", "-L", "8080:localhost:9090")).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Closed", "localhost:8080 -> localhost:9090", "2 connections"},
+						[]string{"Port forwarding interrupted"},
+					))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.InteractiveSession()", func() {
 					fakeSecureShell.ConnectReturns(nil)
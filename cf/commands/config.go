@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"os/exec"
 	"sort"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/configv3"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 )
@@ -28,12 +30,14 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 	fs["trace"] = &flags.StringFlag{Name: "trace", Usage: T("Trace HTTP requests")}
 	fs["color"] = &flags.StringFlag{Name: "color", Usage: T("Enable or disable color")}
 	fs["locale"] = &flags.StringFlag{Name: "locale", Usage: T("Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.")}
+	fs["max-requests-per-second"] = &flags.IntFlag{Name: "max-requests-per-second", Usage: T("Limit the number of requests per second sent to the Cloud Controller. 0 removes the limit.")}
+	fs["credential-store"] = &flags.StringFlag{Name: "credential-store", Usage: T("Store tokens with the cf-credential-NAME helper instead of in the config file. If NAME is 'file', tokens are stored in the config file.")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "config",
 		Description: T("Write default values to the config"),
 		Usage: []string{
-			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
+			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (NAME | file)] [--max-requests-per-second LIMIT]"),
 		},
 		Flags: fs,
 	}
@@ -51,7 +55,7 @@ func (cmd *ConfigCommands) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") && !context.IsSet("credential-store") && !context.IsSet("max-requests-per-second") {
 		return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
 	}
 
@@ -64,6 +68,15 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 		cmd.config.SetAsyncTimeout(uint(asyncTimeout))
 	}
 
+	if context.IsSet("max-requests-per-second") {
+		limit := context.Int("max-requests-per-second")
+		if limit < 0 {
+			return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+
+		cmd.config.SetMaxRequestsPerSecond(limit)
+	}
+
 	if context.IsSet("trace") {
 		cmd.config.SetTrace(context.String("trace"))
 	}
@@ -80,6 +93,19 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 		}
 	}
 
+	if context.IsSet("credential-store") {
+		store := context.String("credential-store")
+		if configv3.IsExternalCredentialStore(store) {
+			if _, err := exec.LookPath(configv3.CredentialHelperPrefix + store); err != nil {
+				return errors.New(T("Could not find the credential helper '{{.Helper}}' on the PATH.", map[string]interface{}{
+					"Helper": configv3.CredentialHelperPrefix + store,
+				}))
+			}
+		}
+
+		cmd.config.SetCredentialStore(store)
+	}
+
 	if context.IsSet("locale") {
 		locale := context.String("locale")
 
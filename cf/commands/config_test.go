@@ -125,4 +125,39 @@ var _ = Describe("config command", func() {
 			})
 		})
 	})
+	Context("--credential-store flag", func() {
+		It("stores the credential store when --credential-store file is provided", func() {
+			runCommand("--credential-store", "file")
+			Expect(configRepo.CredentialStore()).To(Equal("file"))
+		})
+
+		It("fails when the credential helper is not on the PATH", func() {
+			runCommand("--credential-store", "does-not-exist")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Could not find the credential helper 'cf-credential-does-not-exist' on the PATH."},
+			))
+			Expect(configRepo.CredentialStore()).To(BeEmpty())
+		})
+	})
+
+	Context("--max-requests-per-second flag", func() {
+		It("stores the limit", func() {
+			runCommand("--max-requests-per-second", "10")
+			Expect(configRepo.MaxRequestsPerSecond()).To(Equal(10))
+		})
+
+		It("removes the limit when 0 is provided", func() {
+			configRepo.SetMaxRequestsPerSecond(10)
+			runCommand("--max-requests-per-second", "0")
+			Expect(configRepo.MaxRequestsPerSecond()).To(BeZero())
+		})
+
+		It("fails with usage when a negative limit is provided", func() {
+			runCommand("--max-requests-per-second", "-1")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+		})
+	})
 })
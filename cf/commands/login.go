@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"os"
 	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -38,6 +39,7 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 	fs["a"] = &flags.StringFlag{ShortName: "a", Usage: T("API endpoint (e.g. https://api.example.com)")}
 	fs["u"] = &flags.StringFlag{ShortName: "u", Usage: T("Username")}
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Password")}
+	fs["password-stdin"] = &flags.BoolFlag{Name: "password-stdin", Usage: T("Read the password from the first line of standard input")}
 	fs["o"] = &flags.StringFlag{ShortName: "o", Usage: T("Org")}
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Space")}
 	fs["sso"] = &flags.BoolFlag{Name: "sso", Usage: T("Prompt for a one-time passcode to login")}
@@ -49,8 +51,9 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 		ShortName:   "l",
 		Description: T("Log user in"),
 		Usage: []string{
-			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\n"),
+			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD | --password-stdin] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
+			T("\n\n   If -u or -p are omitted they are read from CF_USERNAME and CF_PASSWORD before prompting"),
 		},
 		Examples: []string{
 			T("CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)"),
@@ -58,6 +61,7 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 			T("CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)"),
 			T("CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"),
+			T("echo \"my password\" | CF_NAME login -a api.example.com -u name@example.com --password-stdin (read the password from standard input)"),
 		},
 		Flags: fs,
 	}
@@ -111,6 +115,8 @@ func (cmd *Login) Execute(c flags.FlagContext) error {
 	switch {
 	case c.Bool("sso") && c.IsSet("sso-passcode"):
 		return errors.New(T("Incorrect usage: --sso-passcode flag cannot be used with --sso"))
+	case c.Bool("password-stdin") && c.IsSet("p"):
+		return errors.New(T("Incorrect usage: -p flag cannot be used with --password-stdin"))
 	case c.Bool("sso") || c.IsSet("sso-passcode"):
 		err = cmd.authenticateSSO(c)
 		if err != nil {
@@ -191,7 +197,16 @@ func (cmd Login) authenticateSSO(c flags.FlagContext) error {
 
 func (cmd Login) authenticate(c flags.FlagContext) error {
 	usernameFlagValue := c.String("u")
+	if usernameFlagValue == "" {
+		usernameFlagValue = os.Getenv("CF_USERNAME")
+	}
+
 	passwordFlagValue := c.String("p")
+	if c.Bool("password-stdin") {
+		passwordFlagValue = cmd.ui.ReadLine()
+	} else if passwordFlagValue == "" {
+		passwordFlagValue = os.Getenv("CF_PASSWORD")
+	}
 
 	prompts, err := cmd.authenticator.GetLoginPromptsAndSaveUAAServerURL()
 	if err != nil {
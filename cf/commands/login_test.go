@@ -1,6 +1,7 @@
 package commands_test
 
 import (
+	"os"
 	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
@@ -486,6 +487,70 @@ var _ = Describe("Login Command", func() {
 				}))
 			})
 
+			It("takes the password from stdin with the --password-stdin flag", func() {
+				Flags = []string{"-a", "api.example.com", "--password-stdin"}
+				ui.Inputs = []string{"the-stdin-password", "the-username", "the-account-number"}
+
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+				Expect(ui.PasswordPrompts).ToNot(ContainSubstrings([]string{"Your Password"}))
+				Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+				Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+					"account_number": "the-account-number",
+					"username":       "the-username",
+					"password":       "the-stdin-password",
+				}))
+			})
+
+			It("errors when both -p and --password-stdin are provided", func() {
+				Flags = []string{"-a", "api.example.com", "-p", "the-password", "--password-stdin"}
+
+				execution := testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+				Expect(execution).To(BeFalse())
+
+				Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+			})
+
+			Context("when CF_USERNAME and CF_PASSWORD are set", func() {
+				BeforeEach(func() {
+					os.Setenv("CF_USERNAME", "the-env-username")
+					os.Setenv("CF_PASSWORD", "the-env-password")
+				})
+
+				AfterEach(func() {
+					os.Unsetenv("CF_USERNAME")
+					os.Unsetenv("CF_PASSWORD")
+				})
+
+				It("takes the username and password from the environment", func() {
+					Flags = []string{"-a", "api.example.com"}
+					ui.Inputs = []string{"the-account-number"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(ui.Prompts).ToNot(ContainSubstrings([]string{"Username"}))
+					Expect(ui.PasswordPrompts).ToNot(ContainSubstrings([]string{"Your Password"}))
+					Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+						"account_number": "the-account-number",
+						"username":       "the-env-username",
+						"password":       "the-env-password",
+					}))
+				})
+
+				It("prefers the -u and -p flags", func() {
+					Flags = []string{"-a", "api.example.com", "-u", "the-username", "-p", "the-password"}
+					ui.Inputs = []string{"the-account-number"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+						"account_number": "the-account-number",
+						"username":       "the-username",
+						"password":       "the-password",
+					}))
+				})
+			})
+
 			It("tries 3 times for the password-type prompts", func() {
 				authRepo.AuthenticateReturns(errors.New("Error authenticating."))
 				ui.Inputs = []string{"api.example.com", "the-username", "the-account-number",
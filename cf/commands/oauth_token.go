@@ -51,7 +51,7 @@ func (cmd *OAuthToken) SetDependency(deps commandregistry.Dependency, pluginCall
 }
 
 func (cmd *OAuthToken) Execute(c flags.FlagContext) error {
-	token, err := cmd.authRepo.RefreshAuthToken()
+	token, err := cmd.authRepo.ValidAccessToken()
 	if err != nil {
 		return err
 	}
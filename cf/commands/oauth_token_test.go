@@ -63,7 +63,7 @@ var _ = Describe("OauthToken", func() {
 		})
 
 		It("fails if oauth refresh fails", func() {
-			authRepo.RefreshAuthTokenReturns("", errors.New("Could not refresh"))
+			authRepo.ValidAccessTokenReturns("", errors.New("Could not refresh"))
 			runCommand()
 
 			Expect(ui.Outputs()).To(ContainSubstrings(
@@ -72,8 +72,8 @@ var _ = Describe("OauthToken", func() {
 			))
 		})
 
-		It("returns to the user the oauth token after a refresh", func() {
-			authRepo.RefreshAuthTokenReturns("1234567890", nil)
+		It("returns to the user an oauth token that is valid for the refresh skew", func() {
+			authRepo.ValidAccessTokenReturns("1234567890", nil)
 			runCommand()
 
 			Expect(ui.Outputs()).To(ContainSubstrings(
@@ -92,7 +92,7 @@ var _ = Describe("OauthToken", func() {
 			})
 
 			It("populates the plugin model upon execution", func() {
-				authRepo.RefreshAuthTokenReturns("911999111", nil)
+				authRepo.ValidAccessTokenReturns("911999111", nil)
 				testcmd.RunCLICommand("oauth-token", []string{}, requirementsFactory, updateCommandDependency, true, ui)
 				Expect(pluginModel.Token).To(Equal("911999111"))
 			})
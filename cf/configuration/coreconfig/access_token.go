@@ -3,7 +3,13 @@ package coreconfig
 import (
 	"encoding/base64"
 	"encoding/json"
+	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type TokenInfo struct {
@@ -27,6 +33,23 @@ func NewTokenInfo(accessToken string) (info TokenInfo) {
 	return info
 }
 
+// AccessTokenValidFor returns true when the access token will still be valid
+// after the provided duration. It uses the same token parsing as the UAA
+// client, see uaa.AccessTokenValidFor.
+func AccessTokenValidFor(accessToken string, duration time.Duration) bool {
+	return uaa.AccessTokenValidFor(accessToken, duration)
+}
+
+// TokenRefreshSkew returns how long before it expires an access token is
+// refreshed, from $CF_TOKEN_REFRESH_SKEW in seconds.
+func TokenRefreshSkew() time.Duration {
+	skew, err := strconv.ParseInt(os.Getenv("CF_TOKEN_REFRESH_SKEW"), 10, 64)
+	if err != nil || skew < 0 {
+		return configv3.DefaultTokenRefreshSkew
+	}
+	return time.Duration(skew) * time.Second
+}
+
 func DecodeAccessToken(accessToken string) (tokenJSON []byte, err error) {
 	tokenParts := strings.Split(accessToken, " ")
 
@@ -46,7 +69,11 @@ func DecodeAccessToken(accessToken string) (tokenJSON []byte, err error) {
 }
 
 func base64Decode(encodedData string) ([]byte, error) {
-	return base64.StdEncoding.DecodeString(restorePadding(encodedData))
+	data, err := base64.StdEncoding.DecodeString(restorePadding(encodedData))
+	if err != nil {
+		return base64.URLEncoding.DecodeString(restorePadding(encodedData))
+	}
+	return data, nil
 }
 
 func restorePadding(seg string) string {
@@ -1,6 +1,11 @@
 package coreconfig_test
 
 import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"time"
+
 	. "code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -31,3 +36,44 @@ var _ = Describe("NewTokenInfo", func() {
 		Expect(string(decodedInfo)).To(ContainSubstring("tlang1@gopivotal.com"))
 	})
 })
+
+var _ = Describe("AccessTokenValidFor", func() {
+	tokenExpiringIn := func(duration time.Duration) string {
+		claims := fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, time.Now().Add(duration).Unix())
+		return "bearer e30." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2lnbmF0dXJl"
+	}
+
+	It("returns true when the token expires after the duration", func() {
+		Expect(AccessTokenValidFor(tokenExpiringIn(time.Hour), time.Minute)).To(BeTrue())
+	})
+
+	It("returns false when the token expires within the duration", func() {
+		Expect(AccessTokenValidFor(tokenExpiringIn(30*time.Second), time.Minute)).To(BeFalse())
+	})
+
+	It("returns true when the token has no readable expiry", func() {
+		Expect(AccessTokenValidFor("bearer not-a-jwt", time.Minute)).To(BeTrue())
+	})
+})
+
+var _ = Describe("TokenRefreshSkew", func() {
+	var originalSkew string
+
+	BeforeEach(func() {
+		originalSkew = os.Getenv("CF_TOKEN_REFRESH_SKEW")
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("CF_TOKEN_REFRESH_SKEW", originalSkew)).To(Succeed())
+	})
+
+	It("returns the skew from $CF_TOKEN_REFRESH_SKEW in seconds", func() {
+		Expect(os.Setenv("CF_TOKEN_REFRESH_SKEW", "120")).To(Succeed())
+		Expect(TokenRefreshSkew()).To(Equal(2 * time.Minute))
+	})
+
+	It("defaults to 60 seconds", func() {
+		Expect(os.Setenv("CF_TOKEN_REFRESH_SKEW", "")).To(Succeed())
+		Expect(TokenRefreshSkew()).To(Equal(time.Minute))
+	})
+})
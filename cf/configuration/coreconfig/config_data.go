@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type AuthPromptType string
@@ -13,6 +14,10 @@ const (
 	AuthPromptTypePassword AuthPromptType = "PASSWORD"
 )
 
+// ClientCredentialsGrantType is the UAAGrantType of a session authenticated as
+// a UAA client rather than a user.
+const ClientCredentialsGrantType = "client_credentials"
+
 type AuthPrompt struct {
 	Type        AuthPromptType
 	DisplayName string
@@ -29,6 +34,7 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string `json:",omitempty"`
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
@@ -41,6 +47,15 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	CurrentContext           string                 `json:",omitempty"`
+	Contexts                 map[string]ContextData `json:",omitempty"`
+	CredentialStore          string                 `json:",omitempty"`
+	MaxRequestsPerSecond     int                    `json:",omitempty"`
+
+	activeContext     string
+	overriddenTarget  *ContextData
+	credentialStore   configv3.CredentialStore
+	storedCredentials configv3.StoredCredentials
 }
 
 func NewData() *Data {
@@ -54,7 +69,20 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+	d.saveActiveContext()
+
+	persisted := *d
+	if d.overriddenTarget != nil {
+		persisted.applyContext(*d.overriddenTarget)
+	}
+
+	if configv3.IsExternalCredentialStore(persisted.CredentialStore) {
+		err := d.moveCredentialsToStore(&persisted)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.MarshalIndent(persisted, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -68,5 +96,13 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	if configv3.IsExternalCredentialStore(d.CredentialStore) {
+		err = d.loadCredentials()
+		if err != nil {
+			return err
+		}
+		d.storedCredentials = d.persistedStoredCredentials()
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package coreconfig
 
 import (
+	"os"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
@@ -37,6 +38,7 @@ func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Reposi
 
 func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler func(error)) Repository {
 	data := NewData()
+	data.activeContext = os.Getenv("CF_CONTEXT")
 	if !persistor.Exists() {
 		//set default plugin repo
 		data.PluginRepos = append(data.PluginRepos, models.PluginRepo{
@@ -66,6 +68,7 @@ type Reader interface {
 	AccessToken() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
+	UAAGrantType() string
 	SSHOAuthClient() string
 	RefreshToken() string
 
@@ -93,6 +96,10 @@ type Reader interface {
 
 	Locale() string
 
+	CredentialStore() string
+
+	MaxRequestsPerSecond() int
+
 	PluginRepos() []models.PluginRepo
 }
 
@@ -112,6 +119,7 @@ type ReadWriter interface {
 	SetAccessToken(string)
 	SetUAAOAuthClient(string)
 	SetUAAOAuthClientSecret(string)
+	SetUAAGrantType(string)
 	SetSSHOAuthClient(string)
 	SetRefreshToken(string)
 	SetOrganizationFields(models.OrganizationFields)
@@ -121,6 +129,8 @@ type ReadWriter interface {
 	SetTrace(string)
 	SetColorEnabled(string)
 	SetLocale(string)
+	SetCredentialStore(string)
+	SetMaxRequestsPerSecond(int)
 	SetPluginRepo(models.PluginRepo)
 	UnSetPluginRepo(int)
 	SetCLIVersion(string)
@@ -138,6 +148,12 @@ type Repository interface {
 func (c *ConfigRepository) init() {
 	c.initOnce.Do(func() {
 		err := c.persistor.Load(c.data)
+		if err != nil {
+			c.onError(err)
+			return
+		}
+
+		err = c.data.loadActiveContext()
 		if err != nil {
 			c.onError(err)
 		}
@@ -160,6 +176,9 @@ func (c *ConfigRepository) write(cb func()) {
 	cb()
 
 	err := c.persistor.Save(c.data)
+	if err == nil {
+		err = c.data.eraseStaleCredentials()
+	}
 	if err != nil {
 		c.onError(err)
 	}
@@ -246,6 +265,13 @@ func (c *ConfigRepository) UAAOAuthClientSecret() (clientID string) {
 	return
 }
 
+func (c *ConfigRepository) UAAGrantType() (grantType string) {
+	c.read(func() {
+		grantType = c.data.UAAGrantType
+	})
+	return
+}
+
 func (c *ConfigRepository) SSHOAuthClient() (clientID string) {
 	c.read(func() {
 		clientID = c.data.SSHOAuthClient
@@ -414,6 +440,20 @@ func (c *ConfigRepository) Locale() (locale string) {
 	return
 }
 
+func (c *ConfigRepository) CredentialStore() (store string) {
+	c.read(func() {
+		store = c.data.CredentialStore
+	})
+	return
+}
+
+func (c *ConfigRepository) MaxRequestsPerSecond() (limit int) {
+	c.read(func() {
+		limit = c.data.MaxRequestsPerSecond
+	})
+	return
+}
+
 func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 	c.read(func() {
 		repos = c.data.PluginRepos
@@ -427,6 +467,11 @@ func (c *ConfigRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		if c.data.UAAGrantType == ClientCredentialsGrantType {
+			c.data.UAAOAuthClient = "cf"
+			c.data.UAAOAuthClientSecret = ""
+		}
+		c.data.UAAGrantType = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
 	})
@@ -498,6 +543,12 @@ func (c *ConfigRepository) SetUAAOAuthClientSecret(clientID string) {
 	})
 }
 
+func (c *ConfigRepository) SetUAAGrantType(grantType string) {
+	c.write(func() {
+		c.data.UAAGrantType = grantType
+	})
+}
+
 func (c *ConfigRepository) SetSSHOAuthClient(clientID string) {
 	c.write(func() {
 		c.data.SSHOAuthClient = clientID
@@ -552,6 +603,25 @@ func (c *ConfigRepository) SetLocale(locale string) {
 	})
 }
 
+// SetCredentialStore sets the store that keeps the access and refresh tokens
+// and the client secret. The credentials are moved to the new store when the
+// config is saved, and are then erased from the credential helper that kept
+// them before, if any.
+func (c *ConfigRepository) SetCredentialStore(store string) {
+	c.write(func() {
+		c.data.CredentialStore = store
+		c.data.credentialStore = nil
+	})
+}
+
+// SetMaxRequestsPerSecond sets the number of requests per second the CLI
+// sends to the Cloud Controller. Zero removes the limit.
+func (c *ConfigRepository) SetMaxRequestsPerSecond(limit int) {
+	c.write(func() {
+		c.data.MaxRequestsPerSecond = limit
+	})
+}
+
 func (c *ConfigRepository) SetPluginRepo(repo models.PluginRepo) {
 	c.write(func() {
 		c.data.PluginRepos = append(c.data.PluginRepos, repo)
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 
@@ -83,6 +84,9 @@ var _ = Describe("Configuration Repository", func() {
 		config.SetUAAOAuthClientSecret("cf-oauth-client-secret")
 		Expect(config.UAAOAuthClientSecret()).To(Equal("cf-oauth-client-secret"))
 
+		config.SetUAAGrantType("client_credentials")
+		Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+
 		config.SetSSHOAuthClient("oauth-client-id")
 		Expect(config.SSHOAuthClient()).To(Equal("oauth-client-id"))
 
@@ -252,6 +256,107 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("contexts", func() {
+		var (
+			tmpDir     string
+			configPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "test-config")
+			Expect(err).NotTo(HaveOccurred())
+
+			configPath = filepath.Join(tmpDir, "config.json")
+			err = ioutil.WriteFile(configPath, []byte(`{
+				"ConfigVersion": 3,
+				"Target": "https://api.staging.com",
+				"AccessToken": "staging-token",
+				"CurrentContext": "staging",
+				"Contexts": {
+					"staging": {
+						"Target": "https://api.staging.com",
+						"AccessToken": "staging-token"
+					},
+					"prod": {
+						"Target": "https://api.prod.com",
+						"AccessToken": "prod-token",
+						"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"}
+					}
+				}
+			}`), 0600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		readConfig := func() *coreconfig.Data {
+			rawConfig, err := ioutil.ReadFile(configPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			data := coreconfig.NewData()
+			Expect(data.JSONUnmarshalV3(rawConfig)).To(Succeed())
+			return data
+		}
+
+		It("uses the current context", func() {
+			config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+			Expect(config.APIEndpoint()).To(Equal("https://api.staging.com"))
+
+			config.SetAccessToken("new-staging-token")
+			written := readConfig()
+			Expect(written.AccessToken).To(Equal("new-staging-token"))
+			Expect(written.Contexts["staging"].AccessToken).To(Equal("new-staging-token"))
+		})
+
+		Context("when CF_CONTEXT is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CONTEXT", "prod")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CONTEXT")).To(Succeed())
+			})
+
+			It("uses the provided context", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+				Expect(config.APIEndpoint()).To(Equal("https://api.prod.com"))
+				Expect(config.AccessToken()).To(Equal("prod-token"))
+				Expect(config.SpaceFields().Name).To(Equal("prod-space"))
+			})
+
+			It("saves changes into the provided context only", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+				config.SetAccessToken("new-prod-token")
+
+				written := readConfig()
+				Expect(written.CurrentContext).To(Equal("staging"))
+				Expect(written.Target).To(Equal("https://api.staging.com"))
+				Expect(written.AccessToken).To(Equal("staging-token"))
+				Expect(written.Contexts["prod"].AccessToken).To(Equal("new-prod-token"))
+			})
+		})
+
+		Context("when CF_CONTEXT names a context that does not exist", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CONTEXT", "does-not-exist")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CONTEXT")).To(Succeed())
+			})
+
+			It("calls the error handler with a ContextNotFoundError", func() {
+				var handledErr error
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { handledErr = err })
+				config.APIEndpoint()
+				Expect(handledErr).To(MatchError(configv3.ContextNotFoundError{Name: "does-not-exist"}))
+			})
+		})
+	})
+
 	Describe("IsMinCLIVersion", func() {
 		It("returns true when the actual version is the default version string", func() {
 			Expect(config.IsMinCLIVersion(version.DefaultVersion)).To(BeTrue())
@@ -0,0 +1,111 @@
+package coreconfig
+
+import (
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// ContextData is a named set of target, session and org/space information.
+// The top level values in Data always mirror the current context.
+type ContextData struct {
+	Target                   string
+	APIVersion               string
+	AuthorizationEndpoint    string
+	DopplerEndPoint          string
+	UaaEndpoint              string
+	RoutingAPIEndpoint       string
+	AccessToken              string
+	UAAOAuthClient           string
+	UAAOAuthClientSecret     string
+	UAAGrantType             string `json:",omitempty"`
+	SSHOAuthClient           string
+	RefreshToken             string
+	OrganizationFields       models.OrganizationFields
+	SpaceFields              models.SpaceFields
+	SSLDisabled              bool
+	MinCLIVersion            string
+	MinRecommendedCLIVersion string
+}
+
+func (d *Data) snapshotContext() ContextData {
+	return ContextData{
+		Target:                   d.Target,
+		APIVersion:               d.APIVersion,
+		AuthorizationEndpoint:    d.AuthorizationEndpoint,
+		DopplerEndPoint:          d.DopplerEndPoint,
+		UaaEndpoint:              d.UaaEndpoint,
+		RoutingAPIEndpoint:       d.RoutingAPIEndpoint,
+		AccessToken:              d.AccessToken,
+		UAAOAuthClient:           d.UAAOAuthClient,
+		UAAOAuthClientSecret:     d.UAAOAuthClientSecret,
+		UAAGrantType:             d.UAAGrantType,
+		SSHOAuthClient:           d.SSHOAuthClient,
+		RefreshToken:             d.RefreshToken,
+		OrganizationFields:       d.OrganizationFields,
+		SpaceFields:              d.SpaceFields,
+		SSLDisabled:              d.SSLDisabled,
+		MinCLIVersion:            d.MinCLIVersion,
+		MinRecommendedCLIVersion: d.MinRecommendedCLIVersion,
+	}
+}
+
+func (d *Data) applyContext(context ContextData) {
+	d.Target = context.Target
+	d.APIVersion = context.APIVersion
+	d.AuthorizationEndpoint = context.AuthorizationEndpoint
+	d.DopplerEndPoint = context.DopplerEndPoint
+	d.UaaEndpoint = context.UaaEndpoint
+	d.RoutingAPIEndpoint = context.RoutingAPIEndpoint
+	d.AccessToken = context.AccessToken
+	d.UAAOAuthClient = context.UAAOAuthClient
+	d.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	d.UAAGrantType = context.UAAGrantType
+	d.SSHOAuthClient = context.SSHOAuthClient
+	d.RefreshToken = context.RefreshToken
+	d.OrganizationFields = context.OrganizationFields
+	d.SpaceFields = context.SpaceFields
+	d.SSLDisabled = context.SSLDisabled
+	d.MinCLIVersion = context.MinCLIVersion
+	d.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+
+	if d.UAAOAuthClient == "" {
+		d.UAAOAuthClient = "cf"
+		d.UAAOAuthClientSecret = ""
+	}
+}
+
+// loadActiveContext swaps in the values of the active context when it is not
+// the current context. An unknown context is an error, so that commands never
+// run against the wrong target.
+func (d *Data) loadActiveContext() error {
+	if d.activeContext == "" || d.activeContext == d.CurrentContext {
+		return nil
+	}
+
+	context, exists := d.Contexts[d.activeContext]
+	if !exists {
+		return configv3.ContextNotFoundError{Name: d.activeContext}
+	}
+
+	current := d.snapshotContext()
+	if _, exists := d.Contexts[d.CurrentContext]; exists {
+		d.Contexts[d.CurrentContext] = current
+	}
+	d.overriddenTarget = &current
+	d.applyContext(context)
+	return nil
+}
+
+// saveActiveContext copies the top level values back into the context they
+// were loaded from.
+func (d *Data) saveActiveContext() {
+	name := d.CurrentContext
+	if d.overriddenTarget != nil {
+		name = d.activeContext
+	}
+
+	if _, exists := d.Contexts[name]; name == "" || !exists {
+		return
+	}
+	d.Contexts[name] = d.snapshotContext()
+}
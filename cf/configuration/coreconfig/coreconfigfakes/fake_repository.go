@@ -76,6 +76,12 @@ type FakeRepository struct {
 	sSHOAuthClientReturns     struct {
 		result1 string
 	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -194,6 +200,18 @@ type FakeRepository struct {
 	localeReturns     struct {
 		result1 string
 	}
+	CredentialStoreStub        func() string
+	credentialStoreMutex       sync.RWMutex
+	credentialStoreArgsForCall []struct{}
+	credentialStoreReturns     struct {
+		result1 string
+	}
+	MaxRequestsPerSecondStub        func() int
+	maxRequestsPerSecondMutex       sync.RWMutex
+	maxRequestsPerSecondArgsForCall []struct{}
+	maxRequestsPerSecondReturns     struct {
+		result1 int
+	}
 	PluginReposStub        func() []models.PluginRepo
 	pluginReposMutex       sync.RWMutex
 	pluginReposArgsForCall []struct{}
@@ -263,6 +281,11 @@ type FakeRepository struct {
 	setSSHOAuthClientArgsForCall []struct {
 		arg1 string
 	}
+	SetUAAGrantTypeStub        func(string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		arg1 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -303,6 +326,16 @@ type FakeRepository struct {
 	setLocaleArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialStoreStub        func(string)
+	setCredentialStoreMutex       sync.RWMutex
+	setCredentialStoreArgsForCall []struct {
+		arg1 string
+	}
+	SetMaxRequestsPerSecondStub        func(int)
+	setMaxRequestsPerSecondMutex       sync.RWMutex
+	setMaxRequestsPerSecondArgsForCall []struct {
+		arg1 int
+	}
 	SetPluginRepoStub        func(models.PluginRepo)
 	setPluginRepoMutex       sync.RWMutex
 	setPluginRepoArgsForCall []struct {
@@ -600,6 +633,31 @@ func (fake *FakeRepository) SSHOAuthClientReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	} else {
+		return fake.uAAGrantTypeReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeRepository) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct{}{})
@@ -1091,6 +1149,56 @@ func (fake *FakeRepository) LocaleReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) CredentialStore() string {
+	fake.credentialStoreMutex.Lock()
+	fake.credentialStoreArgsForCall = append(fake.credentialStoreArgsForCall, struct{}{})
+	fake.recordInvocation("CredentialStore", []interface{}{})
+	fake.credentialStoreMutex.Unlock()
+	if fake.CredentialStoreStub != nil {
+		return fake.CredentialStoreStub()
+	} else {
+		return fake.credentialStoreReturns.result1
+	}
+}
+
+func (fake *FakeRepository) CredentialStoreCallCount() int {
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
+	return len(fake.credentialStoreArgsForCall)
+}
+
+func (fake *FakeRepository) CredentialStoreReturns(result1 string) {
+	fake.CredentialStoreStub = nil
+	fake.credentialStoreReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) MaxRequestsPerSecond() int {
+	fake.maxRequestsPerSecondMutex.Lock()
+	fake.maxRequestsPerSecondArgsForCall = append(fake.maxRequestsPerSecondArgsForCall, struct{}{})
+	fake.recordInvocation("MaxRequestsPerSecond", []interface{}{})
+	fake.maxRequestsPerSecondMutex.Unlock()
+	if fake.MaxRequestsPerSecondStub != nil {
+		return fake.MaxRequestsPerSecondStub()
+	} else {
+		return fake.maxRequestsPerSecondReturns.result1
+	}
+}
+
+func (fake *FakeRepository) MaxRequestsPerSecondCallCount() int {
+	fake.maxRequestsPerSecondMutex.RLock()
+	defer fake.maxRequestsPerSecondMutex.RUnlock()
+	return len(fake.maxRequestsPerSecondArgsForCall)
+}
+
+func (fake *FakeRepository) MaxRequestsPerSecondReturns(result1 int) {
+	fake.MaxRequestsPerSecondStub = nil
+	fake.maxRequestsPerSecondReturns = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeRepository) PluginRepos() []models.PluginRepo {
 	fake.pluginReposMutex.Lock()
 	fake.pluginReposArgsForCall = append(fake.pluginReposArgsForCall, struct{}{})
@@ -1420,6 +1528,30 @@ func (fake *FakeRepository) SetSSHOAuthClientArgsForCall(i int) string {
 	return fake.setSSHOAuthClientArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetUAAGrantType(arg1 string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -1612,6 +1744,54 @@ func (fake *FakeRepository) SetLocaleArgsForCall(i int) string {
 	return fake.setLocaleArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetCredentialStore(arg1 string) {
+	fake.setCredentialStoreMutex.Lock()
+	fake.setCredentialStoreArgsForCall = append(fake.setCredentialStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCredentialStore", []interface{}{arg1})
+	fake.setCredentialStoreMutex.Unlock()
+	if fake.SetCredentialStoreStub != nil {
+		fake.SetCredentialStoreStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetCredentialStoreCallCount() int {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return len(fake.setCredentialStoreArgsForCall)
+}
+
+func (fake *FakeRepository) SetCredentialStoreArgsForCall(i int) string {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return fake.setCredentialStoreArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) SetMaxRequestsPerSecond(arg1 int) {
+	fake.setMaxRequestsPerSecondMutex.Lock()
+	fake.setMaxRequestsPerSecondArgsForCall = append(fake.setMaxRequestsPerSecondArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetMaxRequestsPerSecond", []interface{}{arg1})
+	fake.setMaxRequestsPerSecondMutex.Unlock()
+	if fake.SetMaxRequestsPerSecondStub != nil {
+		fake.SetMaxRequestsPerSecondStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetMaxRequestsPerSecondCallCount() int {
+	fake.setMaxRequestsPerSecondMutex.RLock()
+	defer fake.setMaxRequestsPerSecondMutex.RUnlock()
+	return len(fake.setMaxRequestsPerSecondArgsForCall)
+}
+
+func (fake *FakeRepository) SetMaxRequestsPerSecondArgsForCall(i int) int {
+	fake.setMaxRequestsPerSecondMutex.RLock()
+	defer fake.setMaxRequestsPerSecondMutex.RUnlock()
+	return fake.setMaxRequestsPerSecondArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetPluginRepo(arg1 models.PluginRepo) {
 	fake.setPluginRepoMutex.Lock()
 	fake.setPluginRepoArgsForCall = append(fake.setPluginRepoArgsForCall, struct {
@@ -1725,6 +1905,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.organizationFieldsMutex.RLock()
@@ -1763,6 +1945,10 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
+	fake.maxRequestsPerSecondMutex.RLock()
+	defer fake.maxRequestsPerSecondMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
 	defer fake.pluginReposMutex.RUnlock()
 	fake.clearSessionMutex.RLock()
@@ -1791,6 +1977,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
 	fake.setSSHOAuthClientMutex.RLock()
 	defer fake.setSSHOAuthClientMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setOrganizationFieldsMutex.RLock()
@@ -1807,6 +1995,10 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.setColorEnabledMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
 	defer fake.setLocaleMutex.RUnlock()
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	fake.setMaxRequestsPerSecondMutex.RLock()
+	defer fake.setMaxRequestsPerSecondMutex.RUnlock()
 	fake.setPluginRepoMutex.RLock()
 	defer fake.setPluginRepoMutex.RUnlock()
 	fake.unSetPluginRepoMutex.RLock()
@@ -0,0 +1,118 @@
+package coreconfig
+
+import "code.cloudfoundry.org/cli/util/configv3"
+
+// store returns the credential store named in the config, creating it on
+// first use.
+func (d *Data) store() configv3.CredentialStore {
+	if d.credentialStore == nil {
+		d.credentialStore = configv3.NewCredentialStore(d.CredentialStore)
+	}
+	return d.credentialStore
+}
+
+// loadCredentials fills in the top level and context credentials from the
+// credential store.
+func (d *Data) loadCredentials() error {
+	if d.Target != "" {
+		credentials, err := d.store().Get(configv3.CredentialKey{ServerURL: d.Target, Context: d.CurrentContext})
+		if err != nil {
+			return err
+		}
+		d.AccessToken = credentials.AccessToken
+		d.RefreshToken = credentials.RefreshToken
+		d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	}
+
+	for name, context := range d.Contexts {
+		if context.Target == "" {
+			continue
+		}
+
+		credentials, err := d.store().Get(configv3.CredentialKey{ServerURL: context.Target, Context: name})
+		if err != nil {
+			return err
+		}
+		context.AccessToken = credentials.AccessToken
+		context.RefreshToken = credentials.RefreshToken
+		context.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+		d.Contexts[name] = context
+	}
+	return nil
+}
+
+// moveCredentialsToStore saves the top level and context credentials of the
+// persisted copy of the config in the credential store and removes them from
+// the copy.
+func (d *Data) moveCredentialsToStore(persisted *Data) error {
+	err := d.storeCredentials(configv3.CredentialKey{ServerURL: persisted.Target, Context: persisted.CurrentContext}, configv3.Credentials{
+		AccessToken:          persisted.AccessToken,
+		RefreshToken:         persisted.RefreshToken,
+		UAAOAuthClientSecret: persisted.UAAOAuthClientSecret,
+	})
+	if err != nil {
+		return err
+	}
+	persisted.AccessToken = ""
+	persisted.RefreshToken = ""
+	persisted.UAAOAuthClientSecret = ""
+
+	if persisted.Contexts == nil {
+		return nil
+	}
+
+	contexts := make(map[string]ContextData, len(persisted.Contexts))
+	for name, context := range persisted.Contexts {
+		err = d.storeCredentials(configv3.CredentialKey{ServerURL: context.Target, Context: name}, configv3.Credentials{
+			AccessToken:          context.AccessToken,
+			RefreshToken:         context.RefreshToken,
+			UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+		})
+		if err != nil {
+			return err
+		}
+		context.AccessToken = ""
+		context.RefreshToken = ""
+		context.UAAOAuthClientSecret = ""
+		contexts[name] = context
+	}
+	persisted.Contexts = contexts
+	return nil
+}
+
+// persistedStoredCredentials returns the StoredCredentials of the persisted
+// config.
+func (d *Data) persistedStoredCredentials() configv3.StoredCredentials {
+	persisted := *d
+	if d.overriddenTarget != nil {
+		persisted.applyContext(*d.overriddenTarget)
+	}
+
+	contextTargets := make(map[string]string, len(persisted.Contexts))
+	for name, context := range persisted.Contexts {
+		contextTargets[name] = context.Target
+	}
+	return configv3.NewStoredCredentials(persisted.CredentialStore, d.store(), persisted.Target, persisted.CurrentContext, contextTargets)
+}
+
+// eraseStaleCredentials erases the credentials the persisted config no longer
+// keeps from the credential helper it was loaded with.
+func (d *Data) eraseStaleCredentials() error {
+	stored := d.persistedStoredCredentials()
+	err := d.storedCredentials.EraseStale(stored)
+	if err != nil {
+		return err
+	}
+	d.storedCredentials = stored
+	return nil
+}
+
+func (d *Data) storeCredentials(key configv3.CredentialKey, credentials configv3.Credentials) error {
+	if credentials.IsEmpty() {
+		if key.ServerURL == "" {
+			return nil
+		}
+		return d.store().Erase(key)
+	}
+	return d.store().Store(key, credentials)
+}
@@ -0,0 +1,134 @@
+// +build !windows
+
+package coreconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testCredentialHelper = `#!/bin/sh
+input=$(cat)
+echo "$1 $input" >> "$CF_CREDENTIAL_TEST_LOG"
+if [ "$1" = "get" ]; then
+  echo '{"AccessToken":"stored-access-token","RefreshToken":"stored-refresh-token"}'
+fi
+`
+
+var _ = Describe("Credential store", func() {
+	var (
+		helperDir string
+		logPath   string
+		oldPath   string
+	)
+
+	helperCalls := func() []string {
+		rawLog, err := ioutil.ReadFile(logPath)
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(rawLog)), "\n")
+	}
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-test"), []byte(testCredentialHelper), 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		logPath = filepath.Join(helperDir, "log")
+		Expect(os.Setenv("CF_CREDENTIAL_TEST_LOG", logPath)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_TEST_LOG")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("loads the credentials from the helper when unmarshalling", func() {
+		data := coreconfig.NewData()
+		err := data.JSONUnmarshalV3([]byte(`{"ConfigVersion": 3, "Target": "api.example.com", "CredentialStore": "test"}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(data.AccessToken).To(Equal("stored-access-token"))
+		Expect(data.RefreshToken).To(Equal("stored-refresh-token"))
+		Expect(helperCalls()).To(Equal([]string{`get {"ServerURL":"api.example.com","Context":""}`}))
+	})
+
+	It("stores the credentials in the helper instead of the JSON when marshalling", func() {
+		data := coreconfig.NewData()
+		data.Target = "api.example.com"
+		data.CredentialStore = "test"
+		data.AccessToken = "new-access-token"
+		data.RefreshToken = "new-refresh-token"
+
+		jsonData, err := data.JSONMarshalV3()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(jsonData)).ToNot(ContainSubstring("new-"))
+
+		Expect(data.AccessToken).To(Equal("new-access-token"))
+		Expect(helperCalls()).To(Equal([]string{
+			`store {"ServerURL":"api.example.com","Context":"","AccessToken":"new-access-token","RefreshToken":"new-refresh-token","UAAOAuthClientSecret":""}`,
+		}))
+	})
+
+	Context("when the config file uses the helper", func() {
+		var configPath string
+
+		BeforeEach(func() {
+			configPath = filepath.Join(helperDir, "config.json")
+			err := ioutil.WriteFile(configPath, []byte(`{
+				"ConfigVersion": 3,
+				"Target": "https://api.staging.com",
+				"CredentialStore": "test",
+				"CurrentContext": "staging",
+				"Contexts": {
+					"staging": {"Target": "https://api.staging.com"},
+					"prod": {"Target": "https://api.prod.com"}
+				}
+			}`), 0600)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("writes the credentials to the config file and erases them from the helper", func() {
+			config := coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+			config.SetCredentialStore("file")
+
+			rawConfig, err := ioutil.ReadFile(configPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).To(ContainSubstring("stored-access-token"))
+
+			var erased []string
+			for _, call := range helperCalls() {
+				Expect(call).ToNot(HavePrefix("store"))
+				if strings.HasPrefix(call, "erase") {
+					erased = append(erased, call)
+				}
+			}
+			Expect(erased).To(ConsistOf(
+				`erase {"ServerURL":"https://api.staging.com","Context":"staging"}`,
+				`erase {"ServerURL":"https://api.prod.com","Context":"prod"}`,
+			))
+		})
+
+		It("erases the credentials of the previous target when the API endpoint changes", func() {
+			config := coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+			config.SetAPIEndpoint("https://api.other.com")
+			config.ClearSession()
+
+			Expect(helperCalls()).To(ContainElement(`erase {"ServerURL":"https://api.staging.com","Context":"staging"}`))
+			Expect(helperCalls()).ToNot(ContainElement(`erase {"ServerURL":"https://api.prod.com","Context":"prod"}`))
+		})
+	})
+})
@@ -160,6 +160,8 @@ func newAppPresenter() appPresenter {
 					presentCommand("disable-ssh"),
 					presentCommand("ssh-enabled"),
 					presentCommand("ssh"),
+					presentCommand("scp"),
+					presentCommand("ssh-config"),
 				},
 			},
 		}, {
@@ -10,13 +10,14 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/util/generic"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	"gopkg.in/yaml.v2"
 )
 
 //go:generate counterfeiter . Repository
 
 type Repository interface {
-	ReadManifest(string) (*Manifest, error)
+	ReadManifest(string, manifestvars.Vars) (*Manifest, error)
 }
 
 type DiskRepository struct{}
@@ -25,7 +26,9 @@ func NewDiskRepository() (repo Repository) {
 	return DiskRepository{}
 }
 
-func (repo DiskRepository) ReadManifest(inputPath string) (*Manifest, error) {
+// ReadManifest reads the manifest at inputPath, or the manifest in the
+// inputPath directory, replacing ((var)) placeholders with vars.
+func (repo DiskRepository) ReadManifest(inputPath string, vars manifestvars.Vars) (*Manifest, error) {
 	m := NewEmptyManifest()
 	manifestPath, err := repo.manifestPath(inputPath)
 
@@ -35,7 +38,7 @@ func (repo DiskRepository) ReadManifest(inputPath string) (*Manifest, error) {
 
 	m.Path = manifestPath
 
-	mapp, err := repo.readAllYAMLFiles(manifestPath)
+	mapp, err := repo.readAllYAMLFiles(manifestPath, vars)
 	if err != nil {
 		return m, err
 	}
@@ -45,14 +48,14 @@ func (repo DiskRepository) ReadManifest(inputPath string) (*Manifest, error) {
 	return m, nil
 }
 
-func (repo DiskRepository) readAllYAMLFiles(path string) (mergedMap generic.Map, err error) {
+func (repo DiskRepository) readAllYAMLFiles(path string, vars manifestvars.Vars) (mergedMap generic.Map, err error) {
 	file, err := os.Open(filepath.Clean(path))
 	if err != nil {
 		return
 	}
 	defer file.Close()
 
-	mapp, err := parseManifest(file)
+	mapp, err := parseManifest(file, vars)
 	if err != nil {
 		return
 	}
@@ -72,7 +75,7 @@ func (repo DiskRepository) readAllYAMLFiles(path string) (mergedMap generic.Map,
 		inheritedPath = filepath.Join(filepath.Dir(path), inheritedPath)
 	}
 
-	inheritedMap, err := repo.readAllYAMLFiles(inheritedPath)
+	inheritedMap, err := repo.readAllYAMLFiles(inheritedPath, vars)
 	if err != nil {
 		return
 	}
@@ -81,7 +84,7 @@ func (repo DiskRepository) readAllYAMLFiles(path string) (mergedMap generic.Map,
 	return
 }
 
-func parseManifest(file io.Reader) (yamlMap generic.Map, err error) {
+func parseManifest(file io.Reader, vars manifestvars.Vars) (yamlMap generic.Map, err error) {
 	manifest, err := ioutil.ReadAll(file)
 	if err != nil {
 		return
@@ -98,7 +101,12 @@ func parseManifest(file io.Reader) (yamlMap generic.Map, err error) {
 		return
 	}
 
-	yamlMap = generic.NewMap(mmap)
+	interpolated, err := manifestvars.Interpolate(mmap, vars)
+	if err != nil {
+		return
+	}
+
+	yamlMap = generic.NewMap(interpolated)
 
 	return
 }
@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -17,7 +18,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 	Describe("given a directory containing a file called 'manifest.yml'", func() {
 		It("reads that file", func() {
-			m, err := repo.ReadManifest("../../fixtures/manifests")
+			m, err := repo.ReadManifest("../../fixtures/manifests", nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(m.Path).To(Equal(filepath.Clean("../../fixtures/manifests/manifest.yml")))
@@ -31,7 +32,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 	Describe("given a directory that doesn't contain a file called 'manifest.y{a}ml'", func() {
 		It("returns an error", func() {
-			m, err := repo.ReadManifest("../../fixtures")
+			m, err := repo.ReadManifest("../../fixtures", nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(m.Path).To(BeEmpty())
@@ -40,7 +41,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 	Describe("given a directory that contains a file called 'manifest.yaml'", func() {
 		It("reads that file", func() {
-			m, err := repo.ReadManifest("../../fixtures/manifests/only_yaml")
+			m, err := repo.ReadManifest("../../fixtures/manifests/only_yaml", nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(m.Path).To(Equal(filepath.Clean("../../fixtures/manifests/only_yaml/manifest.yaml")))
@@ -53,7 +54,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 	Describe("given a directory contains files called 'manifest.yml' and 'manifest.yaml'", func() {
 		It("reads the file named 'manifest.yml'", func() {
-			m, err := repo.ReadManifest("../../fixtures/manifests/both_yaml_yml")
+			m, err := repo.ReadManifest("../../fixtures/manifests/both_yaml_yml", nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(m.Path).To(Equal(filepath.Clean("../../fixtures/manifests/both_yaml_yml/manifest.yml")))
@@ -73,7 +74,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 		BeforeEach(func() {
 			inputPath = filepath.Clean("../../fixtures/manifests/different-manifest.yml")
-			m, err = repo.ReadManifest(inputPath)
+			m, err = repo.ReadManifest(inputPath, nil)
 		})
 
 		It("reads the file at that path", func() {
@@ -98,31 +99,31 @@ var _ = Describe("ManifestDiskRepository", func() {
 
 	Describe("given a path to a file that doesn't exist", func() {
 		It("returns an error", func() {
-			_, err := repo.ReadManifest("some/path/that/doesnt/exist/manifest.yml")
+			_, err := repo.ReadManifest("some/path/that/doesnt/exist/manifest.yml", nil)
 			Expect(err).To(HaveOccurred())
 		})
 
 		It("returns empty string for the manifest path", func() {
-			m, _ := repo.ReadManifest("some/path/that/doesnt/exist/manifest.yml")
+			m, _ := repo.ReadManifest("some/path/that/doesnt/exist/manifest.yml", nil)
 			Expect(m.Path).To(Equal(""))
 		})
 	})
 
 	Describe("when the manifest is empty", func() {
 		It("returns an error", func() {
-			_, err := repo.ReadManifest("../../fixtures/manifests/empty-manifest.yml")
+			_, err := repo.ReadManifest("../../fixtures/manifests/empty-manifest.yml", nil)
 			Expect(err).To(HaveOccurred())
 		})
 
 		It("returns the path to the manifest", func() {
 			inputPath := filepath.Clean("../../fixtures/manifests/empty-manifest.yml")
-			m, _ := repo.ReadManifest(inputPath)
+			m, _ := repo.ReadManifest(inputPath, nil)
 			Expect(m.Path).To(Equal(inputPath))
 		})
 	})
 
 	It("converts nested maps to generic maps", func() {
-		m, err := repo.ReadManifest("../../fixtures/manifests/different-manifest.yml")
+		m, err := repo.ReadManifest("../../fixtures/manifests/different-manifest.yml", nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		applications, err := m.Applications()
@@ -133,7 +134,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 	})
 
 	It("merges manifests with their 'inherited' manifests", func() {
-		m, err := repo.ReadManifest("../../fixtures/manifests/inherited-manifest.yml")
+		m, err := repo.ReadManifest("../../fixtures/manifests/inherited-manifest.yml", nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		applications, err := m.Applications()
@@ -156,7 +157,7 @@ var _ = Describe("ManifestDiskRepository", func() {
 	})
 
 	It("supports yml merges", func() {
-		m, err := repo.ReadManifest("../../fixtures/manifests/merge-manifest.yml")
+		m, err := repo.ReadManifest("../../fixtures/manifests/merge-manifest.yml", nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		applications, err := m.Applications()
@@ -174,4 +175,29 @@ var _ = Describe("ManifestDiskRepository", func() {
 		Expect(*applications[2].InstanceCount).To(Equal(3))
 		Expect(*applications[2].Memory).To(Equal(int64(256)))
 	})
+
+	Describe("when the manifest contains variables", func() {
+		It("substitutes the variables, keeping their types", func() {
+			m, err := repo.ReadManifest("../../fixtures/manifests/vars-manifest.yml", manifestvars.Vars{
+				"name":      "vars-app",
+				"instances": 3,
+				"memory":    "512M",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			applications, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*applications[0].Name).To(Equal("vars-app"))
+			Expect(*applications[0].InstanceCount).To(Equal(3))
+			Expect(*applications[0].Memory).To(Equal(int64(512)))
+			Expect(*applications[0].EnvironmentVars).To(Equal(map[string]interface{}{
+				"GREETING": "hello vars-app",
+			}))
+		})
+
+		It("returns an error listing every unresolved variable", func() {
+			_, err := repo.ReadManifest("../../fixtures/manifests/vars-manifest.yml", manifestvars.Vars{"name": "vars-app"})
+			Expect(err).To(MatchError(manifestvars.UnresolvedVariablesError{Names: []string{"instances", "memory"}}))
+		})
+	})
 })
@@ -40,5 +40,6 @@ func NewCloudControllerGateway(config coreconfig.Reader, clock func() time.Time,
 		logger:          logger,
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
+		service:         "cloud_controller",
 	}
 }
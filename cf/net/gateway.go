@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -70,6 +71,7 @@ type Gateway struct {
 	ui              terminal.UI
 	logger          trace.Printer
 	DialTimeout     time.Duration
+	service         string
 }
 
 func (gateway *Gateway) AsyncTimeout() time.Duration {
@@ -420,6 +422,7 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 
 	httpClient.DumpRequest(request)
 
+	span := tracing.Current().StartRequest(gateway.service, request)
 	for i := 0; i < 3; i++ {
 		response, err = httpClient.Do(request)
 		if response == nil && err != nil {
@@ -428,6 +431,7 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 			break
 		}
 	}
+	span.FinishRequest(response, responseBodySize(response), err)
 
 	if err != nil {
 		return response, err
@@ -445,6 +449,15 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	return response, err
 }
 
+// responseBodySize returns the size of the response's body, which has not
+// been read yet, or 0 if the server did not send it.
+func responseBodySize(response *http.Response) int64 {
+	if response == nil || response.ContentLength < 0 {
+		return 0
+	}
+	return response.ContentLength
+}
+
 func makeHTTPTransport(gateway *Gateway) {
 	gateway.transport = &http.Transport{
 		Dial: (&net.Dialer{
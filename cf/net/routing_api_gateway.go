@@ -37,5 +37,6 @@ func NewRoutingAPIGateway(config coreconfig.Reader, clock func() time.Time, ui t
 		logger:          logger,
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
+		service:         "routing_api",
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/net"
@@ -91,6 +93,51 @@ var _ = Describe("Routing Api Gateway", func() {
 		})
 	})
 
+	Context("when the command is traced", func() {
+		var (
+			fakeExporter *tracingfakes.FakeExporter
+			tracer       *tracing.Tracer
+			root         *tracing.Span
+		)
+
+		BeforeEach(func() {
+			fakeExporter = new(tracingfakes.FakeExporter)
+			tracer = tracing.NewTracer(fakeExporter)
+			root = tracer.StartCommand("cf router-groups")
+			tracing.SetCurrent(tracer)
+		})
+
+		AfterEach(func() {
+			tracing.SetCurrent(nil)
+		})
+
+		It("records a child span of the command and propagates it to the Routing API", func() {
+			var traceParent string
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				traceParent = request.Header.Get("traceparent")
+				fmt.Fprintln(writer, `[]`)
+			}))
+			defer ts.Close()
+			gateway.SetTrustedCerts(ts.TLS.Certificates)
+
+			request, err := gateway.NewRequest("GET", ts.URL+"/routing/v1/router_groups", "TOKEN", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = gateway.PerformRequest(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			root.Finish()
+			Expect(tracer.Flush()).To(Succeed())
+
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Name).To(Equal("GET /routing/v1/router_groups"))
+			Expect(spans[0].ParentSpanID).To(Equal(root.SpanID))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("peer.service", "routing_api"))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("http.response.status_code", "200"))
+			Expect(traceParent).To(Equal(spans[0].TraceParent()))
+		})
+	})
+
 	It("uses the set dial timeout", func() {
 		Expect(gateway.DialTimeout).To(Equal(1 * time.Second))
 	})
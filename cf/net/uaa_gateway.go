@@ -37,5 +37,6 @@ func NewUAAGateway(config coreconfig.Reader, ui terminal.UI, logger trace.Printe
 		logger:          logger,
 		PollingEnabled:  false,
 		DialTimeout:     dialTimeout(envDialTimeout),
+		service:         "uaa",
 	}
 }
@@ -0,0 +1,46 @@
+package sshCmd
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+)
+
+const defaultSSHPort = "22"
+
+// OpenSSHHost returns an OpenSSH client configuration Host stanza that
+// connects to the application instance at index through the SSH endpoint.
+// The one time passcode is the password, so public key authentication is
+// turned off. OpenSSH cannot check the host key against a fingerprint, but
+// when the fingerprint is MD5 or SHA256 FingerprintHash makes ssh show it in
+// the same format, so it can be compared on the first connection.
+func OpenSSHHost(alias string, appGUID string, index int, sshEndpoint string, sshEndpointFingerprint string) (string, error) {
+	host, port, err := net.SplitHostPort(sshEndpoint)
+	if err != nil {
+		host, port, err = net.SplitHostPort(net.JoinHostPort(sshEndpoint, defaultSSHPort))
+		if err != nil {
+			return "", fmt.Errorf("Invalid SSH endpoint %q: %s", sshEndpoint, err.Error())
+		}
+	}
+
+	stanza := &bytes.Buffer{}
+	fmt.Fprintf(stanza, "Host %s\n", alias)
+	fmt.Fprintf(stanza, "    HostName %s\n", host)
+	fmt.Fprintf(stanza, "    Port %s\n", port)
+	fmt.Fprintf(stanza, "    User cf:%s/%d\n", appGUID, index)
+	fmt.Fprintf(stanza, "    PreferredAuthentications password\n")
+	fmt.Fprintf(stanza, "    PubkeyAuthentication no\n")
+
+	switch len(sshEndpointFingerprint) {
+	case md5FingerprintLength:
+		fmt.Fprintf(stanza, "    FingerprintHash md5\n")
+	case base64Sha256FingerprintLength:
+		fmt.Fprintf(stanza, "    FingerprintHash sha256\n")
+	}
+
+	if sshEndpointFingerprint != "" {
+		fmt.Fprintf(stanza, "    # Host key fingerprint: %s\n", sshEndpointFingerprint)
+	}
+
+	return stanza.String(), nil
+}
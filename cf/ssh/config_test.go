@@ -0,0 +1,68 @@
+package sshCmd_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OpenSSHHost", func() {
+	const md5Fingerprint = "11:11:11:11:11:11:11:11:11:11:11:11:11:11:11:11"
+
+	It("connects to the application instance through the SSH endpoint with a password", func() {
+		stanza, err := sshCmd.OpenSSHHost("my-app.1", "app-guid", 1, "ssh.example.com:2222", md5Fingerprint)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stanza).To(Equal(`Host my-app.1
+    HostName ssh.example.com
+    Port 2222
+    User cf:app-guid/1
+    PreferredAuthentications password
+    PubkeyAuthentication no
+    FingerprintHash md5
+    # Host key fingerprint: 11:11:11:11:11:11:11:11:11:11:11:11:11:11:11:11
+`))
+	})
+
+	Context("when the fingerprint is a base64 SHA256 fingerprint", func() {
+		It("shows SHA256 fingerprints", func() {
+			stanza, err := sshCmd.OpenSSHHost("my-app.0", "app-guid", 0, "ssh.example.com:2222", "b88sSeEOsUAw3CLgMLvTW1IwzLRnEF8Jz3B6PNvLbuk")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stanza).To(ContainSubstring("    FingerprintHash sha256\n"))
+		})
+	})
+
+	Context("when the fingerprint is a hex SHA1 fingerprint", func() {
+		It("only includes the fingerprint as a comment", func() {
+			stanza, err := sshCmd.OpenSSHHost("my-app.0", "app-guid", 0, "ssh.example.com:2222", "a6:14:c0:ea:42:07:b2:f7:53:2c:0b:60:e0:00:21:6c:62:9e:9a:cc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stanza).NotTo(ContainSubstring("FingerprintHash"))
+			Expect(stanza).To(ContainSubstring("    # Host key fingerprint: a6:14:c0:ea:42:07:b2:f7:53:2c:0b:60:e0:00:21:6c:62:9e:9a:cc\n"))
+		})
+	})
+
+	Context("when there is no fingerprint", func() {
+		It("does not mention one", func() {
+			stanza, err := sshCmd.OpenSSHHost("my-app.0", "app-guid", 0, "ssh.example.com:2222", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stanza).NotTo(ContainSubstring("Fingerprint"))
+			Expect(stanza).NotTo(ContainSubstring("fingerprint"))
+		})
+	})
+
+	Context("when the SSH endpoint has no port", func() {
+		It("uses the default SSH port", func() {
+			stanza, err := sshCmd.OpenSSHHost("my-app.0", "app-guid", 0, "ssh.example.com", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stanza).To(ContainSubstring("    HostName ssh.example.com\n    Port 22\n"))
+		})
+	})
+
+	Context("when the SSH endpoint is an IPv6 address", func() {
+		It("separates the address from the port", func() {
+			stanza, err := sshCmd.OpenSSHHost("my-app.0", "app-guid", 0, "[::1]:2222", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stanza).To(ContainSubstring("    HostName ::1\n    Port 2222\n"))
+		})
+	})
+})
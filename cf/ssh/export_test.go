@@ -0,0 +1,21 @@
+package sshCmd
+
+import (
+	"io"
+	"os"
+)
+
+// SetShutdownSignals replaces the signals that make Wait close the port
+// forwards, and returns a function that restores them.
+func SetShutdownSignals(signals ...os.Signal) func() {
+	original := shutdownSignals
+	shutdownSignals = signals
+	return func() {
+		shutdownSignals = original
+	}
+}
+
+// SetStderr replaces the writer Wait reports waiting connections to.
+func SetStderr(shell SecureShell, stderr io.Writer) {
+	shell.(*secureShell).stderr = stderr
+}
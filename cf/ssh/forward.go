@@ -0,0 +1,230 @@
+package sshCmd
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// forwardShutdownTimeout is how long Wait gives open forwarded connections to
+// finish after a signal.
+const forwardShutdownTimeout = 10 * time.Second
+
+// ErrInterrupted is returned by Wait when the port forwards were closed by a
+// signal.
+var ErrInterrupted = errors.New("interrupted")
+
+// shutdownSignals make Wait close the port forwards.
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+type ForwardType string
+
+const (
+	// LocalForward listens on the local machine and connects from the
+	// application instance (-L).
+	LocalForward ForwardType = "local"
+
+	// RemoteForward listens on the application instance and connects from the
+	// local machine (-R).
+	RemoteForward ForwardType = "remote"
+
+	// DynamicForward is a local SOCKS5 proxy that connects from the
+	// application instance (-D).
+	DynamicForward ForwardType = "dynamic"
+)
+
+// ForwardStatus describes a port forward and counts the connections made
+// through it. ConnectAddress is empty for dynamic forwards, whose clients
+// choose where to connect.
+type ForwardStatus struct {
+	Type              ForwardType
+	ListenAddress     string
+	ConnectAddress    string
+	ActiveConnections int
+	TotalConnections  int
+	FailedConnections int
+}
+
+type portForward struct {
+	listener net.Listener
+
+	mutex  sync.Mutex
+	status ForwardStatus
+}
+
+func (f *portForward) opened() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.status.ActiveConnections++
+	f.status.TotalConnections++
+}
+
+func (f *portForward) closed(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.status.ActiveConnections--
+	if err != nil {
+		f.status.FailedConnections++
+	}
+}
+
+func (f *portForward) Status() ForwardStatus {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.status
+}
+
+// forwardHandler connects a forwarded connection to its target and copies
+// data between them until either side closes.
+type forwardHandler func(conn net.Conn) error
+
+// RemotePortForward listens on the application instance for each remote
+// forward spec, and connects the connections it accepts to the connect
+// address from the local machine.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		forward := c.addForward(RemoteForward, listener, forwardSpec.ListenAddress, forwardSpec.ConnectAddress)
+		go c.forwardAcceptLoop(forward, dialLocal(forwardSpec.ConnectAddress))
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each dynamic forward address
+// that connects to the requested addresses from the application instance.
+func (c *secureShell) DynamicPortForward() error {
+	for _, address := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+
+		forward := c.addForward(DynamicForward, listener, address, "")
+		go c.forwardAcceptLoop(forward, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
+// Forwards returns the status of the port forwards that have been started.
+func (c *secureShell) Forwards() []ForwardStatus {
+	statuses := make([]ForwardStatus, 0, len(c.forwards))
+	for _, forward := range c.forwards {
+		statuses = append(statuses, forward.Status())
+	}
+	return statuses
+}
+
+func (c *secureShell) addForward(forwardType ForwardType, listener net.Listener, listenAddress string, connectAddress string) *portForward {
+	forward := &portForward{
+		listener: listener,
+		status: ForwardStatus{
+			Type:           forwardType,
+			ListenAddress:  listenAddress,
+			ConnectAddress: connectAddress,
+		},
+	}
+	c.forwards = append(c.forwards, forward)
+	return forward
+}
+
+func (c *secureShell) forwardAcceptLoop(forward *portForward, handle forwardHandler) {
+	defer forward.listener.Close()
+
+	for {
+		conn, err := forward.listener.Accept()
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return
+		}
+
+		forward.opened()
+		go func() {
+			defer conn.Close()
+			forward.closed(handle(conn))
+		}()
+	}
+}
+
+func (c *secureShell) closeForwards() {
+	for _, forward := range c.forwards {
+		_ = forward.listener.Close()
+	}
+}
+
+func (c *secureShell) activeConnections() int {
+	active := 0
+	for _, forward := range c.forwards {
+		active += forward.Status().ActiveConnections
+	}
+	return active
+}
+
+// connectionsClosed returns a channel that is closed once no forwarded
+// connections are open. It stops checking when stop is closed.
+func (c *secureShell) connectionsClosed(stop <-chan struct{}) <-chan struct{} {
+	closed := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+
+		for c.activeConnections() > 0 {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+		close(closed)
+	}()
+	return closed
+}
+
+func (c *secureShell) dialRemote(targetAddr string) forwardHandler {
+	return func(conn net.Conn) error {
+		target, err := c.secureClient.Dial("tcp", targetAddr)
+		if err != nil {
+			fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+			return err
+		}
+		defer target.Close()
+
+		copyConnections(conn, target)
+		return nil
+	}
+}
+
+func dialLocal(targetAddr string) forwardHandler {
+	return func(conn net.Conn) error {
+		target, err := net.Dial("tcp", targetAddr)
+		if err != nil {
+			fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+			return err
+		}
+		defer target.Close()
+
+		copyConnections(conn, target)
+		return nil
+	}
+}
+
+func copyConnections(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
@@ -0,0 +1,432 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Port forwarding", func() {
+	var (
+		fakeSecureClient    *sshfakes.FakeSecureClient
+		fakeSecureDialer    *sshfakes.FakeSecureDialer
+		fakeListenerFactory *sshfakes.FakeListenerFactory
+
+		opts        *options.SSHOptions
+		secureShell sshCmd.SecureShell
+
+		echoListener net.Listener
+		echoAddress  string
+		listeners    chan net.Listener
+	)
+
+	// listen listens on a random local port in place of the requested
+	// address, and sends the listener to listeners.
+	listen := func(network string, _ string) (net.Listener, error) {
+		listener, err := net.Listen(network, "127.0.0.1:0")
+		if err == nil {
+			listeners <- listener
+		}
+		return listener, err
+	}
+
+	expectEcho := func(conn net.Conn) {
+		_, err := conn.Write([]byte("hello\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		response := make([]byte, len("hello\n"))
+		_, err = io.ReadFull(conn, response)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(response)).To(Equal("hello\n"))
+	}
+
+	BeforeEach(func() {
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeListenerFactory = new(sshfakes.FakeListenerFactory)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+		fakeSecureClient.DialStub = net.Dial
+
+		listeners = make(chan net.Listener, 10)
+		fakeListenerFactory.ListenStub = listen
+		fakeSecureClient.ListenStub = listen
+
+		var err error
+		echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		echoAddress = echoListener.Addr().String()
+
+		go func(listener net.Listener) {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					_, _ = io.Copy(conn, conn)
+					conn.Close()
+				}()
+			}
+		}(echoListener)
+
+		opts = &options.SSHOptions{AppName: "app-1"}
+	})
+
+	JustBeforeEach(func() {
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			fakeListenerFactory,
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:22",
+			"",
+		)
+		Expect(secureShell.Connect(opts)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(secureShell.Close()).To(Succeed())
+		echoListener.Close()
+	})
+
+	Describe("RemotePortForward", func() {
+		var remoteForwardErr error
+
+		BeforeEach(func() {
+			opts.RemoteForwardSpecs = []options.ForwardSpec{{
+				ListenAddress:  "localhost:9999",
+				ConnectAddress: echoAddress,
+			}}
+		})
+
+		JustBeforeEach(func() {
+			remoteForwardErr = secureShell.RemotePortForward()
+		})
+
+		It("listens on the application instance and connects from the local machine", func() {
+			Expect(remoteForwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, address := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal("localhost:9999"))
+
+			conn, err := net.Dial("tcp", (<-listeners).Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			expectEcho(conn)
+			Expect(conn.Close()).To(Succeed())
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			Eventually(secureShell.Forwards).Should(ConsistOf(sshCmd.ForwardStatus{
+				Type:             sshCmd.RemoteForward,
+				ListenAddress:    "localhost:9999",
+				ConnectAddress:   echoAddress,
+				TotalConnections: 1,
+			}))
+		})
+
+		Context("when the local address cannot be reached", func() {
+			BeforeEach(func() {
+				echoListener.Close()
+			})
+
+			It("counts the failed connection", func() {
+				conn, err := net.Dial("tcp", (<-listeners).Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				Eventually(func() int {
+					return secureShell.Forwards()[0].FailedConnections
+				}).Should(Equal(1))
+			})
+		})
+
+		Context("when listening on the application instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenStub = nil
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			dynamicForwardErr error
+			conn              net.Conn
+		)
+
+		// connect asks the SOCKS proxy to connect to host:port as a domain
+		// name, and returns the reply code.
+		connect := func(address string) byte {
+			host, port, err := net.SplitHostPort(address)
+			Expect(err).NotTo(HaveOccurred())
+			portNumber, err := strconv.Atoi(port)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{5, 1, 0})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{5, 0}))
+
+			request := append([]byte{5, 1, 0, 3, byte(len(host))}, host...)
+			request = append(request, byte(portNumber>>8), byte(portNumber))
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			return reply[1]
+		}
+
+		BeforeEach(func() {
+			opts.DynamicForwardAddresses = []string{"localhost:1080"}
+		})
+
+		JustBeforeEach(func() {
+			dynamicForwardErr = secureShell.DynamicPortForward()
+			Expect(dynamicForwardErr).NotTo(HaveOccurred())
+
+			var err error
+			conn, err = net.Dial("tcp", (<-listeners).Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			conn.Close()
+		})
+
+		It("connects to the requested address from the application instance", func() {
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			_, address := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(address).To(Equal("localhost:1080"))
+
+			Expect(connect(echoAddress)).To(Equal(byte(0)))
+			expectEcho(conn)
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			_, address = fakeSecureClient.DialArgsForCall(0)
+			Expect(address).To(Equal(echoAddress))
+
+			Expect(secureShell.Forwards()).To(ConsistOf(sshCmd.ForwardStatus{
+				Type:              sshCmd.DynamicForward,
+				ListenAddress:     "localhost:1080",
+				ActiveConnections: 1,
+				TotalConnections:  1,
+			}))
+		})
+
+		Context("when the application instance cannot connect to the address", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("replies that the host is unreachable", func() {
+				Expect(connect("internal.example.com:80")).To(Equal(byte(4)))
+
+				_, address := fakeSecureClient.DialArgsForCall(0)
+				Expect(address).To(Equal("internal.example.com:80"))
+
+				Eventually(func() int {
+					return secureShell.Forwards()[0].FailedConnections
+				}).Should(Equal(1))
+			})
+		})
+
+		Context("when the client does not speak SOCKS5", func() {
+			It("closes the connection", func() {
+				_, err := conn.Write([]byte{4, 1})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(Equal(io.EOF))
+			})
+		})
+	})
+
+	Describe("Wait", func() {
+		var (
+			waitErr        error
+			waitReturned   chan struct{}
+			closeClient    func()
+			forwardAddress string
+			conn           net.Conn
+			stderr         *Buffer
+		)
+
+		BeforeEach(func() {
+			opts.ForwardSpecs = []options.ForwardSpec{{
+				ListenAddress:  "localhost:8080",
+				ConnectAddress: echoAddress,
+			}}
+
+			clientClosed := make(chan struct{})
+			once := &sync.Once{}
+			closeClient = func() {
+				once.Do(func() { close(clientClosed) })
+			}
+
+			fakeSecureClient.CloseStub = func() error {
+				closeClient()
+				return nil
+			}
+			fakeSecureClient.WaitStub = func() error {
+				<-clientClosed
+				return errors.New("connection closed")
+			}
+		})
+
+		JustBeforeEach(func() {
+			stderr = NewBuffer()
+			sshCmd.SetStderr(secureShell, stderr)
+			Expect(secureShell.LocalPortForward()).To(Succeed())
+
+			forwardAddress = (<-listeners).Addr().String()
+
+			var err error
+			conn, err = net.Dial("tcp", forwardAddress)
+			Expect(err).NotTo(HaveOccurred())
+			expectEcho(conn)
+
+			waitReturned = make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				waitErr = secureShell.Wait()
+				close(waitReturned)
+			}()
+			Eventually(fakeSecureClient.WaitCallCount).Should(Equal(1))
+		})
+
+		AfterEach(func() {
+			conn.Close()
+		})
+
+		Context("when a termination signal is received", func() {
+			var restoreSignals func()
+
+			BeforeEach(func() {
+				// The test runner exits on SIGINT and SIGTERM.
+				restoreSignals = sshCmd.SetShutdownSignals(syscall.SIGUSR1)
+			})
+
+			AfterEach(func() {
+				restoreSignals()
+			})
+
+			JustBeforeEach(func() {
+				Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)).To(Succeed())
+			})
+
+			It("stops accepting connections and waits for the open ones to close", func() {
+				Eventually(func() error {
+					newConn, err := net.DialTimeout("tcp", forwardAddress, time.Second)
+					if err == nil {
+						newConn.Close()
+					}
+					return err
+				}).Should(HaveOccurred())
+
+				Consistently(waitReturned, 200*time.Millisecond).ShouldNot(BeClosed())
+				Expect(stderr).To(Say("Waiting for 1 forwarded connections to close..."))
+
+				Expect(conn.Close()).To(Succeed())
+				Eventually(waitReturned).Should(BeClosed())
+				Expect(waitErr).To(Equal(sshCmd.ErrInterrupted))
+
+				// Dials made before the listener closed are counted as well.
+				forwards := secureShell.Forwards()
+				Expect(forwards).To(HaveLen(1))
+				Expect(forwards[0].ActiveConnections).To(Equal(0))
+				Expect(forwards[0].TotalConnections).To(BeNumerically(">=", 1))
+			})
+		})
+
+		Context("when the connection closes", func() {
+			It("returns the error", func() {
+				closeClient()
+
+				Eventually(waitReturned).Should(BeClosed())
+				Expect(waitErr).To(MatchError("connection closed"))
+			})
+		})
+	})
+
+	Describe("Wait without port forwards", func() {
+		var (
+			restoreSignals func()
+			testSignals    chan os.Signal
+			waitErr        error
+		)
+
+		BeforeEach(func() {
+			// The test runner exits on SIGINT and SIGTERM.
+			restoreSignals = sshCmd.SetShutdownSignals(syscall.SIGUSR1)
+
+			// Keeps the signal from terminating the test runner when Wait
+			// does not trap it.
+			testSignals = make(chan os.Signal, 1)
+			signal.Notify(testSignals, syscall.SIGUSR1)
+
+			fakeSecureClient.WaitStub = func() error {
+				Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)).To(Succeed())
+				Eventually(testSignals).Should(Receive())
+				time.Sleep(100 * time.Millisecond)
+				return errors.New("connection closed")
+			}
+		})
+
+		AfterEach(func() {
+			signal.Stop(testSignals)
+			restoreSignals()
+		})
+
+		JustBeforeEach(func() {
+			waitErr = secureShell.Wait()
+		})
+
+		It("does not trap signals", func() {
+			Expect(waitErr).To(MatchError("connection closed"))
+		})
+
+		Context("when only forwarding is requested", func() {
+			BeforeEach(func() {
+				opts.SkipRemoteExecution = true
+			})
+
+			It("returns ErrInterrupted on a signal", func() {
+				Expect(waitErr).To(Equal(sshCmd.ErrInterrupted))
+			})
+		})
+	})
+})
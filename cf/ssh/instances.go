@@ -0,0 +1,152 @@
+package sshCmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+)
+
+// InstanceResult is the outcome of running a command on one application
+// instance. Err is set when the command could not be run to completion;
+// otherwise ExitStatus, and Signal if one terminated it, describe how the
+// command exited.
+type InstanceResult struct {
+	Index      int
+	ExitStatus int
+	Signal     string
+	Err        error
+}
+
+func (r InstanceResult) Failed() bool {
+	return r.Err != nil || r.ExitStatus != 0 || r.Signal != ""
+}
+
+// RunOnInstances runs the command from the options on each of the instances
+// at indexes, at most opts.Concurrency at a time, connecting to each with a
+// SecureShell from newSecureShell. newSecureShell is called for one instance
+// at a time, since each connection needs its own one time auth code. Every
+// line of output is written to stdout or stderr prefixed with the index of
+// the instance it came from. The results are in the same order as indexes.
+func RunOnInstances(newSecureShell func() (SecureShell, error), opts *options.SSHOptions, indexes []int, stdout io.Writer, stderr io.Writer) []InstanceResult {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]InstanceResult, len(indexes))
+	outputMutex := &sync.Mutex{}
+	semaphore := make(chan struct{}, concurrency)
+	wg := &sync.WaitGroup{}
+
+	for i, index := range indexes {
+		semaphore <- struct{}{}
+
+		secureShell, err := newSecureShell()
+		if err != nil {
+			results[i] = InstanceResult{Index: index, Err: err}
+			<-semaphore
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, index int, secureShell SecureShell) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			prefix := fmt.Sprintf("[%d] ", index)
+			instanceStdout := newPrefixWriter(stdout, outputMutex, prefix)
+			instanceStderr := newPrefixWriter(stderr, outputMutex, prefix)
+
+			results[i] = runOnInstance(secureShell, opts, index, instanceStdout, instanceStderr)
+
+			_ = instanceStdout.Flush()
+			_ = instanceStderr.Flush()
+		}(i, index, secureShell)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func runOnInstance(secureShell SecureShell, opts *options.SSHOptions, index int, stdout io.Writer, stderr io.Writer) InstanceResult {
+	result := InstanceResult{Index: index}
+
+	instanceOpts := *opts
+	instanceOpts.Index = uint(index)
+
+	err := secureShell.Connect(&instanceOpts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer secureShell.Close()
+
+	err = secureShell.RunCommand(stdout, stderr)
+	if exitError, ok := err.(*ssh.ExitError); ok {
+		result.ExitStatus = exitError.ExitStatus()
+		result.Signal = exitError.Signal()
+	} else {
+		result.Err = err
+	}
+
+	return result
+}
+
+// prefixWriter writes each complete line written to it to dest, after a
+// prefix. The writers for all instances share a mutex, so that lines from
+// different instances are never interleaved.
+type prefixWriter struct {
+	dest    io.Writer
+	mutex   *sync.Mutex
+	prefix  []byte
+	partial []byte
+}
+
+func newPrefixWriter(dest io.Writer, mutex *sync.Mutex, prefix string) *prefixWriter {
+	return &prefixWriter{
+		dest:   dest,
+		mutex:  mutex,
+		prefix: []byte(prefix),
+	}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+
+	for {
+		end := bytes.IndexByte(w.partial, '\n')
+		if end < 0 {
+			return len(p), nil
+		}
+
+		err := w.writeLine(w.partial[:end+1])
+		w.partial = w.partial[end+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+}
+
+// Flush writes any output after the last newline as a line of its own.
+func (w *prefixWriter) Flush() error {
+	if len(w.partial) == 0 {
+		return nil
+	}
+
+	line := append(w.partial, '\n')
+	w.partial = nil
+	return w.writeLine(line)
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	_, err := w.dest.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
@@ -0,0 +1,172 @@
+package sshCmd_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunOnInstances", func() {
+	var (
+		opts           *options.SSHOptions
+		stdout, stderr *bytes.Buffer
+
+		mutex        sync.Mutex
+		shells       []*sshfakes.FakeSecureShell
+		running      int
+		maxRunning   int
+		failingIndex int
+
+		newSecureShellFailsAt int
+
+		results []sshCmd.InstanceResult
+	)
+
+	newSecureShell := func() (sshCmd.SecureShell, error) {
+		if len(shells) == newSecureShellFailsAt {
+			shells = append(shells, nil)
+			return nil, errors.New("auth api error")
+		}
+
+		fakeSecureShell := new(sshfakes.FakeSecureShell)
+
+		var index uint
+		fakeSecureShell.ConnectStub = func(opts *options.SSHOptions) error {
+			index = opts.Index
+			if int(index) == failingIndex {
+				return errors.New("dial error")
+			}
+			return nil
+		}
+
+		fakeSecureShell.RunCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+			fmt.Fprintf(stdout, "first line from %d\nsecond ", index)
+			fmt.Fprintf(stdout, "line from %d\npartial line from %d", index, index)
+			fmt.Fprintf(stderr, "warning from %d\n", index)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+			return nil
+		}
+
+		shells = append(shells, fakeSecureShell)
+		return fakeSecureShell, nil
+	}
+
+	outputLines := func(buffer *bytes.Buffer) []string {
+		lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+		sort.Strings(lines)
+		return lines
+	}
+
+	BeforeEach(func() {
+		opts = &options.SSHOptions{
+			AppName:      "app-1",
+			Command:      []string{"uptime"},
+			AllInstances: true,
+			Concurrency:  2,
+		}
+
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+
+		shells = nil
+		running = 0
+		maxRunning = 0
+		failingIndex = -1
+		newSecureShellFailsAt = -1
+	})
+
+	JustBeforeEach(func() {
+		results = sshCmd.RunOnInstances(newSecureShell, opts, []int{0, 1, 3}, stdout, stderr)
+	})
+
+	It("runs the command on each instance, prefixing each line of output with the instance index", func() {
+		Expect(shells).To(HaveLen(3))
+		for _, shell := range shells {
+			Expect(shell.ConnectCallCount()).To(Equal(1))
+			Expect(shell.RunCommandCallCount()).To(Equal(1))
+			Expect(shell.CloseCallCount()).To(Equal(1))
+		}
+
+		Expect(outputLines(stdout)).To(Equal([]string{
+			"[0] first line from 0",
+			"[0] partial line from 0",
+			"[0] second line from 0",
+			"[1] first line from 1",
+			"[1] partial line from 1",
+			"[1] second line from 1",
+			"[3] first line from 3",
+			"[3] partial line from 3",
+			"[3] second line from 3",
+		}))
+		Expect(outputLines(stderr)).To(Equal([]string{
+			"[0] warning from 0",
+			"[1] warning from 1",
+			"[3] warning from 3",
+		}))
+
+		Expect(results).To(Equal([]sshCmd.InstanceResult{{Index: 0}, {Index: 1}, {Index: 3}}))
+	})
+
+	It("does not change the options it was given", func() {
+		Expect(opts.Index).To(BeZero())
+	})
+
+	It("runs on at most the given number of instances at a time", func() {
+		Expect(maxRunning).To(Equal(2))
+	})
+
+	Context("when connecting to an instance fails", func() {
+		BeforeEach(func() {
+			failingIndex = 1
+		})
+
+		It("records the error and runs on the other instances", func() {
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].Failed()).To(BeFalse())
+			Expect(results[1].Index).To(Equal(1))
+			Expect(results[1].Err).To(MatchError("dial error"))
+			Expect(results[1].Failed()).To(BeTrue())
+			Expect(results[2].Failed()).To(BeFalse())
+
+			Expect(stdout.String()).NotTo(ContainSubstring("[1]"))
+		})
+	})
+
+	Context("when creating the secure shell for an instance fails", func() {
+		BeforeEach(func() {
+			newSecureShellFailsAt = 2
+		})
+
+		It("records the error and runs on the other instances", func() {
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].Failed()).To(BeFalse())
+			Expect(results[1].Failed()).To(BeFalse())
+			Expect(results[2]).To(Equal(sshCmd.InstanceResult{Index: 3, Err: errors.New("auth api error")}))
+
+			Expect(stdout.String()).NotTo(ContainSubstring("[3]"))
+		})
+	})
+})
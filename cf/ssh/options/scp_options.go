@@ -0,0 +1,79 @@
+package options
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/flags"
+)
+
+type SCPOptions struct {
+	AppName            string
+	Index              uint
+	SkipHostValidation bool
+	Recursive          bool
+
+	// Upload is true when files are copied from the local machine to the
+	// application instance, and false when they are copied from it.
+	Upload     bool
+	LocalPath  string
+	RemotePath string
+}
+
+// NewSCPOptions parses the SOURCE and TARGET arguments of the scp command.
+// Exactly one of them must be an APP_NAME:PATH argument that refers to the
+// application instance.
+func NewSCPOptions(fc flags.FlagContext) (*SCPOptions, error) {
+	scpOptions := &SCPOptions{}
+
+	scpOptions.Index = uint(fc.Int("i"))
+	scpOptions.SkipHostValidation = fc.Bool("k")
+	scpOptions.Recursive = fc.Bool("r")
+
+	source, target := fc.Args()[0], fc.Args()[1]
+	sourceApp, sourcePath, sourceIsRemote := splitRemotePath(source)
+	targetApp, targetPath, targetIsRemote := splitRemotePath(target)
+
+	switch {
+	case sourceIsRemote && targetIsRemote:
+		return scpOptions, errors.New("Copying files between application instances is not supported")
+	case sourceIsRemote:
+		scpOptions.AppName = sourceApp
+		scpOptions.RemotePath = sourcePath
+		scpOptions.LocalPath = target
+	case targetIsRemote:
+		scpOptions.AppName = targetApp
+		scpOptions.RemotePath = targetPath
+		scpOptions.LocalPath = source
+		scpOptions.Upload = true
+	default:
+		return scpOptions, errors.New("Either SOURCE or TARGET must be of the form APP_NAME:PATH")
+	}
+
+	if scpOptions.RemotePath == "" {
+		scpOptions.RemotePath = "."
+	}
+
+	return scpOptions, nil
+}
+
+// SSHOptions returns the options used to connect to the application instance.
+func (o *SCPOptions) SSHOptions() *SSHOptions {
+	return &SSHOptions{
+		AppName:            o.AppName,
+		Index:              o.Index,
+		SkipHostValidation: o.SkipHostValidation,
+	}
+}
+
+// splitRemotePath splits an APP_NAME:PATH argument. Arguments without a colon,
+// whose colon follows a path separator or that start with a volume name,
+// such as C:, are local paths.
+func splitRemotePath(arg string) (string, string, bool) {
+	i := strings.Index(arg, ":")
+	if i < 1 || strings.ContainsAny(arg[:i], `/\`) || filepath.VolumeName(arg) != "" {
+		return "", "", false
+	}
+	return arg[:i], arg[i+1:], true
+}
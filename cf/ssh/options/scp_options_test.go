@@ -0,0 +1,119 @@
+package options_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCPOptions", func() {
+	var (
+		opts       *options.SCPOptions
+		args       []string
+		parseError error
+		fc         flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		fc = flags.New()
+		fc.NewIntFlag("app-instance-index", "i", "")
+		fc.NewBoolFlag("skip-host-validation", "k", "")
+		fc.NewBoolFlag("recursive", "r", "")
+
+		args = []string{}
+		parseError = nil
+	})
+
+	JustBeforeEach(func() {
+		err := fc.Parse(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		opts, parseError = options.NewSCPOptions(fc)
+	})
+
+	Context("when the target is on the application instance", func() {
+		BeforeEach(func() {
+			args = append(args, "-i", "2", "-k", "-r", "some/local/dir", "app-1:app/dir")
+		})
+
+		It("uploads the source to the target", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(*opts).To(Equal(options.SCPOptions{
+				AppName:            "app-1",
+				Index:              2,
+				SkipHostValidation: true,
+				Recursive:          true,
+				Upload:             true,
+				LocalPath:          "some/local/dir",
+				RemotePath:         "app/dir",
+			}))
+		})
+
+		It("connects to the instance with the same options", func() {
+			Expect(*opts.SSHOptions()).To(Equal(options.SSHOptions{
+				AppName:            "app-1",
+				Index:              2,
+				SkipHostValidation: true,
+			}))
+		})
+	})
+
+	Context("when the source is on the application instance", func() {
+		BeforeEach(func() {
+			args = append(args, "app-1:logs/app.log", "./app.log")
+		})
+
+		It("downloads the source to the target", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.AppName).To(Equal("app-1"))
+			Expect(opts.Upload).To(BeFalse())
+			Expect(opts.RemotePath).To(Equal("logs/app.log"))
+			Expect(opts.LocalPath).To(Equal("./app.log"))
+		})
+	})
+
+	Context("when the remote path is empty", func() {
+		BeforeEach(func() {
+			args = append(args, "app.log", "app-1:")
+		})
+
+		It("copies to the home directory of the instance", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.RemotePath).To(Equal("."))
+		})
+	})
+
+	Context("when the colon follows a path separator", func() {
+		BeforeEach(func() {
+			args = append(args, "./some:file", "app-1:file")
+		})
+
+		It("treats the argument as a local path", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.LocalPath).To(Equal("./some:file"))
+			Expect(opts.Upload).To(BeTrue())
+		})
+	})
+
+	Context("when both arguments are on application instances", func() {
+		BeforeEach(func() {
+			args = append(args, "app-1:file", "app-2:file")
+		})
+
+		It("returns an error", func() {
+			Expect(parseError).To(MatchError("Copying files between application instances is not supported"))
+		})
+	})
+
+	Context("when neither argument is on an application instance", func() {
+		BeforeEach(func() {
+			args = append(args, "file", "other-file")
+		})
+
+		It("returns an error", func() {
+			Expect(parseError).To(MatchError("Either SOURCE or TARGET must be of the form APP_NAME:PATH"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package options
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,6 +17,10 @@ const (
 	RequestTTYForce
 )
 
+// DefaultConcurrency is how many instances --all-instances runs the command
+// on at once when --concurrency is not given.
+const DefaultConcurrency = 8
+
 type ForwardSpec struct {
 	ListenAddress  string
 	ConnectAddress string
@@ -29,6 +34,19 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+
+	// RemoteForwardSpecs listen on the application instance and connect to
+	// addresses reachable from the local machine.
+	RemoteForwardSpecs []ForwardSpec
+
+	// DynamicForwardAddresses are local addresses that accept SOCKS5
+	// connections to any address reachable from the application instance.
+	DynamicForwardAddresses []string
+
+	// AllInstances runs Command on every running instance instead of the one
+	// at Index, at most Concurrency at a time.
+	AllInstances bool
+	Concurrency  int
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -37,7 +55,7 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 	sshOptions.AppName = fc.Args()[0]
 	sshOptions.Index = uint(fc.Int("i"))
 	sshOptions.SkipHostValidation = fc.Bool("k")
-	sshOptions.SkipRemoteExecution = fc.Bool("N")
+	sshOptions.SkipRemoteExecution = fc.Bool("N") || fc.Bool("forward-only")
 	sshOptions.Command = fc.StringSlice("c")
 
 	if fc.IsSet("L") {
@@ -50,6 +68,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseRemoteForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			address, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardAddresses = append(sshOptions.DynamicForwardAddresses, address)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -62,21 +100,58 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		sshOptions.TerminalRequest = RequestTTYNo
 	}
 
+	sshOptions.AllInstances = fc.Bool("all-instances")
+	sshOptions.Concurrency = DefaultConcurrency
+	if fc.IsSet("concurrency") {
+		sshOptions.Concurrency = fc.Int("concurrency")
+	}
+
+	if sshOptions.AllInstances {
+		err := sshOptions.validateAllInstances(fc)
+		if err != nil {
+			return sshOptions, err
+		}
+	}
+
 	return sshOptions, nil
 }
 
+// validateAllInstances rejects the flags that only make sense for a single
+// session: an instance index, port forwarding and terminal allocation.
+func (o *SSHOptions) validateAllInstances(fc flags.FlagContext) error {
+	if len(o.Command) == 0 {
+		return errors.New("--all-instances requires a command to run with -c")
+	}
+
+	if fc.IsSet("i") || o.SkipRemoteExecution || len(o.ForwardSpecs) > 0 || len(o.RemoteForwardSpecs) > 0 ||
+		len(o.DynamicForwardAddresses) > 0 || o.TerminalRequest == RequestTTYYes || o.TerminalRequest == RequestTTYForce {
+		return errors.New("--all-instances cannot be used with -i, -L, -R, -D, -N, --forward-only, -t or -tt")
+	}
+
+	if o.Concurrency < 1 {
+		return errors.New("--concurrency must be at least 1")
+	}
+
+	return nil
+}
+
 func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
-	arg = strings.TrimSpace(arg)
+	return parseForwardingSpec(arg, "local")
+}
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
-		}
+// parseRemoteForwardingSpec parses the same arguments as
+// parseLocalForwardingSpec, but the bind address and port are on the
+// application instance.
+func (o *SSHOptions) parseRemoteForwardingSpec(arg string) (*ForwardSpec, error) {
+	return parseForwardingSpec(arg, "remote")
+}
 
-		parts = append(parts, part)
-		remainder = r
+func parseForwardingSpec(arg string, kind string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +166,48 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", kind, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+// parseDynamicForwardingSpec parses [bind_address:]port.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
+
+func tokenizeForwardingSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
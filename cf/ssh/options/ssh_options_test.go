@@ -20,13 +20,18 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
 			fc.NewBoolFlag("skip-remote-execution", "N", "")
+			fc.NewBoolFlag("forward-only", "", "")
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
 			fc.NewBoolFlag("disable-pseudo-tty", "T", "")
+			fc.NewBoolFlag("all-instances", "", "")
+			fc.NewIntFlag("concurrency", "", "")
 
 			args = []string{}
 			parseError = nil
@@ -281,6 +286,94 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"}))
+					Expect(opts.ForwardSpecs).To(BeEmpty())
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "*:9999:[::1]:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: ":9999", ConnectAddress: "[::1]:8888"}))
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999:localhost"`))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("with only a port", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("listens on localhost", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with explicit bind addresses", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "*:1080", "-D", "[::1]:1081")
+				})
+
+				It("listens on the bind addresses", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf(":1080", "[::1]:1081"))
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "localhost:1080:remote")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "localhost:1080:remote"`))
+				})
+			})
+		})
+
+		Context("when --forward-only is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--forward-only")
+			})
+
+			It("indicates that no remote command should be run", func() {
+				Expect(parseError).ToNot(HaveOccurred())
+				Expect(opts.SkipRemoteExecution).To(BeTrue())
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
@@ -292,6 +385,82 @@ var _ = Describe("SSHOptions", func() {
 				Expect(opts.AppName).To(Equal("app-name"))
 			})
 		})
+
+		Context("when --all-instances is specified", func() {
+			Context("with a command", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "uptime")
+				})
+
+				It("runs the command on all instances with the default concurrency", func() {
+					Expect(parseError).ToNot(HaveOccurred())
+					Expect(opts.AllInstances).To(BeTrue())
+					Expect(opts.Command).To(ConsistOf("uptime"))
+					Expect(opts.Concurrency).To(Equal(options.DefaultConcurrency))
+				})
+			})
+
+			Context("with --concurrency", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "uptime", "--concurrency", "2")
+				})
+
+				It("limits the concurrency", func() {
+					Expect(parseError).ToNot(HaveOccurred())
+					Expect(opts.Concurrency).To(Equal(2))
+				})
+			})
+
+			Context("when --concurrency is less than 1", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "uptime", "--concurrency", "0")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--concurrency must be at least 1"))
+				})
+			})
+
+			Context("without a command", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances requires a command to run with -c"))
+				})
+			})
+
+			Context("with an instance index", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "uptime", "-i", "1")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with -i, -L, -R, -D, -N, --forward-only, -t or -tt"))
+				})
+			})
+
+			Context("with port forwarding", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "uptime", "-L", "8080:localhost:8080")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with -i, -L, -R, -D, -N, --forward-only, -t or -tt"))
+				})
+			})
+
+			Context("with -t", func() {
+				BeforeEach(func() {
+					args = append(args, "app-name", "--all-instances", "-c", "top", "-t")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with -i, -L, -R, -D, -N, --forward-only, -t or -tt"))
+				})
+			})
+		})
 	})
 
 })
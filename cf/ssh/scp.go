@@ -0,0 +1,376 @@
+package sshCmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:generate counterfeiter . FileProgress
+
+// FileProgress displays the progress of the files copied to or from an
+// application instance.
+type FileProgress interface {
+	NewFileWrapper(name string, reader io.Reader, size int64) io.Reader
+}
+
+// CopyToRemote copies the file, or with recursive the directory, at localPath
+// to remotePath on the connected application instance. The copy uses the scp
+// protocol, so the instance must have scp installed.
+func (c *secureShell) CopyToRemote(localPath string, remotePath string, recursive bool, progress FileProgress) error {
+	absPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%s is a directory", localPath)
+	}
+
+	return c.runSCP(scpArguments("-t", remotePath, recursive), func(stream *scpStream) error {
+		err := stream.readAck()
+		if err != nil {
+			return err
+		}
+
+		return stream.send(absPath, info, progress)
+	})
+}
+
+// CopyFromRemote copies the file, or with recursive the directory, at
+// remotePath on the connected application instance to localPath. When
+// localPath is an existing directory the copy is placed inside it.
+func (c *secureShell) CopyFromRemote(remotePath string, localPath string, recursive bool, progress FileProgress) error {
+	return c.runSCP(scpArguments("-f", remotePath, recursive), func(stream *scpStream) error {
+		return stream.receive(localPath, progress)
+	})
+}
+
+func (c *secureShell) runSCP(arguments string, transfer func(*scpStream) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	stderr := &bytes.Buffer{}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go copyAndDone(wg, stderr, errPipe)
+
+	err = session.Start("scp " + arguments)
+	if err != nil {
+		return err
+	}
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	err = transfer(&scpStream{writer: inPipe, reader: bufio.NewReader(outPipe)})
+	_ = inPipe.Close()
+	if err != nil {
+		return err
+	}
+
+	err = session.Wait()
+	wg.Wait()
+	if err != nil && stderr.Len() > 0 {
+		return errors.New(strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
+
+func scpArguments(mode string, path string, recursive bool) string {
+	arguments := []string{mode}
+	if recursive {
+		arguments = append(arguments, "-r")
+	}
+	quotedPath := "'" + strings.Replace(path, "'", `'\''`, -1) + "'"
+	return strings.Join(append(arguments, "--", quotedPath), " ")
+}
+
+// scpStream is the connection to scp running on the application instance in
+// source (-f) or sink (-t) mode. Every message is acknowledged with a zero
+// byte, or answered with 1 (warning) or 2 (fatal error) followed by a line
+// describing the problem.
+type scpStream struct {
+	writer io.Writer
+	reader *bufio.Reader
+}
+
+func (s *scpStream) ack() error {
+	_, err := s.writer.Write([]byte{0})
+	return err
+}
+
+func (s *scpStream) readAck() error {
+	code, err := s.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+	if code == 0 {
+		return nil
+	}
+
+	message, err := s.reader.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	return scpError(code, message)
+}
+
+func (s *scpStream) send(path string, info os.FileInfo, progress FileProgress) error {
+	if info.IsDir() {
+		return s.sendDirectory(path, info, progress)
+	}
+	return s.sendFile(path, info, progress)
+}
+
+func (s *scpStream) sendFile(path string, info os.FileInfo, progress FileProgress) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(s.writer, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	err = s.readAck()
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader = file
+	if progress != nil {
+		reader = progress.NewFileWrapper(info.Name(), file, info.Size())
+	}
+
+	_, err = io.CopyN(s.writer, reader, info.Size())
+	if err != nil {
+		return err
+	}
+
+	err = s.ack()
+	if err != nil {
+		return err
+	}
+
+	return s.readAck()
+}
+
+func (s *scpStream) sendDirectory(path string, info os.FileInfo, progress FileProgress) error {
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.writer, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	err = s.readAck()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+
+		// Like scp, copy the files that symbolic links point to.
+		if entry.Mode()&os.ModeSymlink != 0 {
+			entry, err = os.Stat(entryPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !entry.IsDir() && !entry.Mode().IsRegular() {
+			continue
+		}
+
+		err = s.send(entryPath, entry, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(s.writer, "E\n")
+	if err != nil {
+		return err
+	}
+
+	return s.readAck()
+}
+
+func (s *scpStream) receive(localPath string, progress FileProgress) error {
+	var directories []string
+
+	err := s.ack()
+	if err != nil {
+		return err
+	}
+
+	for {
+		code, err := s.reader.ReadByte()
+		if err == io.EOF && len(directories) == 0 {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		switch code {
+		case 1, 2:
+			return scpError(code, line)
+		case 'T':
+			// Modification times are only sent with -p, which is not used.
+		case 'E':
+			if len(directories) == 0 {
+				return errors.New("Unexpected end of directory received from scp")
+			}
+			directories = directories[:len(directories)-1]
+		case 'C', 'D':
+			mode, size, name, err := parseSCPHeader(line)
+			if err != nil {
+				return err
+			}
+
+			path, err := receivePath(localPath, directories, name)
+			if err != nil {
+				return err
+			}
+
+			if code == 'D' {
+				err = os.Mkdir(path, mode|0700)
+				if err != nil && !os.IsExist(err) {
+					return err
+				}
+				directories = append(directories, path)
+			} else {
+				err = s.receiveFile(path, mode, size, name, progress)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("Unexpected message received from scp: %q", string(code)+line)
+		}
+
+		err = s.ack()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *scpStream) receiveFile(path string, mode os.FileMode, size int64, name string, progress FileProgress) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = s.ack()
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader = s.reader
+	if progress != nil {
+		reader = progress.NewFileWrapper(name, s.reader, size)
+	}
+
+	_, err = io.CopyN(file, reader, size)
+	if err != nil {
+		return err
+	}
+
+	return s.readAck()
+}
+
+// parseSCPHeader parses the "MODE SIZE NAME" of a C (file) or D (directory)
+// message.
+func parseSCPHeader(header string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(header, " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("Invalid header received from scp: %q", header)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("Invalid file mode received from scp: %q", parts[0])
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("Invalid file size received from scp: %q", parts[1])
+	}
+
+	return os.FileMode(mode).Perm(), size, parts[2], nil
+}
+
+// receivePath returns where a received file or directory called name is
+// written. Names that could escape the target directory are rejected.
+func receivePath(localPath string, directories []string, name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/"+string(filepath.Separator)) {
+		return "", fmt.Errorf("Invalid file name received from scp: %q", name)
+	}
+
+	if len(directories) > 0 {
+		return filepath.Join(directories[len(directories)-1], name), nil
+	}
+
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		return filepath.Join(localPath, name), nil
+	}
+	return localPath, nil
+}
+
+func scpError(code byte, message string) error {
+	message = strings.TrimSpace(message)
+	if code != 1 && code != 2 {
+		return fmt.Errorf("Unexpected response from scp: %q", string(code)+message)
+	}
+	return errors.New(message)
+}
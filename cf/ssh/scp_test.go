@@ -0,0 +1,240 @@
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_io"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP", func() {
+	var (
+		fakeSecureClient  *sshfakes.FakeSecureClient
+		fakeSecureDialer  *sshfakes.FakeSecureDialer
+		fakeSecureSession *sshfakes.FakeSecureSession
+		fakeFileProgress  *sshfakes.FakeFileProgress
+
+		secureShell sshCmd.SecureShell
+
+		localDir  string
+		remoteDir string
+	)
+
+	// useLocalSCP runs scp on the local machine, in remoteDir, as a stand-in
+	// for scp on the application instance.
+	useLocalSCP := func() {
+		_, err := exec.LookPath("scp")
+		if err != nil {
+			Skip("scp is not installed")
+		}
+
+		stdinReader, stdinWriter, err := os.Pipe()
+		Expect(err).NotTo(HaveOccurred())
+		stdoutReader, stdoutWriter, err := os.Pipe()
+		Expect(err).NotTo(HaveOccurred())
+		stderrReader, stderrWriter, err := os.Pipe()
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeSecureSession.StdinPipeReturns(stdinWriter, nil)
+		fakeSecureSession.StdoutPipeReturns(stdoutReader, nil)
+		fakeSecureSession.StderrPipeReturns(stderrReader, nil)
+
+		var cmd *exec.Cmd
+		fakeSecureSession.StartStub = func(command string) error {
+			cmd = exec.Command("sh", "-c", command)
+			cmd.Dir = remoteDir
+			cmd.Stdin = stdinReader
+			cmd.Stdout = stdoutWriter
+			cmd.Stderr = stderrWriter
+			err := cmd.Start()
+
+			stdinReader.Close()
+			stdoutWriter.Close()
+			stderrWriter.Close()
+			return err
+		}
+		fakeSecureSession.WaitStub = func() error {
+			return cmd.Wait()
+		}
+	}
+
+	writeFile := func(path string, contents string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(contents), 0640)).To(Succeed())
+	}
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	BeforeEach(func() {
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureSession = new(sshfakes.FakeSecureSession)
+		fakeFileProgress = new(sshfakes.FakeFileProgress)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+
+		fakeFileProgress.NewFileWrapperStub = func(_ string, reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "scp-local")
+		Expect(err).NotTo(HaveOccurred())
+		remoteDir, err = ioutil.TempDir("", "scp-remote")
+		Expect(err).NotTo(HaveOccurred())
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			terminal.DefaultHelper(),
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:22",
+			"",
+		)
+		Expect(secureShell.Connect(&options.SSHOptions{AppName: "app-1"})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+	})
+
+	Describe("CopyToRemote", func() {
+		BeforeEach(func() {
+			useLocalSCP()
+		})
+
+		It("copies the file with its permissions", func() {
+			writeFile(filepath.Join(localDir, "config.yml"), "some-config")
+
+			err := secureShell.CopyToRemote(filepath.Join(localDir, "config.yml"), "app's.yml", false, fakeFileProgress)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t -- 'app'\''s.yml'`))
+			Expect(readFile(filepath.Join(remoteDir, "app's.yml"))).To(Equal("some-config"))
+
+			info, err := os.Stat(filepath.Join(remoteDir, "app's.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+
+			Expect(fakeFileProgress.NewFileWrapperCallCount()).To(Equal(1))
+			name, _, size := fakeFileProgress.NewFileWrapperArgsForCall(0)
+			Expect(name).To(Equal("config.yml"))
+			Expect(size).To(Equal(int64(len("some-config"))))
+		})
+
+		It("copies directories recursively", func() {
+			writeFile(filepath.Join(localDir, "app", "a.txt"), "a")
+			writeFile(filepath.Join(localDir, "app", "nested", "b.txt"), "b")
+
+			err := secureShell.CopyToRemote(filepath.Join(localDir, "app"), ".", true, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t -r -- '.'`))
+			Expect(readFile(filepath.Join(remoteDir, "app", "a.txt"))).To(Equal("a"))
+			Expect(readFile(filepath.Join(remoteDir, "app", "nested", "b.txt"))).To(Equal("b"))
+		})
+
+		Context("when the source is a directory and recursive is not set", func() {
+			It("returns an error without starting scp", func() {
+				err := secureShell.CopyToRemote(localDir, ".", false, nil)
+				Expect(err).To(MatchError(localDir + " is a directory"))
+				Expect(fakeSecureSession.StartCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when scp on the instance cannot write the file", func() {
+			It("returns the error from scp", func() {
+				writeFile(filepath.Join(localDir, "config.yml"), "some-config")
+
+				err := secureShell.CopyToRemote(filepath.Join(localDir, "config.yml"), "missing/dir/config.yml", false, nil)
+				Expect(err).To(MatchError(ContainSubstring("No such file or directory")))
+			})
+		})
+	})
+
+	Describe("CopyFromRemote", func() {
+		Context("with scp on the instance", func() {
+			BeforeEach(func() {
+				useLocalSCP()
+			})
+
+			It("copies the file into an existing directory", func() {
+				writeFile(filepath.Join(remoteDir, "logs", "app.log"), "some-log")
+
+				err := secureShell.CopyFromRemote("logs/app.log", localDir, false, fakeFileProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -- 'logs/app.log'`))
+				Expect(readFile(filepath.Join(localDir, "app.log"))).To(Equal("some-log"))
+
+				Expect(fakeFileProgress.NewFileWrapperCallCount()).To(Equal(1))
+				name, _, size := fakeFileProgress.NewFileWrapperArgsForCall(0)
+				Expect(name).To(Equal("app.log"))
+				Expect(size).To(Equal(int64(len("some-log"))))
+			})
+
+			It("copies directories recursively to a new path", func() {
+				writeFile(filepath.Join(remoteDir, "logs", "app.log"), "some-log")
+				writeFile(filepath.Join(remoteDir, "logs", "old", "app.log.1"), "old-log")
+
+				err := secureShell.CopyFromRemote("logs", filepath.Join(localDir, "copy"), true, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(readFile(filepath.Join(localDir, "copy", "app.log"))).To(Equal("some-log"))
+				Expect(readFile(filepath.Join(localDir, "copy", "old", "app.log.1"))).To(Equal("old-log"))
+			})
+
+			Context("when the file does not exist", func() {
+				It("returns the error from scp", func() {
+					err := secureShell.CopyFromRemote("missing.log", localDir, false, nil)
+					Expect(err).To(MatchError(ContainSubstring("No such file or directory")))
+				})
+			})
+		})
+
+		Context("when scp sends a file name that would escape the target directory", func() {
+			BeforeEach(func() {
+				stdinPipe := &fake_io.FakeWriteCloser{}
+				stdinPipe.WriteStub = func(p []byte) (int, error) {
+					return len(p), nil
+				}
+				fakeSecureSession.StdinPipeReturns(stdinPipe, nil)
+				fakeSecureSession.StdoutPipeReturns(bytes.NewBufferString("C0644 4 ../evil\nevil\x00"), nil)
+				fakeSecureSession.StderrPipeReturns(new(bytes.Buffer), nil)
+			})
+
+			It("returns an error without writing the file", func() {
+				err := secureShell.CopyFromRemote("evil", filepath.Join(localDir, "target"), false, nil)
+				Expect(err).To(MatchError(`Invalid file name received from scp: "../evil"`))
+
+				_, err = os.Stat(filepath.Join(localDir, "target"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
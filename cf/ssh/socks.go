@@ -0,0 +1,138 @@
+package sshCmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// The parts of SOCKS5 (RFC 1928) used by dynamic port forwarding: clients
+// connect without authentication and can only ask for CONNECT.
+const (
+	socksVersion5 = 0x05
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyHostUnreachable     = 0x04
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+func (c *secureShell) handleSOCKSConnection(conn net.Conn) error {
+	targetAddr, err := readSOCKSRequest(conn)
+	if err != nil {
+		return err
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = writeSOCKSReply(conn, socksReplyHostUnreachable)
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return err
+	}
+	defer target.Close()
+
+	err = writeSOCKSReply(conn, socksReplySucceeded)
+	if err != nil {
+		return err
+	}
+
+	copyConnections(conn, target)
+	return nil
+}
+
+// readSOCKSRequest negotiates the authentication method with a SOCKS5 client
+// and returns the address of its CONNECT request.
+func readSOCKSRequest(conn io.ReadWriter) (string, error) {
+	greeting := make([]byte, 2)
+	_, err := io.ReadFull(conn, greeting)
+	if err != nil {
+		return "", err
+	}
+	if greeting[0] != socksVersion5 {
+		return "", fmt.Errorf("Unsupported SOCKS version: %d", greeting[0])
+	}
+
+	methods := make([]byte, greeting[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return "", err
+	}
+	if !bytes.Contains(methods, []byte{socksMethodNoAuth}) {
+		_, _ = conn.Write([]byte{socksVersion5, socksMethodNoAcceptable})
+		return "", errors.New("SOCKS client does not support connecting without authentication")
+	}
+
+	_, err = conn.Write([]byte{socksVersion5, socksMethodNoAuth})
+	if err != nil {
+		return "", err
+	}
+
+	// VER CMD RSV ATYP
+	request := make([]byte, 4)
+	_, err = io.ReadFull(conn, request)
+	if err != nil {
+		return "", err
+	}
+	if request[1] != socksCommandConnect {
+		_ = writeSOCKSReply(conn, socksReplyCommandNotSupported)
+		return "", fmt.Errorf("Unsupported SOCKS command: %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		_, err = io.ReadFull(conn, ip)
+		if err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		_, err = io.ReadFull(conn, length)
+		if err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		_, err = io.ReadFull(conn, domain)
+		if err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKSReply(conn, socksReplyAddressNotSupported)
+		return "", fmt.Errorf("Unsupported SOCKS address type: %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	_, err = io.ReadFull(conn, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// writeSOCKSReply replies to a CONNECT request. The bound address is not
+// meaningful for forwarded connections, so it is always 0.0.0.0:0.
+func writeSOCKSReply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion5, reply, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -37,7 +37,13 @@ const (
 type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
+	RunCommand(stdout io.Writer, stderr io.Writer) error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
+	Forwards() []ForwardStatus
+	CopyToRemote(localPath string, remotePath string, recursive bool, progress FileProgress) error
+	CopyFromRemote(remotePath string, localPath string, recursive bool, progress FileProgress) error
 	Wait() error
 	Close() error
 }
@@ -54,6 +60,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -89,8 +96,9 @@ type secureShell struct {
 	token                  string
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
+	stderr                 io.Writer
 
-	localListeners []net.Listener
+	forwards []*portForward
 }
 
 func NewSecureShell(
@@ -112,7 +120,7 @@ func NewSecureShell(
 		sshEndpointFingerprint: sshEndpointFingerprint,
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
-		localListeners:         []net.Listener{},
+		stderr:                 os.Stderr,
 	}
 }
 
@@ -141,9 +149,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 }
 
 func (c *secureShell) Close() error {
-	for _, listener := range c.localListeners {
-		_ = listener.Close()
-	}
+	c.closeForwards()
 	return c.secureClient.Close()
 }
 
@@ -153,49 +159,14 @@ func (c *secureShell) LocalPortForward() error {
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		forward := c.addForward(LocalForward, listener, forwardSpec.ListenAddress, forwardSpec.ConnectAddress)
+		go c.forwardAcceptLoop(forward, c.dialRemote(forwardSpec.ConnectAddress))
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
-	defer listener.Close()
-
-	for {
-		conn, err := listener.Accept()
-		if err != nil {
-			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
-				time.Sleep(100 * time.Millisecond)
-				continue
-			}
-			return
-		}
-
-		go c.handleForwardConnection(conn, addr)
-	}
-}
-
-func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
-	defer conn.Close()
-
-	target, err := c.secureClient.Dial("tcp", targetAddr)
-	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
-		return
-	}
-	defer target.Close()
-
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
-
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
-}
-
 func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	_, _ = io.Copy(dest, src)
 	_ = dest.Close()
@@ -313,13 +284,89 @@ func (c *secureShell) InteractiveSession() error {
 	return result
 }
 
+// RunCommand runs the command from the options without a terminal or any
+// input, and copies its output to stdout and stderr.
+func (c *secureShell) RunCommand(stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(c.opts.Command, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
+// Wait keeps the connection, and the port forwards using it, open until the
+// connection is closed or, when forwarding ports, an interrupt or termination
+// signal is received. On a signal the forwards stop accepting connections,
+// the open ones are given forwardShutdownTimeout, or until a second signal, to
+// finish, and ErrInterrupted is returned.
 func (c *secureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
 
 	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
 
-	return c.secureClient.Wait()
+	clientDone := make(chan error, 1)
+	go func() { clientDone <- c.secureClient.Wait() }()
+
+	if len(c.forwards) == 0 && !c.opts.SkipRemoteExecution {
+		return <-clientDone
+	}
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, shutdownSignals...)
+	defer signal.Stop(signals)
+
+	select {
+	case err := <-clientDone:
+		return err
+	case <-signals:
+	}
+
+	c.closeForwards()
+	if active := c.activeConnections(); active > 0 {
+		fmt.Fprintf(c.stderr, "Waiting for %d forwarded connections to close...\n", active)
+	}
+
+	stopWaiting := make(chan struct{})
+	defer close(stopWaiting)
+
+	select {
+	case <-c.connectionsClosed(stopWaiting):
+	case <-clientDone:
+	case <-signals:
+	case <-time.After(forwardShutdownTimeout):
+	}
+	return ErrInterrupted
 }
 
 func (c *secureShell) validateTarget(opts *options.SSHOptions) error {
@@ -477,6 +524,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -7,11 +7,13 @@
 package sshCmd_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -1165,6 +1167,83 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RunCommand", func() {
+		var (
+			opts           *options.SSHOptions
+			stdout, stderr *bytes.Buffer
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				Command: []string{"echo", "hello"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+
+			stdout = &bytes.Buffer{}
+			stderr = &bytes.Buffer{}
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("hello\n"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("warning\n"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			runErr = secureShell.RunCommand(stdout, stderr)
+		})
+
+		It("runs the command without a terminal or input and copies its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("echo hello"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+
+			Expect(stdout.String()).To(Equal("hello\n"))
+			Expect(stderr.String()).To(Equal("warning\n"))
+
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("exit error"))
+			})
+		})
+
+		Context("when the command fails to start", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("oh well"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("oh well"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when session allocation fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no channels"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: no channels"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
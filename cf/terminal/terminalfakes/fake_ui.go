@@ -115,6 +115,12 @@ type FakeUI struct {
 	writerReturns     struct {
 		result1 io.Writer
 	}
+	ReadLineStub        func() string
+	readLineMutex       sync.RWMutex
+	readLineArgsForCall []struct{}
+	readLineReturns     struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -568,6 +574,31 @@ func (fake *FakeUI) WriterReturns(result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeUI) ReadLine() string {
+	fake.readLineMutex.Lock()
+	fake.readLineArgsForCall = append(fake.readLineArgsForCall, struct{}{})
+	fake.recordInvocation("ReadLine", []interface{}{})
+	fake.readLineMutex.Unlock()
+	if fake.ReadLineStub != nil {
+		return fake.ReadLineStub()
+	} else {
+		return fake.readLineReturns.result1
+	}
+}
+
+func (fake *FakeUI) ReadLineCallCount() int {
+	fake.readLineMutex.RLock()
+	defer fake.readLineMutex.RUnlock()
+	return len(fake.readLineArgsForCall)
+}
+
+func (fake *FakeUI) ReadLineReturns(result1 string) {
+	fake.ReadLineStub = nil
+	fake.readLineReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -603,6 +634,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.notifyUpdateIfNeededMutex.RUnlock()
 	fake.writerMutex.RLock()
 	defer fake.writerMutex.RUnlock()
+	fake.readLineMutex.RLock()
+	defer fake.readLineMutex.RUnlock()
 	return fake.invocations
 }
 
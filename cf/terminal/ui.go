@@ -32,6 +32,7 @@ type UI interface {
 	Warn(message string, args ...interface{})
 	Ask(prompt string) (answer string)
 	AskForPassword(prompt string) (answer string)
+	ReadLine() (line string)
 	Confirm(message string) bool
 	ConfirmDelete(modelType, modelName string) bool
 	ConfirmDeleteWithAssociations(modelType, modelName string) bool
@@ -115,6 +116,15 @@ func (ui *terminalUI) Ask(prompt string) string {
 	return ""
 }
 
+func (ui *terminalUI) ReadLine() string {
+	rd := bufio.NewReader(ui.stdin)
+	line, err := rd.ReadString('\n')
+	if err != nil && line == "" {
+		return ""
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
 func (ui *terminalUI) ConfirmDeleteWithAssociations(modelType, modelName string) bool {
 	return ui.confirmDelete(T("Really delete the {{.ModelType}} {{.ModelName}} and everything associated with it?",
 		map[string]interface{}{
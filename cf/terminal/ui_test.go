@@ -112,6 +112,27 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("Reading a line from stdin", func() {
+		It("returns the first line without prompting and preserves whitespace", func() {
+			output := io_helpers.CaptureOutput(func() {
+				io_helpers.SimulateStdin(" secret value \r\nsecond line\n", func(reader io.Reader) {
+					ui := NewUI(reader, os.Stdout, NewTeePrinter(os.Stdout), fakeLogger)
+					Expect(ui.ReadLine()).To(Equal(" secret value "))
+				})
+			})
+			Expect(strings.Join(output, "")).To(BeEmpty())
+		})
+
+		It("returns the input when it has no trailing newline", func() {
+			_ = io_helpers.CaptureOutput(func() {
+				io_helpers.SimulateStdin("no newline", func(reader io.Reader) {
+					ui := NewUI(reader, os.Stdout, NewTeePrinter(os.Stdout), fakeLogger)
+					Expect(ui.ReadLine()).To(Equal("no newline"))
+				})
+			})
+		})
+	})
+
 	Describe("Confirming user input", func() {
 		It("treats 'y' as an affirmative confirmation", func() {
 			io_helpers.SimulateStdin("y\n", func(reader io.Reader) {
@@ -2,7 +2,9 @@ package trace
 
 import (
 	"fmt"
+	"os"
 	"regexp"
+	"strings"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 )
@@ -13,11 +15,18 @@ func Sanitize(input string) string {
 	re := regexp.MustCompile(`(?m)^Authorization: .*`)
 	sanitized := re.ReplaceAllString(input, "Authorization: "+PrivateDataPlaceholder())
 
-	re = regexp.MustCompile(`password=[^&]*&`)
-	sanitized = re.ReplaceAllString(sanitized, "password="+PrivateDataPlaceholder()+"&")
+	re = regexp.MustCompile(`(password|client_secret)=[^&\s]*`)
+	sanitized = re.ReplaceAllString(sanitized, "$1="+PrivateDataPlaceholder())
 
 	sanitized = sanitizeJSON("token", sanitized)
 	sanitized = sanitizeJSON("password", sanitized)
+	sanitized = sanitizeJSON("secret", sanitized)
+
+	for _, envVar := range []string{"CF_PASSWORD", "CF_CLIENT_SECRET"} {
+		if secret := os.Getenv(envVar); secret != "" {
+			sanitized = strings.Replace(sanitized, secret, PrivateDataPlaceholder(), -1)
+		}
+	}
 
 	return sanitized
 }
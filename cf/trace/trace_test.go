@@ -1,6 +1,8 @@
 package trace_test
 
 import (
+	"os"
+
 	. "code.cloudfoundry.org/cli/cf/trace"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,6 +56,12 @@ grant_type=password&password=[PRIVATE DATA HIDDEN]&scope=&username=mgehard%2Bcli
 				Expect(Sanitize(request)).To(Equal(expected))
 			})
 
+			It("hides passwords and client secrets at the end of query args", func() {
+				request := "grant_type=client_credentials&client_secret=my-secret\nusername=user&password=my-password\n"
+				expected := "grant_type=client_credentials&client_secret=[PRIVATE DATA HIDDEN]\nusername=user&password=[PRIVATE DATA HIDDEN]\n"
+				Expect(Sanitize(request)).To(Equal(expected))
+			})
+
 			It("hides passwords in the JSON-formatted request body", func() {
 				request := `
 REQUEST: [2014-03-07T10:53:36-08:00]
@@ -228,5 +236,23 @@ Content-Type: application/json;charset=utf-8
 				Expect(Sanitize(response)).To(Equal(expected))
 			})
 		})
+
+		Describe("hiding credentials from the environment", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_PASSWORD", "env-password")
+				os.Setenv("CF_CLIENT_SECRET", "env-client-secret")
+			})
+
+			AfterEach(func() {
+				os.Unsetenv("CF_PASSWORD")
+				os.Unsetenv("CF_CLIENT_SECRET")
+			})
+
+			It("hides the values of CF_PASSWORD and CF_CLIENT_SECRET wherever they appear", func() {
+				response := "Location: https://example.com/?x=env-password\nbody containing env-client-secret\n"
+				expected := "Location: https://example.com/?x=[PRIVATE DATA HIDDEN]\nbody containing [PRIVATE DATA HIDDEN]\n"
+				Expect(Sanitize(response)).To(Equal(expected))
+			})
+		})
 	})
 })
@@ -19,6 +19,15 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ActiveContextStub        func() string
+	activeContextMutex       sync.RWMutex
+	activeContextArgsForCall []struct{}
+	activeContextReturns     struct {
+		result1 string
+	}
+	activeContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AddPluginStub        func(configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
@@ -57,6 +66,42 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CFClientIDStub        func() string
+	cFClientIDMutex       sync.RWMutex
+	cFClientIDArgsForCall []struct{}
+	cFClientIDReturns     struct {
+		result1 string
+	}
+	cFClientIDReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CFClientSecretStub        func() string
+	cFClientSecretMutex       sync.RWMutex
+	cFClientSecretArgsForCall []struct{}
+	cFClientSecretReturns     struct {
+		result1 string
+	}
+	cFClientSecretReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CFPasswordStub        func() string
+	cFPasswordMutex       sync.RWMutex
+	cFPasswordArgsForCall []struct{}
+	cFPasswordReturns     struct {
+		result1 string
+	}
+	cFPasswordReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CFUsernameStub        func() string
+	cFUsernameMutex       sync.RWMutex
+	cFUsernameArgsForCall []struct{}
+	cFUsernameReturns     struct {
+		result1 string
+	}
+	cFUsernameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct{}
@@ -66,6 +111,26 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextsStub        func() []configv3.TargetContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct{}
+	contextsReturns     struct {
+		result1 []configv3.TargetContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 []configv3.TargetContext
+	}
+	CreateContextStub        func(name string) error
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		name string
+	}
+	createContextReturns struct {
+		result1 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -77,6 +142,17 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	DeleteContextStub        func(name string) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		name string
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -86,6 +162,15 @@ type FakeConfig struct {
 	dialTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	DockerPasswordStub        func() string
+	dockerPasswordMutex       sync.RWMutex
+	dockerPasswordArgsForCall []struct{}
+	dockerPasswordReturns     struct {
+		result1 string
+	}
+	dockerPasswordReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ExperimentalStub        func() bool
 	experimentalMutex       sync.RWMutex
 	experimentalArgsForCall []struct{}
@@ -139,6 +224,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HTTPCacheEnabledStub        func() bool
+	hTTPCacheEnabledMutex       sync.RWMutex
+	hTTPCacheEnabledArgsForCall []struct{}
+	hTTPCacheEnabledReturns     struct {
+		result1 bool
+	}
+	hTTPCacheEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -148,6 +242,15 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MaxRequestsPerSecondStub        func() int
+	maxRequestsPerSecondMutex       sync.RWMutex
+	maxRequestsPerSecondArgsForCall []struct{}
+	maxRequestsPerSecondReturns     struct {
+		result1 int
+	}
+	maxRequestsPerSecondReturnsOnCall map[int]struct {
+		result1 int
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct{}
@@ -202,6 +305,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RecordPathStub        func() string
+	recordPathMutex       sync.RWMutex
+	recordPathArgsForCall []struct{}
+	recordPathReturns     struct {
+		result1 string
+	}
+	recordPathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -216,6 +328,54 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RenameContextStub        func(oldName string, newName string) error
+	renameContextMutex       sync.RWMutex
+	renameContextArgsForCall []struct {
+		oldName string
+		newName string
+	}
+	renameContextReturns struct {
+		result1 error
+	}
+	renameContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplayPathStub        func() string
+	replayPathMutex       sync.RWMutex
+	replayPathArgsForCall []struct{}
+	replayPathReturns     struct {
+		result1 string
+	}
+	replayPathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RetryBackoffStub        func() time.Duration
+	retryBackoffMutex       sync.RWMutex
+	retryBackoffArgsForCall []struct{}
+	retryBackoffReturns     struct {
+		result1 time.Duration
+	}
+	retryBackoffReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	RetryMaxStub        func() int
+	retryMaxMutex       sync.RWMutex
+	retryMaxArgsForCall []struct{}
+	retryMaxReturns     struct {
+		result1 int
+	}
+	retryMaxReturnsOnCall map[int]struct {
+		result1 int
+	}
+	SaveCredentialsStub        func() error
+	saveCredentialsMutex       sync.RWMutex
+	saveCredentialsArgsForCall []struct{}
+	saveCredentialsReturns     struct {
+		result1 error
+	}
+	saveCredentialsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -258,6 +418,17 @@ type FakeConfig struct {
 		refreshToken   string
 		sshOAuthClient string
 	}
+	SetUAAClientCredentialsStub        func(client string, clientSecret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client       string
+		clientSecret string
+	}
+	SetUAAGrantTypeStub        func(grantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		grantType string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -312,6 +483,24 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TokenRefreshSkewStub        func() time.Duration
+	tokenRefreshSkewMutex       sync.RWMutex
+	tokenRefreshSkewArgsForCall []struct{}
+	tokenRefreshSkewReturns     struct {
+		result1 time.Duration
+	}
+	tokenRefreshSkewReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
+	uAAGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientStub        func() string
 	uAAOAuthClientMutex       sync.RWMutex
 	uAAOAuthClientArgsForCall []struct{}
@@ -336,10 +525,24 @@ type FakeConfig struct {
 	UnsetSpaceInformationStub               func()
 	unsetSpaceInformationMutex              sync.RWMutex
 	unsetSpaceInformationArgsForCall        []struct{}
-	VerboseStub                             func() (bool, []string)
-	verboseMutex                            sync.RWMutex
-	verboseArgsForCall                      []struct{}
-	verboseReturns                          struct {
+	UnsetUAAClientCredentialsStub           func()
+	unsetUAAClientCredentialsMutex          sync.RWMutex
+	unsetUAAClientCredentialsArgsForCall    []struct{}
+	UseContextStub                          func(name string) error
+	useContextMutex                         sync.RWMutex
+	useContextArgsForCall                   []struct {
+		name string
+	}
+	useContextReturns struct {
+		result1 error
+	}
+	useContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
 		result1 bool
 		result2 []string
 	}
@@ -400,6 +603,46 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ActiveContext() string {
+	fake.activeContextMutex.Lock()
+	ret, specificReturn := fake.activeContextReturnsOnCall[len(fake.activeContextArgsForCall)]
+	fake.activeContextArgsForCall = append(fake.activeContextArgsForCall, struct{}{})
+	fake.recordInvocation("ActiveContext", []interface{}{})
+	fake.activeContextMutex.Unlock()
+	if fake.ActiveContextStub != nil {
+		return fake.ActiveContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.activeContextReturns.result1
+}
+
+func (fake *FakeConfig) ActiveContextCallCount() int {
+	fake.activeContextMutex.RLock()
+	defer fake.activeContextMutex.RUnlock()
+	return len(fake.activeContextArgsForCall)
+}
+
+func (fake *FakeConfig) ActiveContextReturns(result1 string) {
+	fake.ActiveContextStub = nil
+	fake.activeContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ActiveContextReturnsOnCall(i int, result1 string) {
+	fake.ActiveContextStub = nil
+	if fake.activeContextReturnsOnCall == nil {
+		fake.activeContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.activeContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginMutex.Lock()
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
@@ -569,6 +812,166 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CFClientID() string {
+	fake.cFClientIDMutex.Lock()
+	ret, specificReturn := fake.cFClientIDReturnsOnCall[len(fake.cFClientIDArgsForCall)]
+	fake.cFClientIDArgsForCall = append(fake.cFClientIDArgsForCall, struct{}{})
+	fake.recordInvocation("CFClientID", []interface{}{})
+	fake.cFClientIDMutex.Unlock()
+	if fake.CFClientIDStub != nil {
+		return fake.CFClientIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cFClientIDReturns.result1
+}
+
+func (fake *FakeConfig) CFClientIDCallCount() int {
+	fake.cFClientIDMutex.RLock()
+	defer fake.cFClientIDMutex.RUnlock()
+	return len(fake.cFClientIDArgsForCall)
+}
+
+func (fake *FakeConfig) CFClientIDReturns(result1 string) {
+	fake.CFClientIDStub = nil
+	fake.cFClientIDReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFClientIDReturnsOnCall(i int, result1 string) {
+	fake.CFClientIDStub = nil
+	if fake.cFClientIDReturnsOnCall == nil {
+		fake.cFClientIDReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cFClientIDReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFClientSecret() string {
+	fake.cFClientSecretMutex.Lock()
+	ret, specificReturn := fake.cFClientSecretReturnsOnCall[len(fake.cFClientSecretArgsForCall)]
+	fake.cFClientSecretArgsForCall = append(fake.cFClientSecretArgsForCall, struct{}{})
+	fake.recordInvocation("CFClientSecret", []interface{}{})
+	fake.cFClientSecretMutex.Unlock()
+	if fake.CFClientSecretStub != nil {
+		return fake.CFClientSecretStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cFClientSecretReturns.result1
+}
+
+func (fake *FakeConfig) CFClientSecretCallCount() int {
+	fake.cFClientSecretMutex.RLock()
+	defer fake.cFClientSecretMutex.RUnlock()
+	return len(fake.cFClientSecretArgsForCall)
+}
+
+func (fake *FakeConfig) CFClientSecretReturns(result1 string) {
+	fake.CFClientSecretStub = nil
+	fake.cFClientSecretReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFClientSecretReturnsOnCall(i int, result1 string) {
+	fake.CFClientSecretStub = nil
+	if fake.cFClientSecretReturnsOnCall == nil {
+		fake.cFClientSecretReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cFClientSecretReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFPassword() string {
+	fake.cFPasswordMutex.Lock()
+	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
+	fake.cFPasswordArgsForCall = append(fake.cFPasswordArgsForCall, struct{}{})
+	fake.recordInvocation("CFPassword", []interface{}{})
+	fake.cFPasswordMutex.Unlock()
+	if fake.CFPasswordStub != nil {
+		return fake.CFPasswordStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cFPasswordReturns.result1
+}
+
+func (fake *FakeConfig) CFPasswordCallCount() int {
+	fake.cFPasswordMutex.RLock()
+	defer fake.cFPasswordMutex.RUnlock()
+	return len(fake.cFPasswordArgsForCall)
+}
+
+func (fake *FakeConfig) CFPasswordReturns(result1 string) {
+	fake.CFPasswordStub = nil
+	fake.cFPasswordReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFPasswordReturnsOnCall(i int, result1 string) {
+	fake.CFPasswordStub = nil
+	if fake.cFPasswordReturnsOnCall == nil {
+		fake.cFPasswordReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cFPasswordReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFUsername() string {
+	fake.cFUsernameMutex.Lock()
+	ret, specificReturn := fake.cFUsernameReturnsOnCall[len(fake.cFUsernameArgsForCall)]
+	fake.cFUsernameArgsForCall = append(fake.cFUsernameArgsForCall, struct{}{})
+	fake.recordInvocation("CFUsername", []interface{}{})
+	fake.cFUsernameMutex.Unlock()
+	if fake.CFUsernameStub != nil {
+		return fake.CFUsernameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cFUsernameReturns.result1
+}
+
+func (fake *FakeConfig) CFUsernameCallCount() int {
+	fake.cFUsernameMutex.RLock()
+	defer fake.cFUsernameMutex.RUnlock()
+	return len(fake.cFUsernameArgsForCall)
+}
+
+func (fake *FakeConfig) CFUsernameReturns(result1 string) {
+	fake.CFUsernameStub = nil
+	fake.cFUsernameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
+	fake.CFUsernameStub = nil
+	if fake.cFUsernameReturnsOnCall == nil {
+		fake.cFUsernameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cFUsernameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -609,6 +1012,94 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Contexts() []configv3.TargetContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct{}{})
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if fake.ContextsStub != nil {
+		return fake.ContextsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.contextsReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 []configv3.TargetContext) {
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 []configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 []configv3.TargetContext) {
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TargetContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 []configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContext(name string) error {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("CreateContext", []interface{}{name})
+	fake.createContextMutex.Unlock()
+	if fake.CreateContextStub != nil {
+		return fake.CreateContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.createContextReturns.result1
+}
+
+func (fake *FakeConfig) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeConfig) CreateContextArgsForCall(i int) string {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return fake.createContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) CreateContextReturns(result1 error) {
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContextReturnsOnCall(i int, result1 error) {
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -652,6 +1143,54 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteContext(name string) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteContext", []interface{}{name})
+	fake.deleteContextMutex.Unlock()
+	if fake.DeleteContextStub != nil {
+		return fake.DeleteContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteContextReturns.result1
+}
+
+func (fake *FakeConfig) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteContextArgsForCall(i int) string {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return fake.deleteContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) DeleteContextReturns(result1 error) {
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -692,6 +1231,46 @@ func (fake *FakeConfig) DialTimeoutReturnsOnCall(i int, result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) DockerPassword() string {
+	fake.dockerPasswordMutex.Lock()
+	ret, specificReturn := fake.dockerPasswordReturnsOnCall[len(fake.dockerPasswordArgsForCall)]
+	fake.dockerPasswordArgsForCall = append(fake.dockerPasswordArgsForCall, struct{}{})
+	fake.recordInvocation("DockerPassword", []interface{}{})
+	fake.dockerPasswordMutex.Unlock()
+	if fake.DockerPasswordStub != nil {
+		return fake.DockerPasswordStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dockerPasswordReturns.result1
+}
+
+func (fake *FakeConfig) DockerPasswordCallCount() int {
+	fake.dockerPasswordMutex.RLock()
+	defer fake.dockerPasswordMutex.RUnlock()
+	return len(fake.dockerPasswordArgsForCall)
+}
+
+func (fake *FakeConfig) DockerPasswordReturns(result1 string) {
+	fake.DockerPasswordStub = nil
+	fake.dockerPasswordReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DockerPasswordReturnsOnCall(i int, result1 string) {
+	fake.DockerPasswordStub = nil
+	if fake.dockerPasswordReturnsOnCall == nil {
+		fake.dockerPasswordReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.dockerPasswordReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Experimental() bool {
 	fake.experimentalMutex.Lock()
 	ret, specificReturn := fake.experimentalReturnsOnCall[len(fake.experimentalArgsForCall)]
@@ -914,6 +1493,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HTTPCacheEnabled() bool {
+	fake.hTTPCacheEnabledMutex.Lock()
+	ret, specificReturn := fake.hTTPCacheEnabledReturnsOnCall[len(fake.hTTPCacheEnabledArgsForCall)]
+	fake.hTTPCacheEnabledArgsForCall = append(fake.hTTPCacheEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPCacheEnabled", []interface{}{})
+	fake.hTTPCacheEnabledMutex.Unlock()
+	if fake.HTTPCacheEnabledStub != nil {
+		return fake.HTTPCacheEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hTTPCacheEnabledReturns.result1
+}
+
+func (fake *FakeConfig) HTTPCacheEnabledCallCount() int {
+	fake.hTTPCacheEnabledMutex.RLock()
+	defer fake.hTTPCacheEnabledMutex.RUnlock()
+	return len(fake.hTTPCacheEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPCacheEnabledReturns(result1 bool) {
+	fake.HTTPCacheEnabledStub = nil
+	fake.hTTPCacheEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPCacheEnabledReturnsOnCall(i int, result1 bool) {
+	fake.HTTPCacheEnabledStub = nil
+	if fake.hTTPCacheEnabledReturnsOnCall == nil {
+		fake.hTTPCacheEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hTTPCacheEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -954,6 +1573,46 @@ func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) MaxRequestsPerSecond() int {
+	fake.maxRequestsPerSecondMutex.Lock()
+	ret, specificReturn := fake.maxRequestsPerSecondReturnsOnCall[len(fake.maxRequestsPerSecondArgsForCall)]
+	fake.maxRequestsPerSecondArgsForCall = append(fake.maxRequestsPerSecondArgsForCall, struct{}{})
+	fake.recordInvocation("MaxRequestsPerSecond", []interface{}{})
+	fake.maxRequestsPerSecondMutex.Unlock()
+	if fake.MaxRequestsPerSecondStub != nil {
+		return fake.MaxRequestsPerSecondStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.maxRequestsPerSecondReturns.result1
+}
+
+func (fake *FakeConfig) MaxRequestsPerSecondCallCount() int {
+	fake.maxRequestsPerSecondMutex.RLock()
+	defer fake.maxRequestsPerSecondMutex.RUnlock()
+	return len(fake.maxRequestsPerSecondArgsForCall)
+}
+
+func (fake *FakeConfig) MaxRequestsPerSecondReturns(result1 int) {
+	fake.MaxRequestsPerSecondStub = nil
+	fake.maxRequestsPerSecondReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) MaxRequestsPerSecondReturnsOnCall(i int, result1 int) {
+	fake.MaxRequestsPerSecondStub = nil
+	if fake.maxRequestsPerSecondReturnsOnCall == nil {
+		fake.maxRequestsPerSecondReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxRequestsPerSecondReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
@@ -1194,6 +1853,46 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RecordPath() string {
+	fake.recordPathMutex.Lock()
+	ret, specificReturn := fake.recordPathReturnsOnCall[len(fake.recordPathArgsForCall)]
+	fake.recordPathArgsForCall = append(fake.recordPathArgsForCall, struct{}{})
+	fake.recordInvocation("RecordPath", []interface{}{})
+	fake.recordPathMutex.Unlock()
+	if fake.RecordPathStub != nil {
+		return fake.RecordPathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordPathReturns.result1
+}
+
+func (fake *FakeConfig) RecordPathCallCount() int {
+	fake.recordPathMutex.RLock()
+	defer fake.recordPathMutex.RUnlock()
+	return len(fake.recordPathArgsForCall)
+}
+
+func (fake *FakeConfig) RecordPathReturns(result1 string) {
+	fake.RecordPathStub = nil
+	fake.recordPathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordPathReturnsOnCall(i int, result1 string) {
+	fake.RecordPathStub = nil
+	if fake.recordPathReturnsOnCall == nil {
+		fake.recordPathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.recordPathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1258,6 +1957,215 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RenameContext(oldName string, newName string) error {
+	fake.renameContextMutex.Lock()
+	ret, specificReturn := fake.renameContextReturnsOnCall[len(fake.renameContextArgsForCall)]
+	fake.renameContextArgsForCall = append(fake.renameContextArgsForCall, struct {
+		oldName string
+		newName string
+	}{oldName, newName})
+	fake.recordInvocation("RenameContext", []interface{}{oldName, newName})
+	fake.renameContextMutex.Unlock()
+	if fake.RenameContextStub != nil {
+		return fake.RenameContextStub(oldName, newName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.renameContextReturns.result1
+}
+
+func (fake *FakeConfig) RenameContextCallCount() int {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return len(fake.renameContextArgsForCall)
+}
+
+func (fake *FakeConfig) RenameContextArgsForCall(i int) (string, string) {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return fake.renameContextArgsForCall[i].oldName, fake.renameContextArgsForCall[i].newName
+}
+
+func (fake *FakeConfig) RenameContextReturns(result1 error) {
+	fake.RenameContextStub = nil
+	fake.renameContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RenameContextReturnsOnCall(i int, result1 error) {
+	fake.RenameContextStub = nil
+	if fake.renameContextReturnsOnCall == nil {
+		fake.renameContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.renameContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayPath() string {
+	fake.replayPathMutex.Lock()
+	ret, specificReturn := fake.replayPathReturnsOnCall[len(fake.replayPathArgsForCall)]
+	fake.replayPathArgsForCall = append(fake.replayPathArgsForCall, struct{}{})
+	fake.recordInvocation("ReplayPath", []interface{}{})
+	fake.replayPathMutex.Unlock()
+	if fake.ReplayPathStub != nil {
+		return fake.ReplayPathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.replayPathReturns.result1
+}
+
+func (fake *FakeConfig) ReplayPathCallCount() int {
+	fake.replayPathMutex.RLock()
+	defer fake.replayPathMutex.RUnlock()
+	return len(fake.replayPathArgsForCall)
+}
+
+func (fake *FakeConfig) ReplayPathReturns(result1 string) {
+	fake.ReplayPathStub = nil
+	fake.replayPathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayPathReturnsOnCall(i int, result1 string) {
+	fake.ReplayPathStub = nil
+	if fake.replayPathReturnsOnCall == nil {
+		fake.replayPathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.replayPathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryBackoff() time.Duration {
+	fake.retryBackoffMutex.Lock()
+	ret, specificReturn := fake.retryBackoffReturnsOnCall[len(fake.retryBackoffArgsForCall)]
+	fake.retryBackoffArgsForCall = append(fake.retryBackoffArgsForCall, struct{}{})
+	fake.recordInvocation("RetryBackoff", []interface{}{})
+	fake.retryBackoffMutex.Unlock()
+	if fake.RetryBackoffStub != nil {
+		return fake.RetryBackoffStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryBackoffReturns.result1
+}
+
+func (fake *FakeConfig) RetryBackoffCallCount() int {
+	fake.retryBackoffMutex.RLock()
+	defer fake.retryBackoffMutex.RUnlock()
+	return len(fake.retryBackoffArgsForCall)
+}
+
+func (fake *FakeConfig) RetryBackoffReturns(result1 time.Duration) {
+	fake.RetryBackoffStub = nil
+	fake.retryBackoffReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryBackoffReturnsOnCall(i int, result1 time.Duration) {
+	fake.RetryBackoffStub = nil
+	if fake.retryBackoffReturnsOnCall == nil {
+		fake.retryBackoffReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.retryBackoffReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMax() int {
+	fake.retryMaxMutex.Lock()
+	ret, specificReturn := fake.retryMaxReturnsOnCall[len(fake.retryMaxArgsForCall)]
+	fake.retryMaxArgsForCall = append(fake.retryMaxArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMax", []interface{}{})
+	fake.retryMaxMutex.Unlock()
+	if fake.RetryMaxStub != nil {
+		return fake.RetryMaxStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryMaxReturns.result1
+}
+
+func (fake *FakeConfig) RetryMaxCallCount() int {
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	return len(fake.retryMaxArgsForCall)
+}
+
+func (fake *FakeConfig) RetryMaxReturns(result1 int) {
+	fake.RetryMaxStub = nil
+	fake.retryMaxReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMaxReturnsOnCall(i int, result1 int) {
+	fake.RetryMaxStub = nil
+	if fake.retryMaxReturnsOnCall == nil {
+		fake.retryMaxReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.retryMaxReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveCredentials() error {
+	fake.saveCredentialsMutex.Lock()
+	ret, specificReturn := fake.saveCredentialsReturnsOnCall[len(fake.saveCredentialsArgsForCall)]
+	fake.saveCredentialsArgsForCall = append(fake.saveCredentialsArgsForCall, struct{}{})
+	fake.recordInvocation("SaveCredentials", []interface{}{})
+	fake.saveCredentialsMutex.Unlock()
+	if fake.SaveCredentialsStub != nil {
+		return fake.SaveCredentialsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveCredentialsReturns.result1
+}
+
+func (fake *FakeConfig) SaveCredentialsCallCount() int {
+	fake.saveCredentialsMutex.RLock()
+	defer fake.saveCredentialsMutex.RUnlock()
+	return len(fake.saveCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SaveCredentialsReturns(result1 error) {
+	fake.SaveCredentialsStub = nil
+	fake.saveCredentialsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveCredentialsReturnsOnCall(i int, result1 error) {
+	fake.SaveCredentialsStub = nil
+	if fake.saveCredentialsReturnsOnCall == nil {
+		fake.saveCredentialsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCredentialsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1414,6 +2322,55 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return fake.setTokenInformationArgsForCall[i].accessToken, fake.setTokenInformationArgsForCall[i].refreshToken, fake.setTokenInformationArgsForCall[i].sshOAuthClient
 }
 
+func (fake *FakeConfig) SetUAAClientCredentials(client string, clientSecret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client       string
+		clientSecret string
+	}{client, clientSecret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, clientSecret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, clientSecret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].clientSecret
+}
+
+func (fake *FakeConfig) SetUAAGrantType(grantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		grantType string
+	}{grantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{grantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(grantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].grantType
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -1654,6 +2611,86 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TokenRefreshSkew() time.Duration {
+	fake.tokenRefreshSkewMutex.Lock()
+	ret, specificReturn := fake.tokenRefreshSkewReturnsOnCall[len(fake.tokenRefreshSkewArgsForCall)]
+	fake.tokenRefreshSkewArgsForCall = append(fake.tokenRefreshSkewArgsForCall, struct{}{})
+	fake.recordInvocation("TokenRefreshSkew", []interface{}{})
+	fake.tokenRefreshSkewMutex.Unlock()
+	if fake.TokenRefreshSkewStub != nil {
+		return fake.TokenRefreshSkewStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.tokenRefreshSkewReturns.result1
+}
+
+func (fake *FakeConfig) TokenRefreshSkewCallCount() int {
+	fake.tokenRefreshSkewMutex.RLock()
+	defer fake.tokenRefreshSkewMutex.RUnlock()
+	return len(fake.tokenRefreshSkewArgsForCall)
+}
+
+func (fake *FakeConfig) TokenRefreshSkewReturns(result1 time.Duration) {
+	fake.TokenRefreshSkewStub = nil
+	fake.tokenRefreshSkewReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) TokenRefreshSkewReturnsOnCall(i int, result1 time.Duration) {
+	fake.TokenRefreshSkewStub = nil
+	if fake.tokenRefreshSkewReturnsOnCall == nil {
+		fake.tokenRefreshSkewReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.tokenRefreshSkewReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uAAGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uAAGrantTypeReturnsOnCall == nil {
+		fake.uAAGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClient() string {
 	fake.uAAOAuthClientMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientReturnsOnCall[len(fake.uAAOAuthClientArgsForCall)]
@@ -1766,6 +2803,70 @@ func (fake *FakeConfig) UnsetSpaceInformationCallCount() int {
 	return len(fake.unsetSpaceInformationArgsForCall)
 }
 
+func (fake *FakeConfig) UnsetUAAClientCredentials() {
+	fake.unsetUAAClientCredentialsMutex.Lock()
+	fake.unsetUAAClientCredentialsArgsForCall = append(fake.unsetUAAClientCredentialsArgsForCall, struct{}{})
+	fake.recordInvocation("UnsetUAAClientCredentials", []interface{}{})
+	fake.unsetUAAClientCredentialsMutex.Unlock()
+	if fake.UnsetUAAClientCredentialsStub != nil {
+		fake.UnsetUAAClientCredentialsStub()
+	}
+}
+
+func (fake *FakeConfig) UnsetUAAClientCredentialsCallCount() int {
+	fake.unsetUAAClientCredentialsMutex.RLock()
+	defer fake.unsetUAAClientCredentialsMutex.RUnlock()
+	return len(fake.unsetUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) UseContext(name string) error {
+	fake.useContextMutex.Lock()
+	ret, specificReturn := fake.useContextReturnsOnCall[len(fake.useContextArgsForCall)]
+	fake.useContextArgsForCall = append(fake.useContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("UseContext", []interface{}{name})
+	fake.useContextMutex.Unlock()
+	if fake.UseContextStub != nil {
+		return fake.UseContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.useContextReturns.result1
+}
+
+func (fake *FakeConfig) UseContextCallCount() int {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return len(fake.useContextArgsForCall)
+}
+
+func (fake *FakeConfig) UseContextArgsForCall(i int) string {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return fake.useContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) UseContextReturns(result1 error) {
+	fake.UseContextStub = nil
+	fake.useContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) UseContextReturnsOnCall(i int, result1 error) {
+	fake.UseContextStub = nil
+	if fake.useContextReturnsOnCall == nil {
+		fake.useContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -1854,6 +2955,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.activeContextMutex.RLock()
+	defer fake.activeContextMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
@@ -1864,12 +2967,28 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cFClientIDMutex.RLock()
+	defer fake.cFClientIDMutex.RUnlock()
+	fake.cFClientSecretMutex.RLock()
+	defer fake.cFClientSecretMutex.RUnlock()
+	fake.cFPasswordMutex.RLock()
+	defer fake.cFPasswordMutex.RUnlock()
+	fake.cFUsernameMutex.RLock()
+	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
+	fake.dockerPasswordMutex.RLock()
+	defer fake.dockerPasswordMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
 	fake.getPluginMutex.RLock()
@@ -1880,8 +2999,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.hTTPCacheEnabledMutex.RLock()
+	defer fake.hTTPCacheEnabledMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.maxRequestsPerSecondMutex.RLock()
+	defer fake.maxRequestsPerSecondMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
@@ -1894,10 +3017,22 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.recordPathMutex.RLock()
+	defer fake.recordPathMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	fake.replayPathMutex.RLock()
+	defer fake.replayPathMutex.RUnlock()
+	fake.retryBackoffMutex.RLock()
+	defer fake.retryBackoffMutex.RUnlock()
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	fake.saveCredentialsMutex.RLock()
+	defer fake.saveCredentialsMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -1910,6 +3045,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
@@ -1922,6 +3061,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.tokenRefreshSkewMutex.RLock()
+	defer fake.tokenRefreshSkewMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
@@ -1930,6 +3073,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetOrganizationInformationMutex.RUnlock()
 	fake.unsetSpaceInformationMutex.RLock()
 	defer fake.unsetSpaceInformationMutex.RUnlock()
+	fake.unsetUAAClientCredentialsMutex.RLock()
+	defer fake.unsetUAAClientCredentialsMutex.RUnlock()
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
@@ -11,7 +11,11 @@ import (
 var Commands commandList
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	Context          string `long:"context" description:"Run the command against the named context"`
+	NoCache          bool   `long:"no-cache" description:"Do not use the local cache of Cloud Controller responses"`
+	OutputFormat     string `long:"output" choice:"table" choice:"json" choice:"yaml" description:"Display command results as a table, or as a JSON or YAML document"`
+	Timings          string `long:"timings" optional:"yes" optional-value:"table" choice:"table" choice:"json" description:"Print where the time was spent when the command finishes, as a table or a JSON document"`
 
 	V2Push v2.V2PushCommand `command:"v2-push" description:"Push a new app or sync changes to an existing app"`
 
@@ -40,11 +44,14 @@ type commandList struct {
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	Cache                              v2.CacheCommand                              `command:"cache" description:"Clear the local cache of Cloud Controller responses"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v2.ContextsCommand                           `command:"contexts" description:"List all contexts"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v2.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
+	CreateContext                      v2.CreateContextCommand                      `command:"create-context" description:"Save the current target and session as a named context"`
 	CreateDomain                       v2.CreateDomainCommand                       `command:"create-domain" description:"Create a domain in an org for later use"`
 	CreateIsolationSegment             v3.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v2.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
@@ -62,6 +69,7 @@ type commandList struct {
 	CreateUser                         v2.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
 	Curl                               v2.CurlCommand                               `command:"curl" description:"Executes a request to the targeted API endpoint"`
 	DeleteBuildpack                    v2.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
+	DeleteContext                      v2.DeleteContextCommand                      `command:"delete-context" description:"Delete a context"`
 	DeleteDomain                       v2.DeleteDomainCommand                       `command:"delete-domain" description:"Delete a domain"`
 	DeleteIsolationSegment             v3.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v2.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
@@ -113,10 +121,12 @@ type commandList struct {
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v2.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
+	PushCache                          v2.PushCacheCommand                          `command:"push-cache" description:"Show or prune the local cache of pushed files"`
 	Quotas                             v2.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v2.QuotaCommand                              `command:"quota" description:"Show quota info"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	RenameBuildpack                    v2.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
+	RenameContext                      v2.RenameContextCommand                      `command:"rename-context" description:"Rename a context"`
 	RenameOrg                          v2.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameServiceBroker                v2.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
 	RenameService                      v2.RenameServiceCommand                      `command:"rename-service" description:"Rename a service instance"`
@@ -134,6 +144,7 @@ type commandList struct {
 	RunningSecurityGroups              v2.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups in the set of security groups for running applications"`
 	RunTask                            v3.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	Scale                              v2.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	SCP                                v2.SCPCommand                                `command:"scp" description:"Copy files to or from an application container instance"`
 	SecurityGroups                     v2.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	SecurityGroup                      v2.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
 	ServiceAccess                      v2.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
@@ -161,6 +172,7 @@ type commandList struct {
 	SpaceUsers                         v2.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	Space                              v2.SpaceCommand                              `command:"space" description:"Show space info"`
 	SSHCode                            v2.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
+	SSHConfig                          v2.SSHConfigCommand                          `command:"ssh-config" description:"Print OpenSSH client configuration for application container instances"`
 	SSHEnabled                         v2.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
 	SSH                                v2.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	Stacks                             v2.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
@@ -192,6 +204,7 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UseContext                         v2.UseContextCommand                         `command:"use-context" description:"Switch the current target and session to a named context"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -228,3 +241,34 @@ func (c commandList) HasAlias(alias string) bool {
 
 	return found
 }
+
+// structuredOutputCommands are the commands that can display their results as
+// a JSON or YAML document with the --output flag.
+var structuredOutputCommands = []string{
+	"app",
+	"apps",
+	"isolation-segments",
+	"org",
+	"security-groups",
+	"space",
+	"tasks",
+	"v3-apps",
+}
+
+// StructuredOutputCommands returns the names of the commands that support
+// --output json and --output yaml.
+func (c commandList) StructuredOutputCommands() []string {
+	return structuredOutputCommands
+}
+
+// HasStructuredOutput returns true if the named command supports --output
+// json and --output yaml.
+func (c commandList) HasStructuredOutput(name string) bool {
+	for _, command := range structuredOutputCommands {
+		if command == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -46,4 +46,18 @@ var _ = Describe("commandList", func() {
 			})
 		})
 	})
+
+	Describe("HasStructuredOutput", func() {
+		Context("when the command supports --output", func() {
+			It("returns true", func() {
+				Expect(Commands.HasStructuredOutput("apps")).To(BeTrue())
+			})
+		})
+
+		Context("when the command does not support --output", func() {
+			It("returns false", func() {
+				Expect(Commands.HasStructuredOutput("push")).To(BeFalse())
+			})
+		})
+	})
 })
@@ -297,19 +297,28 @@ func (cmd HelpCommand) displayCommand() error {
 
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
+		{"CF_CACHE=true", cmd.UI.TranslateText("Cache responses for resources that rarely change, such as stacks and shared domains")},
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CONTEXT=name", cmd.UI.TranslateText("Run commands against the named context")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RECORD=path/to/cassette", cmd.UI.TranslateText("Record API requests and responses to a file, with credentials redacted")},
+		{"CF_REPLAY=path/to/cassette", cmd.UI.TranslateText("Respond to API requests from a recorded file instead of the network")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_EXPORT=path/or/url", cmd.UI.TranslateText("Export timing spans for the command and its API requests to a file or OTLP endpoint")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
 	}
 }
 
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
+		{"--context", cmd.UI.TranslateText("Run the command against the named context")},
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-cache", cmd.UI.TranslateText("Do not use the local cache of Cloud Controller responses")},
+		{"--output", cmd.UI.TranslateText("Display command results as a table, or as a JSON or YAML document (json, yaml)")},
+		{"--timings", cmd.UI.TranslateText("Print a breakdown of where the time went to stderr when the command finishes (--timings=json for JSON)")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
@@ -411,7 +411,10 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("  install-plugin    list-plugin-repos"))
 
 			Expect(testUI.Out).To(Say("Global options:"))
+			Expect(testUI.Out).To(Say("  --context                          Run the command against the named context"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
+			Expect(testUI.Out).To(Say("  --no-cache                         Do not use the local cache of Cloud Controller responses"))
+			Expect(testUI.Out).To(Say("  --output                           Display command results as a table, or as a JSON or YAML document \\(json, yaml\\)"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
@@ -591,16 +594,25 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   enable-diego\\s+enable Diego support for an app"))
 
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
+				Expect(testUI.Out).To(Say("   CF_CACHE=true                      Cache responses for resources that rarely change, such as stacks and shared domains"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CONTEXT=name                    Run commands against the named context"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_RECORD=path/to/cassette         Record API requests and responses to a file, with credentials redacted"))
+				Expect(testUI.Out).To(Say("   CF_REPLAY=path/to/cassette         Respond to API requests from a recorded file instead of the network"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_EXPORT=path/or/url        Export timing spans for the command and its API requests to a file or OTLP endpoint"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
 
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
+				Expect(testUI.Out).To(Say("   --context                          Run the command against the named context"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say("   --no-cache                         Do not use the local cache of Cloud Controller responses"))
+				Expect(testUI.Out).To(Say("   --output                           Display command results as a table, or as a JSON or YAML document \\(json, yaml\\)"))
+				Expect(testUI.Out).To(Say("   --timings                          Print a breakdown of where the time went to stderr when the command finishes \\(--timings=json for JSON\\)"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 			})
 
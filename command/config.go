@@ -11,44 +11,67 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	ActiveContext() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	CFClientID() string
+	CFClientSecret() string
+	CFPassword() string
+	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() []configv3.TargetContext
+	CreateContext(name string) error
 	CurrentUser() (configv3.User, error)
+	DeleteContext(name string) error
 	DialTimeout() time.Duration
+	DockerPassword() string
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HTTPCacheEnabled() bool
 	Locale() string
+	MaxRequestsPerSecond() int
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	RecordPath() string
 	RefreshToken() string
 	RemovePlugin(string)
+	RenameContext(oldName string, newName string) error
+	ReplayPath() string
+	RetryBackoff() time.Duration
+	RetryMax() int
+	SaveCredentials() error
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAGrantType(grantType string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TokenRefreshSkew() time.Duration
+	UAAGrantType() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
+	UnsetUAAClientCredentials()
+	UseContext(name string) error
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 }
@@ -85,8 +85,8 @@ type APITarget struct {
 }
 
 type Authentication struct {
-	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
-	Password string `positional-arg-name:"PASSWORD" required:"true" description:"The password"`
+	Username string `positional-arg-name:"USERNAME" description:"The username"`
+	Password string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
 type CreateUser struct {
@@ -313,3 +313,25 @@ type ResetSpaceIsolationArgs struct {
 type ResetOrgDefaultIsolationArgs struct {
 	OrgName string `positional-arg-name:"ORG_NAME" required:"true" description:"The organization name"`
 }
+
+type ContextName struct {
+	ContextName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
+type RenameContextArgs struct {
+	OldContextName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The current context name"`
+	NewContextName string `positional-arg-name:"NEW_CONTEXT_NAME" required:"true" description:"The new context name"`
+}
+
+type PushCacheArgs struct {
+	Action PushCacheAction `positional-arg-name:"ACTION" required:"true" description:"Either 'prune' or 'stats'"`
+}
+
+type CacheArgs struct {
+	Action CacheAction `positional-arg-name:"ACTION" required:"true" description:"Must be 'clear'"`
+}
+
+type SCPArgs struct {
+	Source string `positional-arg-name:"SOURCE" required:"true" description:"The file or directory to copy, as APP_NAME:PATH when it is on the application instance"`
+	Target string `positional-arg-name:"TARGET" required:"true" description:"Where to copy to, as APP_NAME:PATH when it is on the application instance"`
+}
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type CacheAction struct {
+	Action string
+}
+
+func (CacheAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{"clear"}, prefix, false)
+}
+
+func (a *CacheAction) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "clear":
+		a.Action = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "clear"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheAction", func() {
+	var action CacheAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := action.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'clear' when passed 'c'", "c",
+				[]flags.Completion{{Item: "clear"}}),
+			Entry("completes to 'clear' when passed nothing", "",
+				[]flags.Completion{{Item: "clear"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			action = CacheAction{}
+		})
+
+		It("downcases and sets action", func() {
+			err := action.UnmarshalFlag("Clear")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(action.Action).To(Equal("clear"))
+		})
+
+		It("errors when passed an unknown action", func() {
+			err := action.UnmarshalFlag("prune")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `ACTION must be "clear"`,
+			}))
+			Expect(action.Action).To(BeEmpty())
+		})
+	})
+})
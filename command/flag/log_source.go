@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogSource struct {
+	Source string
+}
+
+func (LogSource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"APP", "STG", "RTR", "CELL", "API"}, prefix, false)
+}
+
+func (l *LogSource) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "APP", "STG", "RTR", "CELL", "API":
+		l.Source = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE must be "APP", "STG", "RTR", "CELL" or "API"`,
+		}
+	}
+	return nil
+}
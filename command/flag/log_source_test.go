@@ -0,0 +1,60 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var logSource LogSource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := logSource.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'APP' and 'API' when passed 'a'", "a",
+				[]flags.Completion{{Item: "APP"}, {Item: "API"}}),
+			Entry("returns 'CELL' when passed 'C'", "C",
+				[]flags.Completion{{Item: "CELL"}}),
+			Entry("returns all sources when passed nothing", "",
+				[]flags.Completion{{Item: "APP"}, {Item: "STG"}, {Item: "RTR"}, {Item: "CELL"}, {Item: "API"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logSource = LogSource{}
+		})
+
+		DescribeTable("upcases and sets source",
+			func(source string, expectedSource string) {
+				err := logSource.UnmarshalFlag(source)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logSource.Source).To(Equal(expectedSource))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'STG' when passed 'STG'", "STG", "STG"),
+			Entry("sets 'RTR' when passed 'rTr'", "rTr", "RTR"),
+			Entry("sets 'CELL' when passed 'cell'", "cell", "CELL"),
+			Entry("sets 'API' when passed 'api'", "api", "API"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := logSource.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be "APP", "STG", "RTR", "CELL" or "API"`,
+				}))
+				Expect(logSource.Source).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogTime is a point in time given either as an RFC3339 timestamp or as a
+// duration before now, e.g. "10m".
+type LogTime struct {
+	time.Time
+}
+
+func (l *LogTime) UnmarshalFlag(val string) error {
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		l.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TIME must be a duration such as "10m" or an RFC3339 timestamp such as "2017-01-02T15:04:05Z"`,
+		}
+	}
+	l.Time = timestamp
+	return nil
+}
@@ -0,0 +1,54 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogTime", func() {
+	var logTime LogTime
+
+	BeforeEach(func() {
+		logTime = LogTime{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a duration", func() {
+			It("sets the time to that long ago", func() {
+				err := logTime.UnmarshalFlag("1h30m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(BeTemporally("~", time.Now().Add(-90*time.Minute), time.Second))
+			})
+		})
+
+		Context("when passed an RFC3339 timestamp", func() {
+			It("sets the time to the timestamp", func() {
+				err := logTime.UnmarshalFlag("2017-01-02T15:04:05Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(Equal(time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)))
+			})
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := logTime.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIME must be a duration such as "10m" or an RFC3339 timestamp such as "2017-01-02T15:04:05Z"`,
+				}))
+				Expect(logTime.Time).To(BeZero())
+			})
+		})
+
+		Context("when passed a negative duration", func() {
+			It("returns an error", func() {
+				err := logTime.UnmarshalFlag("-5m")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
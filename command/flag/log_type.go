@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogType struct {
+	Type string
+}
+
+func (LogType) Complete(prefix string) []flags.Completion {
+	return completions([]string{"OUT", "ERR"}, prefix, false)
+}
+
+func (l *LogType) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "OUT", "ERR":
+		l.Type = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TYPE must be "OUT" or "ERR"`,
+		}
+	}
+	return nil
+}
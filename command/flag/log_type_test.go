@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogType", func() {
+	var logType LogType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := logType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'OUT' when passed 'o'", "o",
+				[]flags.Completion{{Item: "OUT"}}),
+			Entry("returns 'ERR' when passed 'E'", "E",
+				[]flags.Completion{{Item: "ERR"}}),
+			Entry("returns both types when passed nothing", "",
+				[]flags.Completion{{Item: "OUT"}, {Item: "ERR"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logType = LogType{}
+		})
+
+		DescribeTable("upcases and sets type",
+			func(settingType string, expectedType string) {
+				err := logType.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'OUT' when passed 'out'", "out", "OUT"),
+			Entry("sets 'ERR' when passed 'Err'", "Err", "ERR"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := logType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TYPE must be "OUT" or "ERR"`,
+				}))
+				Expect(logType.Type).To(BeEmpty())
+			})
+		})
+	})
+})
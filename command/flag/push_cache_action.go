@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type PushCacheAction struct {
+	Action string
+}
+
+func (PushCacheAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{"prune", "stats"}, prefix, false)
+}
+
+func (a *PushCacheAction) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "prune", "stats":
+		a.Action = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "prune" or "stats"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushCacheAction", func() {
+	var action PushCacheAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := action.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'prune' when passed 'p'", "p",
+				[]flags.Completion{{Item: "prune"}}),
+			Entry("returns 'stats' when passed 'ST'", "ST",
+				[]flags.Completion{{Item: "stats"}}),
+			Entry("completes to 'prune' and 'stats' when passed nothing", "",
+				[]flags.Completion{{Item: "prune"}, {Item: "stats"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			action = PushCacheAction{}
+		})
+
+		DescribeTable("downcases and sets action",
+			func(settingAction string, expectedAction string) {
+				err := action.UnmarshalFlag(settingAction)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(action.Action).To(Equal(expectedAction))
+			},
+			Entry("sets 'prune' when passed 'prune'", "prune", "prune"),
+			Entry("sets 'stats' when passed 'Stats'", "Stats", "stats"),
+		)
+
+		It("errors when passed an unknown action", func() {
+			err := action.UnmarshalFlag("clear")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `ACTION must be "prune" or "stats"`,
+			}))
+			Expect(action.Action).To(BeEmpty())
+		})
+	})
+})
@@ -30,7 +30,10 @@ type PluginsCommand struct {
 func (cmd *PluginsCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	pluginClient := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
 	cmd.Actor = pluginaction.NewActor(config, pluginClient)
 	return nil
 }
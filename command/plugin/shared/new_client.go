@@ -3,12 +3,15 @@ package shared
 import (
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/cassette"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
 // passed in config.
-func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *plugin.Client {
+func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) (*plugin.Client, error) {
 
 	verbose, location := config.Verbose()
 
@@ -19,6 +22,14 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		SkipSSLValidation: skipSSLValidation,
 	})
 
+	requestCassette, err := cassette.Open(config.RecordPath(), config.ReplayPath())
+	if err != nil {
+		return nil, err
+	}
+	if requestCassette != nil {
+		pluginClient.WrapConnection(wrapper.NewRecorder(requestCassette))
+	}
+
 	if verbose {
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
@@ -26,7 +37,11 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	pluginClient.WrapConnection(wrapper.NewRetryRequest(2))
+	if tracer := tracing.Current(); tracer != nil {
+		pluginClient.WrapConnection(wrapper.NewRequestTracer(tracer))
+	}
+
+	pluginClient.WrapConnection(wrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
 
-	return pluginClient
+	return pluginClient, nil
 }
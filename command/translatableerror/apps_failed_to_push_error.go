@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// AppsFailedToPushError is returned by a parallel push when at least one app
+// failed to push or was skipped because an app it depends on failed.
+type AppsFailedToPushError struct {
+	AppNames []string
+}
+
+func (AppsFailedToPushError) Error() string {
+	return "Failed to push apps: {{.AppNames}}"
+}
+
+func (e AppsFailedToPushError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
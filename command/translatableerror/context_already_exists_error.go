@@ -0,0 +1,17 @@
+package translatableerror
+
+// ContextAlreadyExistsError is returned when creating or renaming a context to
+// a name that is already in use.
+type ContextAlreadyExistsError struct {
+	Name string
+}
+
+func (ContextAlreadyExistsError) Error() string {
+	return "Context '{{.ContextName}}' already exists."
+}
+
+func (e ContextAlreadyExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ContextName": e.Name,
+	})
+}
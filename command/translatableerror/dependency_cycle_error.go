@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// DependencyCycleError is returned when the dependencies of the apps in the
+// manifest form a cycle.
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (DependencyCycleError) Error() string {
+	return "The apps in the manifest depend on each other: {{.AppNames}}"
+}
+
+func (e DependencyCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, " -> "),
+	})
+}
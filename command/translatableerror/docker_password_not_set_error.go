@@ -0,0 +1,13 @@
+package translatableerror
+
+// DockerPasswordNotSetError is returned when a docker username is provided
+// without the CF_DOCKER_PASSWORD environment variable.
+type DockerPasswordNotSetError struct{}
+
+func (DockerPasswordNotSetError) Error() string {
+	return "Environment variable CF_DOCKER_PASSWORD not set."
+}
+
+func (e DockerPasswordNotSetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
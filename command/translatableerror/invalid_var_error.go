@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidVarError is returned when a --var flag is not in the KEY=VALUE
+// format.
+type InvalidVarError struct {
+	Var string
+}
+
+func (InvalidVarError) DisplayUsage() {}
+
+func (InvalidVarError) Error() string {
+	return "Incorrect Usage: '--var {{.Var}}' must be in the format KEY=VALUE."
+}
+
+func (e InvalidVarError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Var": e.Var,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidVarsFileError is returned when a vars file is not a YAML map of
+// variables.
+type InvalidVarsFileError struct {
+	Path string
+}
+
+func (InvalidVarsFileError) Error() string {
+	return "Invalid vars file {{.Path}}: expected a map of variable names to values."
+}
+
+func (e InvalidVarsFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// MissingCredentialError is returned when a credential is neither provided as
+// an argument nor set in the environment.
+type MissingCredentialError struct {
+	ArgumentName string
+	EnvVar       string
+}
+
+func (MissingCredentialError) DisplayUsage() {}
+
+func (MissingCredentialError) Error() string {
+	return "Incorrect Usage: the required argument `{{.ArgumentName}}` was not provided and {{.EnvVar}} is not set"
+}
+
+func (e MissingCredentialError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ArgumentName": e.ArgumentName,
+		"EnvVar":       e.EnvVar,
+	})
+}
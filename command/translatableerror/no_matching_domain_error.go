@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoMatchingDomainError is returned when a route does not match any of the
+// domains available to the organization.
+type NoMatchingDomainError struct {
+	Route string
+}
+
+func (NoMatchingDomainError) Error() string {
+	return "The route {{.Route}} did not match any existing domains."
+}
+
+func (e NoMatchingDomainError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Route": e.Route,
+	})
+}
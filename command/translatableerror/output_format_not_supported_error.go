@@ -0,0 +1,23 @@
+package translatableerror
+
+import "strings"
+
+// OutputFormatNotSupportedError is returned when a command that cannot
+// display its results as a document is run with --output json or yaml.
+type OutputFormatNotSupportedError struct {
+	Command           string
+	Format            string
+	SupportedCommands []string
+}
+
+func (OutputFormatNotSupportedError) Error() string {
+	return "Command '{{.Command}}' does not support --output {{.Format}}. It is supported by: {{.SupportedCommands}}"
+}
+
+func (e OutputFormatNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command":           e.Command,
+		"Format":            e.Format,
+		"SupportedCommands": strings.Join(e.SupportedCommands, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// PushChangesDetectedError is returned by a push with --dry-run and
+// --detect-changes when pushing would change at least one app.
+type PushChangesDetectedError struct {
+	AppNames []string
+}
+
+func (PushChangesDetectedError) Error() string {
+	return "Changes detected for apps: {{.AppNames}}"
+}
+
+func (e PushChangesDetectedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+import "strings"
+
+// PushChangesUndetectableError is returned by a push with --dry-run and
+// --detect-changes when no app would change that can be detected, but some
+// apps have files too small to be compared with what was pushed before.
+type PushChangesUndetectableError struct {
+	AppNames []string
+	Size     string
+}
+
+func (PushChangesUndetectableError) Error() string {
+	return "Changes could not be detected for apps: {{.AppNames}}. Files smaller than {{.Size}} cannot be compared with the pushed app."
+}
+
+func (e PushChangesUndetectableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+		"Size":     e.Size,
+	})
+}
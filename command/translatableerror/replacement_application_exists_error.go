@@ -0,0 +1,18 @@
+package translatableerror
+
+// ReplacementApplicationExistsError is returned when a push with a deployment
+// strategy cannot create its replacement app because one with the same name
+// already exists.
+type ReplacementApplicationExistsError struct {
+	Name string
+}
+
+func (ReplacementApplicationExistsError) Error() string {
+	return "App {{.AppName}} already exists. Delete or rename it before pushing with a deployment strategy."
+}
+
+func (e ReplacementApplicationExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
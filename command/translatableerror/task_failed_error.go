@@ -0,0 +1,17 @@
+package translatableerror
+
+type TaskFailedError struct {
+	Name          string
+	FailureReason string
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.TaskName}} failed{{if .FailureReason}}: {{.FailureReason}}{{end}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":      e.Name,
+		"FailureReason": e.FailureReason,
+	})
+}
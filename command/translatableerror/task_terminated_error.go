@@ -0,0 +1,15 @@
+package translatableerror
+
+type TaskTerminatedError struct {
+	Name string
+}
+
+func (TaskTerminatedError) Error() string {
+	return "Task {{.TaskName}} was terminated."
+}
+
+func (e TaskTerminatedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName": e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+type TaskTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (TaskTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} {{if eq .Timeout 1.0}}second{{else}}seconds{{end}} waiting for task {{.TaskName}} to complete. The task is still running."
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName": e.Name,
+		"Timeout":  e.Timeout.Seconds(),
+	})
+}
@@ -42,10 +42,15 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("AppsFailedToPushError", AppsFailedToPushError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
+		Entry("ContextAlreadyExistsError", ContextAlreadyExistsError{}),
+		Entry("ContextNotFoundError", ContextNotFoundError{}),
+		Entry("DependencyCycleError", DependencyCycleError{}),
+		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
@@ -55,20 +60,25 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidVarError", InvalidVarError{}),
+		Entry("InvalidVarsFileError", InvalidVarsFileError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
+		Entry("MissingCredentialError", MissingCredentialError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
+		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("OutputFormatNotSupportedError", OutputFormatNotSupportedError{SupportedCommands: []string{"some-command", "another-command"}}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
@@ -79,8 +89,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PushChangesDetectedError", PushChangesDetectedError{}),
+		Entry("PushChangesUndetectableError", PushChangesUndetectableError{}),
+		Entry("ReplacementApplicationExistsError", ReplacementApplicationExistsError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
+		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RunTaskError", RunTaskError{}),
@@ -94,9 +108,15 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
+		Entry("TaskTerminatedError", TaskTerminatedError{}),
+		Entry("TaskTimeoutError", TaskTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("UnknownDependencyError", UnknownDependencyError{}),
+		Entry("UnresolvedVariablesError", UnresolvedVariablesError{Names: []string{"some-var"}}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
+		Entry("UploadChecksumError", UploadChecksumError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
 	)
@@ -0,0 +1,19 @@
+package translatableerror
+
+// UnknownDependencyError is returned when an app in the manifest depends on an
+// app that is not in the manifest.
+type UnknownDependencyError struct {
+	AppName    string
+	Dependency string
+}
+
+func (UnknownDependencyError) Error() string {
+	return "App {{.AppName}} depends on {{.Dependency}}, which is not in the manifest."
+}
+
+func (e UnknownDependencyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Dependency": e.Dependency,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// UnresolvedVariablesError is returned when a manifest contains ((var))
+// placeholders that no var or vars file provides.
+type UnresolvedVariablesError struct {
+	Names []string
+}
+
+func (UnresolvedVariablesError) Error() string {
+	return "Expected to find variables: {{.Names}}"
+}
+
+func (e UnresolvedVariablesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Names": strings.Join(e.Names, ", "),
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+import "strings"
+
+// UploadChecksumError is returned when files of the package the Cloud
+// Controller stores after the upload are missing or differ from the pushed
+// files.
+type UploadChecksumError struct {
+	Files []string
+}
+
+func (UploadChecksumError) Error() string {
+	return "The package stored by the Cloud Controller does not match the pushed files {{.Files}}. The files may have changed during the upload; push again."
+}
+
+func (e UploadChecksumError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Files": strings.Join(e.Files, ", "),
+	})
+}
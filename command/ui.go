@@ -11,6 +11,7 @@ import (
 type UI interface {
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
+	DisplayDocument(kind string, data interface{}) error
 	DisplayError(err error)
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
@@ -18,6 +19,7 @@ type UI interface {
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageJSON(message ui.LogMessage) error
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
@@ -27,6 +29,8 @@ type UI interface {
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	IsStructuredOutput() bool
+	Reader() io.Reader
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
@@ -87,6 +87,10 @@ func (cmd AppCommand) displayAppSummary() error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.AppDocumentKind, shared.NewAppDocument(appSummary))
+	}
+
 	shared.DisplayAppSummary(cmd.UI, appSummary, false)
 
 	return nil
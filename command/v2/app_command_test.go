@@ -323,6 +323,68 @@ var _ = Describe("App Command", func() {
 							Expect(spaceGUID).To(Equal("some-space-guid"))
 						})
 					})
+
+					Context("when the output format is JSON", func() {
+						var documentOut *Buffer
+
+						BeforeEach(func() {
+							documentOut = NewBuffer()
+							testUI.OutputFormat = ui.OutputFormatJSON
+							testUI.DocumentOut = documentOut
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("displays the app summary as a JSON document and all warnings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(documentOut.Contents()).To(MatchJSON(`{
+								"schema_version": 1,
+								"kind": "app",
+								"data": {
+									"name": "some-app",
+									"guid": "some-app-guid",
+									"requested_state": "started",
+									"instances": 3,
+									"running_instances": 1,
+									"memory_in_mb": 128,
+									"disk_in_mb": 0,
+									"isolation_segment": "some-isolation-segment",
+									"routes": ["banana.fruit.com/hi", "foobar.com:13"],
+									"last_uploaded": "1970-01-01T00:00:00Z",
+									"stack": "potatos",
+									"buildpack": "some-buildpack",
+									"health_check_type": "",
+									"instance_stats": [
+										{
+											"index": 0,
+											"state": "running",
+											"since": "2014-06-19T01:18:37Z",
+											"cpu": 0.73,
+											"memory_in_bytes": 104857600,
+											"memory_quota_in_bytes": 134217728,
+											"disk_in_bytes": 52428800,
+											"disk_quota_in_bytes": 2147483648,
+											"details": "info from the backend"
+										},
+										{
+											"index": 1,
+											"state": "crashed",
+											"since": "2014-06-18T14:00:00Z",
+											"cpu": 0.37,
+											"memory_in_bytes": 104857600,
+											"memory_quota_in_bytes": 134217728,
+											"disk_in_bytes": 52428800,
+											"disk_quota_in_bytes": 2147483648,
+											"details": "potato"
+										}
+									]
+								}
+							}`))
+							Expect(testUI.Out).ToNot(Say("name:"))
+
+							Expect(testUI.Err).To(Say("app-summary-warning"))
+						})
+					})
 				})
 			})
 
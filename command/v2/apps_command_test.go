@@ -0,0 +1,121 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		documentOut     *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		documentOut = NewBuffer()
+		testUI.OutputFormat = ui.OutputFormatJSON
+		testUI.DocumentOut = documentOut
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the apps are returned", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsBySpaceReturns(
+				[]v2action.Application{{Name: "app-1"}, {Name: "app-2"}},
+				v2action.Warnings{"apps-warning"},
+				nil)
+			fakeActor.GetApplicationSummaryByNameAndSpaceStub = func(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+				return v2action.ApplicationSummary{
+					Application: v2action.Application{
+						Name:      name,
+						GUID:      name + "-guid",
+						Instances: 1,
+						Memory:    64,
+						State:     "STOPPED",
+					},
+				}, v2action.Warnings{name + "-warning"}, nil
+			}
+		})
+
+		It("displays the app summaries as a JSON document and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(2))
+
+			Expect(documentOut).To(Say(`"kind": "app_list"`))
+			Expect(documentOut).To(Say(`"name": "app-1"`))
+			Expect(documentOut).To(Say(`"requested_state": "stopped"`))
+			Expect(documentOut).To(Say(`"name": "app-2"`))
+
+			Expect(testUI.Err).To(Say("apps-warning"))
+			Expect(testUI.Err).To(Say("app-1-warning"))
+			Expect(testUI.Err).To(Say("app-2-warning"))
+		})
+	})
+
+	Context("when getting an app summary fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("summary error")
+			fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "app-1"}}, nil, nil)
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{}, v2action.Warnings{"summary-warning"}, expectedErr)
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(documentOut.Contents()).To(BeEmpty())
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+	})
+})
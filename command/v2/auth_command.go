@@ -1,11 +1,14 @@
 package v2
 
 import (
+	"bufio"
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -13,12 +16,15 @@ import (
 
 type AuthActor interface {
 	Authenticate(config v2action.Config, username string, password string) error
+	AuthenticateClient(config v2action.Config, clientID string, clientSecret string) error
 }
 
 type AuthCommand struct {
-	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	ClientCredentials bool                `long:"client-credentials" description:"Authenticate as a UAA client using its client ID and secret instead of a username and password"`
+	PasswordStdin     bool                `long:"password-stdin" description:"Read the password (or client secret) from the first line of standard input"`
+	usage             interface{}         `usage:"CF_NAME auth [USERNAME] [PASSWORD] [--password-stdin]\n   CF_NAME auth [CLIENT_ID] [CLIENT_SECRET] --client-credentials [--password-stdin]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n   If USERNAME or PASSWORD are omitted they are read from CF_USERNAME and CF_PASSWORD\n   (CF_CLIENT_ID and CF_CLIENT_SECRET with --client-credentials)\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth my-client my-client-secret --client-credentials\n   CF_USERNAME=name@example.com CF_PASSWORD=\"my password\" CF_NAME auth\n   echo \"my password\" | CF_NAME auth name@example.com --password-stdin"`
+	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
 	Config command.Config
@@ -44,6 +50,11 @@ func (cmd AuthCommand) Execute(args []string) error {
 		return err
 	}
 
+	username, password, err := cmd.credentials()
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayTextWithFlavor(
 		"API endpoint: {{.Endpoint}}",
 		map[string]interface{}{
@@ -51,7 +62,11 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	if cmd.ClientCredentials {
+		err = cmd.Actor.AuthenticateClient(cmd.Config, username, password)
+	} else {
+		err = cmd.Actor.Authenticate(cmd.Config, username, password)
+	}
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -65,3 +80,40 @@ func (cmd AuthCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// credentials resolves the username and password from the positional
+// arguments, falling back to standard input (with --password-stdin) and the
+// environment.
+func (cmd AuthCommand) credentials() (string, string, error) {
+	usernameArg, usernameEnv, username := "USERNAME", "CF_USERNAME", cmd.Config.CFUsername()
+	passwordArg, passwordEnv, password := "PASSWORD", "CF_PASSWORD", cmd.Config.CFPassword()
+	if cmd.ClientCredentials {
+		usernameArg, usernameEnv, username = "CLIENT_ID", "CF_CLIENT_ID", cmd.Config.CFClientID()
+		passwordArg, passwordEnv, password = "CLIENT_SECRET", "CF_CLIENT_SECRET", cmd.Config.CFClientSecret()
+	}
+
+	if cmd.RequiredArgs.Username != "" {
+		username = cmd.RequiredArgs.Username
+	}
+	if username == "" {
+		return "", "", translatableerror.MissingCredentialError{ArgumentName: usernameArg, EnvVar: usernameEnv}
+	}
+
+	switch {
+	case cmd.PasswordStdin && cmd.RequiredArgs.Password != "":
+		return "", "", translatableerror.ArgumentCombinationError{Arg1: passwordArg, Arg2: "--password-stdin"}
+	case cmd.PasswordStdin:
+		line, err := bufio.NewReader(cmd.UI.Reader()).ReadString('\n')
+		if err != nil && line == "" {
+			return "", "", translatableerror.MissingCredentialError{ArgumentName: passwordArg, EnvVar: passwordEnv}
+		}
+		password = strings.TrimRight(line, "\r\n")
+	case cmd.RequiredArgs.Password != "":
+		password = cmd.RequiredArgs.Password
+	}
+	if password == "" {
+		return "", "", translatableerror.MissingCredentialError{ArgumentName: passwordArg, EnvVar: passwordEnv}
+	}
+
+	return username, password, nil
+}
@@ -2,6 +2,7 @@ package v2_test
 
 import (
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -76,6 +77,146 @@ var _ = Describe("auth Command", func() {
 		})
 	})
 
+	Context("when --client-credentials is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "some-client"
+			cmd.RequiredArgs.Password = "some-secret"
+			cmd.ClientCredentials = true
+
+			fakeConfig.TargetReturns("some-api-target")
+		})
+
+		It("authenticates as the client", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Authenticating\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateClientCallCount()).To(Equal(1))
+			config, clientID, clientSecret := fakeActor.AuthenticateClientArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(clientID).To(Equal("some-client"))
+			Expect(clientSecret).To(Equal("some-secret"))
+		})
+
+		Context("when the client credentials are rejected", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateClientReturns(uaa.BadCredentialsError{Message: "some message"})
+			})
+
+			It("returns a BadCredentialsError", func() {
+				Expect(err).To(MatchError(translatableerror.BadCredentialsError{}))
+			})
+		})
+	})
+
+	Context("when the credentials are not provided as arguments", func() {
+		BeforeEach(func() {
+			fakeConfig.CFUsernameReturns("env-user")
+			fakeConfig.CFPasswordReturns("env-password")
+			fakeConfig.CFClientIDReturns("env-client")
+			fakeConfig.CFClientSecretReturns("env-secret")
+		})
+
+		It("reads the username and password from the environment", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			_, username, password := fakeActor.AuthenticateArgsForCall(0)
+			Expect(username).To(Equal("env-user"))
+			Expect(password).To(Equal("env-password"))
+		})
+
+		Context("when only the username is provided as an argument", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "arg-user"
+			})
+
+			It("prefers the argument over the environment", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, username, password := fakeActor.AuthenticateArgsForCall(0)
+				Expect(username).To(Equal("arg-user"))
+				Expect(password).To(Equal("env-password"))
+			})
+		})
+
+		Context("when --client-credentials is provided", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("reads the client ID and secret from the environment", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.AuthenticateClientCallCount()).To(Equal(1))
+				_, clientID, clientSecret := fakeActor.AuthenticateClientArgsForCall(0)
+				Expect(clientID).To(Equal("env-client"))
+				Expect(clientSecret).To(Equal("env-secret"))
+			})
+		})
+	})
+
+	Context("when the username is not provided", func() {
+		It("returns a MissingCredentialError", func() {
+			Expect(err).To(MatchError(translatableerror.MissingCredentialError{ArgumentName: "USERNAME", EnvVar: "CF_USERNAME"}))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the client secret is not provided", func() {
+		BeforeEach(func() {
+			cmd.ClientCredentials = true
+			cmd.RequiredArgs.Username = "some-client"
+		})
+
+		It("returns a MissingCredentialError", func() {
+			Expect(err).To(MatchError(translatableerror.MissingCredentialError{ArgumentName: "CLIENT_SECRET", EnvVar: "CF_CLIENT_SECRET"}))
+			Expect(fakeActor.AuthenticateClientCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --password-stdin is provided", func() {
+		BeforeEach(func() {
+			testUI = ui.NewTestUI(strings.NewReader("stdin password\r\nsecond line\n"), NewBuffer(), NewBuffer())
+			cmd.UI = testUI
+			cmd.RequiredArgs.Username = "some-user"
+			cmd.PasswordStdin = true
+			fakeConfig.CFPasswordReturns("env-password")
+		})
+
+		It("reads the password from the first line of stdin", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			_, username, password := fakeActor.AuthenticateArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(password).To(Equal("stdin password"))
+		})
+
+		Context("when stdin is empty", func() {
+			BeforeEach(func() {
+				testUI = ui.NewTestUI(strings.NewReader(""), NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+			})
+
+			It("returns a MissingCredentialError", func() {
+				Expect(err).To(MatchError(translatableerror.MissingCredentialError{ArgumentName: "PASSWORD", EnvVar: "CF_PASSWORD"}))
+			})
+		})
+
+		Context("when the password is also provided as an argument", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Password = "arg-password"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{Arg1: "PASSWORD", Arg2: "--password-stdin"}))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Context("when there is an auth error", func() {
 		BeforeEach(func() {
 			cmd.RequiredArgs.Username = "foo"
@@ -83,7 +224,7 @@ var _ = Describe("auth Command", func() {
 
 			fakeConfig.TargetReturns("some-api-target")
 
-			fakeActor.AuthenticateReturns(uaa.BadCredentialsError{"some message"})
+			fakeActor.AuthenticateReturns(uaa.BadCredentialsError{Message: "some message"})
 		})
 
 		It("returns a BadCredentialsError", func() {
@@ -117,6 +258,9 @@ var _ = Describe("auth Command", func() {
 		)
 
 		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "foo"
+			cmd.RequiredArgs.Password = "bar"
+
 			apiVersion = "1.2.3"
 			fakeConfig.APIVersionReturns(apiVersion)
 			minCLIVersion = "1.0.0"
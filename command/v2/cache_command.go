@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/api/httpcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . HTTPCache
+
+type HTTPCache interface {
+	Clear() error
+}
+
+type CacheCommand struct {
+	RequiredArgs flag.CacheArgs `positional-args:"yes"`
+	usage        interface{}    `usage:"CF_NAME cache clear"`
+	envCFCache   interface{}    `environmentName:"CF_CACHE" environmentDescription:"Cache responses for resources that rarely change, such as stacks and shared domains" environmentDefault:"false"`
+
+	UI    command.UI
+	Cache HTTPCache
+}
+
+func (cmd *CacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Cache = httpcache.NewStore(configv3.HTTPCacheDir())
+	return nil
+}
+
+func (cmd CacheCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Clearing the cache of Cloud Controller responses...")
+
+	err := cmd.Cache.Clear()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,55 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cache Command", func() {
+	var (
+		cmd        CacheCommand
+		testUI     *ui.UI
+		fakeCache  *v2fakes.FakeHTTPCache
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeCache = new(v2fakes.FakeHTTPCache)
+
+		cmd = CacheCommand{
+			UI:    testUI,
+			Cache: fakeCache,
+		}
+		cmd.RequiredArgs.Action = flag.CacheAction{Action: "clear"}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("clears the cache", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeCache.ClearCallCount()).To(Equal(1))
+
+		Expect(testUI.Out).To(Say("Clearing the cache of Cloud Controller responses\\.\\.\\."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when clearing the cache fails", func() {
+		BeforeEach(func() {
+			fakeCache.ClearReturns(errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
@@ -9,11 +9,13 @@ import (
 )
 
 type ConfigCommand struct {
-	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	AsyncTimeout    int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
+	Color           flag.Color        `long:"color" description:"Enable or disable color"`
+	CredentialStore string            `long:"credential-store" description:"Store tokens with the cf-credential-NAME helper instead of in the config file. If NAME is 'file', tokens are stored in the config file."`
+	Locale          flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	MaxRequests     int               `long:"max-requests-per-second" description:"Limit the number of requests per second sent to the Cloud Controller. 0 removes the limit."`
+	Trace           flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
+	usage           interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (NAME | file)] [--max-requests-per-second LIMIT]"`
 }
 
 func (ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+)
+
+type ContextsCommand struct {
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"create-context, use-context, delete-context, rename-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	contexts := cmd.Config.Contexts()
+	if len(contexts) == 0 {
+		cmd.UI.DisplayText("No contexts found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	activeContext := cmd.Config.ActiveContext()
+	for _, context := range contexts {
+		current := ""
+		if context.Name == activeContext {
+			current = "*"
+		}
+
+		// Contexts with undecodable tokens are still listed; the user column is
+		// left blank for them.
+		user, _ := context.CurrentUser()
+
+		table = append(table, []string{
+			current,
+			context.Name,
+			context.Target,
+			user.Name,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
@@ -0,0 +1,74 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextsReturns([]configv3.TargetContext{})
+		})
+
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting contexts\\.\\.\\."))
+			Expect(testUI.Out).To(Say("No contexts found\\."))
+		})
+	})
+
+	Context("when there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextsReturns([]configv3.TargetContext{
+				{
+					Name:                 "prod",
+					Target:               "https://api.prod.com",
+					TargetedOrganization: configv3.Organization{Name: "prod-org"},
+					TargetedSpace:        configv3.Space{Name: "prod-space"},
+				},
+				{
+					Name:   "staging",
+					Target: "https://api.staging.com",
+				},
+			})
+			fakeConfig.ActiveContextReturns("staging")
+		})
+
+		It("displays the contexts and marks the active one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting contexts\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+api endpoint\\s+user\\s+org\\s+space"))
+			Expect(testUI.Out).To(Say("\\s+prod\\s+https://api.prod.com\\s+prod-org\\s+prod-space"))
+			Expect(testUI.Out).To(Say("\\*\\s+staging\\s+https://api.staging.com"))
+		})
+	})
+})
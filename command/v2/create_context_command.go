@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type CreateContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"Save the current API endpoint, session, org and space as a named context:\n   CF_NAME create-context CONTEXT_NAME\n\n   The first context created becomes the current context.\n\nEXAMPLES:\n   CF_NAME create-context staging"`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, target, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *CreateContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd CreateContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Creating context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	err := cmd.Config.CreateContext(cmd.RequiredArgs.ContextName)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,100 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-context Command", func() {
+	var (
+		cmd        DeleteContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = DeleteContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "prod"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the context without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteContextArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Out).To(Say("Deleting context prod\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when the context does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(configv3.ContextNotFoundError{Name: "prod"})
+			})
+
+			It("displays that the context does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting context prod\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Context prod does not exist\\."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the context", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really delete the context prod\\? \\[yN\\]"))
+				Expect(testUI.Out).To(Say("Deleting context prod\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user cancels", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the context", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeConfig.DeleteContextCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,12 +1,13 @@
 package v2
 
 import (
-	"github.com/cloudfoundry/noaa/consumer"
+	"regexp"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -18,16 +19,23 @@ type LogsActor interface {
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	Recent          bool           `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.LogTime   `long:"since" description:"With --recent, only show logs after this time, given as a duration (e.g. 10m) or an RFC3339 timestamp"`
+	Until           flag.LogTime   `long:"until" description:"With --recent, only show logs before this time, given as a duration (e.g. 10m) or an RFC3339 timestamp"`
+	Source          flag.LogSource `long:"source" description:"Only show logs from this source: APP, STG, RTR, CELL or API"`
+	Instance        string         `long:"instance" description:"Only show logs from this instance index"`
+	Type            flag.LogType   `long:"type" description:"Only show logs of this type: OUT or ERR"`
+	Grep            string         `long:"grep" description:"Only show logs matching this regular expression"`
+	Format          string         `long:"format" choice:"json" description:"Display each log as a JSON object on its own line"`
+	usage           interface{}    `usage:"CF_NAME logs APP_NAME [--recent [--since TIME] [--until TIME]] [--source SOURCE] [--instance INDEX] [--type TYPE] [--grep REGEX] [--format json]"`
+	relatedCommands interface{}    `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       LogsActor
-	NOAAClient  *consumer.Consumer
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -47,7 +55,21 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if !cmd.Recent {
+		if !cmd.Since.IsZero() {
+			return translatableerror.RequiredFlagsError{Arg1: "--recent", Arg2: "--since"}
+		}
+		if !cmd.Until.IsZero() {
+			return translatableerror.RequiredFlagsError{Arg1: "--recent", Arg2: "--until"}
+		}
+	}
+
+	filter, err := cmd.filter()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -57,23 +79,62 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	// JSON logs are meant to be piped, so nothing else is written to stdout.
+	if cmd.Format != "json" {
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppName,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		return cmd.displayRecentLogs(filter)
+	}
+
+	return cmd.streamLogs(filter)
+}
+
+func (cmd LogsCommand) filter() (v2action.LogMessageFilter, error) {
+	filter := v2action.LogMessageFilter{
+		SourceType:     cmd.Source.Source,
+		SourceInstance: cmd.Instance,
+		MessageType:    cmd.Type.Type,
+		Since:          cmd.Since.Time,
+		Until:          cmd.Until.Time,
 	}
 
-	return cmd.streamLogs()
+	if cmd.Grep != "" {
+		pattern, err := regexp.Compile(cmd.Grep)
+		if err != nil {
+			return filter, translatableerror.ParseArgumentError{
+				ArgumentName: "--grep",
+				ExpectedType: "a regular expression",
+			}
+		}
+		filter.Pattern = pattern
+	}
+
+	return filter, nil
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
+func (cmd LogsCommand) displayLogMessage(message v2action.LogMessage, filter v2action.LogMessageFilter) error {
+	if !filter.Matches(message) {
+		return nil
+	}
+
+	if cmd.Format == "json" {
+		return cmd.UI.DisplayLogMessageJSON(message)
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
+
+func (cmd LogsCommand) displayRecentLogs(filter v2action.LogMessageFilter) error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -82,14 +143,17 @@ func (cmd LogsCommand) displayRecentLogs() error {
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		displayErr := cmd.displayLogMessage(message, filter)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	return err
 }
 
-func (cmd LogsCommand) streamLogs() error {
+func (cmd LogsCommand) streamLogs(filter v2action.LogMessageFilter) error {
 	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -111,7 +175,11 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			err = cmd.displayLogMessage(*message, filter)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -55,6 +56,41 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when --since is provided without --recent", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTime{Time: time.Unix(1, 0)}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--recent", Arg2: "--since"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --until is provided without --recent", func() {
+		BeforeEach(func() {
+			cmd.Until = flag.LogTime{Time: time.Unix(1, 0)}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--recent", Arg2: "--until"}))
+		})
+	})
+
+	Context("when --grep is not a valid regular expression", func() {
+		BeforeEach(func() {
+			cmd.Grep = "("
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--grep",
+				ExpectedType: "a regular expression",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -147,6 +183,54 @@ var _ = Describe("logs command", func() {
 					Expect(config).To(Equal(fakeConfig))
 				})
 			})
+
+			Context("when filters are provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("out from web 0", 1, time.Unix(10, 0), "APP/PROC/WEB", "0"),
+							*v2action.NewLogMessage("err from web 0", 2, time.Unix(20, 0), "APP/PROC/WEB", "0"),
+							*v2action.NewLogMessage("err from web 1", 2, time.Unix(30, 0), "APP/PROC/WEB", "1"),
+							*v2action.NewLogMessage("err from router", 2, time.Unix(40, 0), "RTR", "0"),
+							*v2action.NewLogMessage("late err from web 0", 2, time.Unix(50, 0), "APP/PROC/WEB", "0"),
+						},
+						nil,
+						nil)
+
+					cmd.Source = flag.LogSource{Source: "APP"}
+					cmd.Instance = "0"
+					cmd.Type = flag.LogType{Type: "ERR"}
+					cmd.Grep = "^err"
+					cmd.Until = flag.LogTime{Time: time.Unix(45, 0)}
+				})
+
+				It("only displays the matching log messages", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("err from web 0"))
+					Expect(testUI.Out).NotTo(Say("out from web 0"))
+					Expect(testUI.Out).NotTo(Say("from web 1"))
+					Expect(testUI.Out).NotTo(Say("router"))
+					Expect(testUI.Out).NotTo(Say("late"))
+				})
+			})
+
+			Context("when --format json is provided", func() {
+				BeforeEach(func() {
+					cmd.Format = "json"
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+						},
+						v2action.Warnings{"some-warning-1"},
+						nil)
+				})
+
+				It("displays one JSON object per message and nothing else on stdout", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`^\{"timestamp":"1970-01-01T00:00:00Z","source_type":"APP/PROC/WEB","source_instance":"1","message_type":"OUT","message":"i am message 1"\}\n$`))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+				})
+			})
 		})
 
 		Context("when the --recent flag is not provided", func() {
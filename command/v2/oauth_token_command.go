@@ -8,8 +8,9 @@ import (
 )
 
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
-	relatedCommands interface{} `related_commands:"curl"`
+	usage                 interface{} `usage:"CF_NAME oauth-token"`
+	relatedCommands       interface{} `related_commands:"curl"`
+	envCFTokenRefreshSkew interface{} `environmentName:"CF_TOKEN_REFRESH_SKEW" environmentDescription:"Refresh the token if it expires within this many seconds" environmentDefault:"60"`
 }
 
 func (OauthTokenCommand) Setup(config command.Config, ui command.UI) error {
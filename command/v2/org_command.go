@@ -110,12 +110,11 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		return shared.HandleError(err)
 	}
 
-	table := [][]string{
-		{cmd.UI.TranslateText("name:"), orgSummary.Name},
-		{cmd.UI.TranslateText("domains:"), strings.Join(orgSummary.DomainNames, ", ")},
-		{cmd.UI.TranslateText("quota:"), orgSummary.QuotaName},
-		{cmd.UI.TranslateText("spaces:"), strings.Join(orgSummary.SpaceNames, ", ")},
-	}
+	var (
+		isolationSegmentNames   []string
+		defaultIsolationSegment string
+		displayIsolationSegment bool
+	)
 
 	if cmd.ActorV3 != nil {
 		apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), command.MinVersionIsolationSegmentV3)
@@ -126,20 +125,40 @@ func (cmd OrgCommand) displayOrgSummary() error {
 				return shared.HandleError(err)
 			}
 
-			isolationSegmentNames := []string{}
+			displayIsolationSegment = true
 			for _, iso := range isolationSegments {
+				isolationSegmentNames = append(isolationSegmentNames, iso.Name)
 				if iso.GUID == orgSummary.DefaultIsolationSegmentGUID {
-					isolationSegmentNames = append(isolationSegmentNames, fmt.Sprintf("%s (%s)", iso.Name, cmd.UI.TranslateText("default")))
-				} else {
-					isolationSegmentNames = append(isolationSegmentNames, iso.Name)
+					defaultIsolationSegment = iso.Name
 				}
 			}
-
 			sort.Strings(isolationSegmentNames)
-			table = append(table, []string{cmd.UI.TranslateText("isolation segments:"), strings.Join(isolationSegmentNames, ", ")})
 		}
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.OrgDocumentKind, shared.NewOrgDocument(orgSummary, isolationSegmentNames, defaultIsolationSegment))
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("name:"), orgSummary.Name},
+		{cmd.UI.TranslateText("domains:"), strings.Join(orgSummary.DomainNames, ", ")},
+		{cmd.UI.TranslateText("quota:"), orgSummary.QuotaName},
+		{cmd.UI.TranslateText("spaces:"), strings.Join(orgSummary.SpaceNames, ", ")},
+	}
+
+	if displayIsolationSegment {
+		displayedNames := []string{}
+		for _, name := range isolationSegmentNames {
+			if name == defaultIsolationSegment {
+				displayedNames = append(displayedNames, fmt.Sprintf("%s (%s)", name, cmd.UI.TranslateText("default")))
+			} else {
+				displayedNames = append(displayedNames, name)
+			}
+		}
+		table = append(table, []string{cmd.UI.TranslateText("isolation segments:"), strings.Join(displayedNames, ", ")})
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	return nil
@@ -154,8 +154,8 @@ var _ = Describe("org Command", func() {
 				fakeActor.GetOrganizationSummaryByNameReturns(
 					v2action.OrganizationSummary{
 						Organization: v2action.Organization{
-							Name: "some-org",
-							GUID: "some-org-guid",
+							Name:                        "some-org",
+							GUID:                        "some-org-guid",
 							DefaultIsolationSegmentGUID: "default-isolation-segment-guid",
 						},
 						DomainNames: []string{
@@ -226,6 +226,39 @@ var _ = Describe("org Command", func() {
 					orgGuid := fakeActorV3.GetIsolationSegmentsByOrganizationArgsForCall(0)
 					Expect(orgGuid).To(Equal("some-org-guid"))
 				})
+
+				Context("when the output format is YAML", func() {
+					var documentOut *Buffer
+
+					BeforeEach(func() {
+						documentOut = NewBuffer()
+						testUI.OutputFormat = ui.OutputFormatYAML
+						testUI.DocumentOut = documentOut
+					})
+
+					It("displays the org summary as a YAML document and all warnings", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(documentOut.Contents()).To(MatchYAML(`
+schema_version: 1
+kind: org
+data:
+  name: some-org
+  guid: some-org-guid
+  quota: some-quota
+  domains: [a-shared.com, b-private.com, c-shared.com, d-private.com]
+  spaces: [space1, space2]
+  isolation_segments: [isolation-segment-1, isolation-segment-2]
+  default_isolation_segment: isolation-segment-1
+`))
+						Expect(testUI.Out).ToNot(Say("domains:"))
+
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("warning-2"))
+						Expect(testUI.Err).To(Say("warning-3"))
+						Expect(testUI.Err).To(Say("warning-4"))
+					})
+				})
 			})
 
 			Context("when api version is below 3.11.0", func() {
@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/pushcache"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// PushCachePruneAge is how long an archive can go unused before prune removes
+// it.
+const PushCachePruneAge = 7 * 24 * time.Hour
+
+//go:generate counterfeiter . PushCache
+
+type PushCache interface {
+	Prune(maxAge time.Duration) (pushcache.Stats, error)
+	Stats() (pushcache.Stats, error)
+}
+
+type PushCacheCommand struct {
+	RequiredArgs    flag.PushCacheArgs `positional-args:"yes"`
+	All             bool               `long:"all" description:"Remove every archive, not just the ones unused for a week (prune only)"`
+	usage           interface{}        `usage:"CF_NAME push-cache prune [--all]\n   CF_NAME push-cache stats"`
+	relatedCommands interface{}        `related_commands:"v2-push"`
+
+	UI    command.UI
+	Cache PushCache
+}
+
+func (cmd *PushCacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Cache = pushcache.NewCache(configv3.PushCacheDir())
+	return nil
+}
+
+func (cmd PushCacheCommand) Execute(args []string) error {
+	if cmd.RequiredArgs.Action.Action == "prune" {
+		return cmd.prune()
+	}
+	return cmd.stats()
+}
+
+func (cmd PushCacheCommand) prune() error {
+	cmd.UI.DisplayText("Pruning push cache...")
+
+	maxAge := PushCachePruneAge
+	if cmd.All {
+		maxAge = 0
+	}
+
+	pruned, err := cmd.Cache.Prune(maxAge)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Removed {{.Files}} file hashes and {{.Archives}} archives ({{.Size}}).", map[string]interface{}{
+		"Files":    pruned.Files,
+		"Archives": pruned.Archives,
+		"Size":     bytefmt.ByteSize(uint64(pruned.ArchivesSize)),
+	})
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd PushCacheCommand) stats() error {
+	cmd.UI.DisplayText("Getting push cache stats...")
+	cmd.UI.DisplayNewline()
+
+	stats, err := cmd.Cache.Stats()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("file hashes:"), fmt.Sprint(stats.Files)},
+		{cmd.UI.TranslateText("archives:"), fmt.Sprint(stats.Archives)},
+		{cmd.UI.TranslateText("archive size:"), bytefmt.ByteSize(uint64(stats.ArchivesSize))},
+	}, 3)
+	return nil
+}
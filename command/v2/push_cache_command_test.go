@@ -0,0 +1,103 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/pushcache"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("push-cache Command", func() {
+	var (
+		cmd        PushCacheCommand
+		testUI     *ui.UI
+		fakeCache  *v2fakes.FakePushCache
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeCache = new(v2fakes.FakePushCache)
+
+		cmd = PushCacheCommand{
+			UI:    testUI,
+			Cache: fakeCache,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the action is stats", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.PushCacheAction{Action: "stats"}
+			fakeCache.StatsReturns(pushcache.Stats{Files: 12, Archives: 3, ArchivesSize: 3 * 1024 * 1024}, nil)
+		})
+
+		It("displays the contents of the cache", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting push cache stats\\.\\.\\."))
+			Expect(testUI.Out).To(Say(`file hashes:\s+12`))
+			Expect(testUI.Out).To(Say(`archives:\s+3`))
+			Expect(testUI.Out).To(Say(`archive size:\s+3M`))
+		})
+
+		Context("when reading the cache fails", func() {
+			BeforeEach(func() {
+				fakeCache.StatsReturns(pushcache.Stats{}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Context("when the action is prune", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.PushCacheAction{Action: "prune"}
+			fakeCache.PruneReturns(pushcache.Stats{Files: 2, Archives: 1, ArchivesSize: 1024}, nil)
+		})
+
+		It("prunes archives unused for a week and displays what was removed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeCache.PruneCallCount()).To(Equal(1))
+			Expect(fakeCache.PruneArgsForCall(0)).To(Equal(7 * 24 * time.Hour))
+
+			Expect(testUI.Out).To(Say("Pruning push cache\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Removed 2 file hashes and 1 archives \\(1K\\)\\."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when --all is provided", func() {
+			BeforeEach(func() {
+				cmd.All = true
+			})
+
+			It("prunes every archive", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCache.PruneArgsForCall(0)).To(BeZero())
+			})
+		})
+
+		Context("when pruning fails", func() {
+			BeforeEach(func() {
+				fakeCache.PruneReturns(pushcache.Stats{}, pushcache.LockTimeoutError{Path: "some-lock"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(pushcache.LockTimeoutError{Path: "some-lock"}))
+			})
+		})
+	})
+})
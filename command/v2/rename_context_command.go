@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type RenameContextCommand struct {
+	RequiredArgs    flag.RenameContextArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-context CONTEXT_NAME NEW_CONTEXT_NAME"`
+	relatedCommands interface{}            `related_commands:"contexts"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *RenameContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd RenameContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Renaming context {{.OldContextName}} to {{.NewContextName}}...", map[string]interface{}{
+		"OldContextName": cmd.RequiredArgs.OldContextName,
+		"NewContextName": cmd.RequiredArgs.NewContextName,
+	})
+
+	err := cmd.Config.RenameContext(cmd.RequiredArgs.OldContextName, cmd.RequiredArgs.NewContextName)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
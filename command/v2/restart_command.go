@@ -6,7 +6,6 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
-	"github.com/cloudfoundry/noaa/consumer"
 )
 
 //go:generate counterfeiter . RestartActor
@@ -27,7 +26,7 @@ type RestartCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RestartActor
-	NOAAClient  *consumer.Consumer
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
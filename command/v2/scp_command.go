@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Copy directories recursively"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME scp [-i app-instance-index] [--recursive] [--skip-host-validation] SOURCE TARGET\n\n   Either SOURCE or TARGET must be APP_NAME:PATH. Paths on the application instance are relative to its home directory.\n\nEXAMPLES:\n   CF_NAME scp ./config.yml my-app:app/config.yml\n   CF_NAME scp -i 1 my-app:logs/app.log .\n   CF_NAME scp -r my-app:app/tmp ./tmp"`
+	relatedCommands    interface{}  `related_commands:"ssh, ssh-code"`
+}
+
+func (SCPCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (SCPCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -74,6 +74,10 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.SecurityGroupListDocumentKind, shared.NewSecurityGroupBindingDocuments(secGroupOrgSpaces))
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
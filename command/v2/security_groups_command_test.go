@@ -180,6 +180,38 @@ var _ = Describe("security-groups Command", func() {
 				Expect(testUI.Err).To(Say("warning-1"))
 				Expect(testUI.Err).To(Say("warning-2"))
 			})
+
+			Context("when the output format is JSON", func() {
+				var documentOut *Buffer
+
+				BeforeEach(func() {
+					documentOut = NewBuffer()
+					testUI.OutputFormat = ui.OutputFormatJSON
+					testUI.DocumentOut = documentOut
+				})
+
+				It("displays the security group bindings as a JSON document and all warnings", func() {
+					Expect(executeErr).To(BeNil())
+
+					Expect(documentOut.Contents()).To(MatchJSON(`{
+						"schema_version": 1,
+						"kind": "security_group_list",
+						"data": [
+							{"name": "seg-group-1", "org": "org-11", "space": "space-111", "lifecycle": "running", "global": false},
+							{"name": "seg-group-1", "org": "org-12", "space": "space-121", "lifecycle": "running", "global": false},
+							{"name": "seg-group-1", "org": "org-12", "space": "space-122", "lifecycle": "staging", "global": false},
+							{"name": "seg-group-2", "org": "", "space": "", "lifecycle": "", "global": false},
+							{"name": "seg-group-3", "org": "org-31", "space": "space-311", "lifecycle": "running", "global": false},
+							{"name": "seg-group-4", "org": "", "space": "", "lifecycle": "running", "global": true},
+							{"name": "seg-group-4", "org": "", "space": "", "lifecycle": "staging", "global": true}
+						]
+					}`))
+					Expect(testUI.Out).ToNot(Say("OK"))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
 		})
 
 		Context("when an error is encountered fetching the security groups", func() {
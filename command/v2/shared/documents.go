@@ -0,0 +1,226 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+// Document kinds displayed by the V2 commands with --output.
+const (
+	AppDocumentKind               = "app"
+	AppListDocumentKind           = "app_list"
+	OrgDocumentKind               = "org"
+	SecurityGroupListDocumentKind = "security_group_list"
+	SpaceDocumentKind             = "space"
+)
+
+// AppDocument is the structured representation of an application summary.
+type AppDocument struct {
+	Name             string                `json:"name" yaml:"name"`
+	GUID             string                `json:"guid" yaml:"guid"`
+	RequestedState   string                `json:"requested_state" yaml:"requested_state"`
+	Instances        int                   `json:"instances" yaml:"instances"`
+	RunningInstances int                   `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64                `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                `json:"disk_in_mb" yaml:"disk_in_mb"`
+	IsolationSegment string                `json:"isolation_segment" yaml:"isolation_segment"`
+	Routes           []string              `json:"routes" yaml:"routes"`
+	LastUploaded     string                `json:"last_uploaded" yaml:"last_uploaded"`
+	Stack            string                `json:"stack" yaml:"stack"`
+	Buildpack        string                `json:"buildpack" yaml:"buildpack"`
+	HealthCheckType  string                `json:"health_check_type" yaml:"health_check_type"`
+	InstanceStats    []AppInstanceDocument `json:"instance_stats" yaml:"instance_stats"`
+}
+
+// AppInstanceDocument is the structured representation of a running
+// application instance.
+type AppInstanceDocument struct {
+	Index              int     `json:"index" yaml:"index"`
+	State              string  `json:"state" yaml:"state"`
+	Since              string  `json:"since" yaml:"since"`
+	CPU                float64 `json:"cpu" yaml:"cpu"`
+	MemoryInBytes      int     `json:"memory_in_bytes" yaml:"memory_in_bytes"`
+	MemoryQuotaInBytes int     `json:"memory_quota_in_bytes" yaml:"memory_quota_in_bytes"`
+	DiskInBytes        int     `json:"disk_in_bytes" yaml:"disk_in_bytes"`
+	DiskQuotaInBytes   int     `json:"disk_quota_in_bytes" yaml:"disk_quota_in_bytes"`
+	Details            string  `json:"details" yaml:"details"`
+}
+
+// OrgDocument is the structured representation of an organization summary.
+type OrgDocument struct {
+	Name                    string   `json:"name" yaml:"name"`
+	GUID                    string   `json:"guid" yaml:"guid"`
+	Quota                   string   `json:"quota" yaml:"quota"`
+	Domains                 []string `json:"domains" yaml:"domains"`
+	Spaces                  []string `json:"spaces" yaml:"spaces"`
+	IsolationSegments       []string `json:"isolation_segments" yaml:"isolation_segments"`
+	DefaultIsolationSegment string   `json:"default_isolation_segment" yaml:"default_isolation_segment"`
+}
+
+// SpaceDocument is the structured representation of a space summary.
+type SpaceDocument struct {
+	Name                  string                      `json:"name" yaml:"name"`
+	GUID                  string                      `json:"guid" yaml:"guid"`
+	Org                   string                      `json:"org" yaml:"org"`
+	Apps                  []string                    `json:"apps" yaml:"apps"`
+	Services              []string                    `json:"services" yaml:"services"`
+	IsolationSegment      string                      `json:"isolation_segment" yaml:"isolation_segment"`
+	SpaceQuota            string                      `json:"space_quota" yaml:"space_quota"`
+	RunningSecurityGroups []string                    `json:"running_security_groups" yaml:"running_security_groups"`
+	StagingSecurityGroups []string                    `json:"staging_security_groups" yaml:"staging_security_groups"`
+	SecurityGroupRules    []SecurityGroupRuleDocument `json:"security_group_rules,omitempty" yaml:"security_group_rules,omitempty"`
+}
+
+// SecurityGroupRuleDocument is the structured representation of a security
+// group rule applied to a space.
+type SecurityGroupRuleDocument struct {
+	SecurityGroup string `json:"security_group" yaml:"security_group"`
+	Destination   string `json:"destination" yaml:"destination"`
+	Ports         string `json:"ports" yaml:"ports"`
+	Protocol      string `json:"protocol" yaml:"protocol"`
+	Lifecycle     string `json:"lifecycle" yaml:"lifecycle"`
+	Description   string `json:"description" yaml:"description"`
+}
+
+// SecurityGroupBindingDocument is the structured representation of a security
+// group bound to a space for a lifecycle. Org and space are empty for
+// globally bound security groups.
+type SecurityGroupBindingDocument struct {
+	Name      string `json:"name" yaml:"name"`
+	Org       string `json:"org" yaml:"org"`
+	Space     string `json:"space" yaml:"space"`
+	Lifecycle string `json:"lifecycle" yaml:"lifecycle"`
+	Global    bool   `json:"global" yaml:"global"`
+}
+
+// NewAppDocument converts an application summary to an AppDocument.
+func NewAppDocument(appSummary v2action.ApplicationSummary) AppDocument {
+	routes := []string{}
+	for _, route := range appSummary.Routes {
+		routes = append(routes, route.String())
+	}
+
+	instances := []AppInstanceDocument{}
+	for _, instance := range appSummary.RunningInstances {
+		instances = append(instances, AppInstanceDocument{
+			Index:              instance.ID,
+			State:              strings.ToLower(string(instance.State)),
+			Since:              zuluDate(instance.TimeSinceCreation()),
+			CPU:                instance.CPU,
+			MemoryInBytes:      instance.Memory,
+			MemoryQuotaInBytes: instance.MemoryQuota,
+			DiskInBytes:        instance.Disk,
+			DiskQuotaInBytes:   instance.DiskQuota,
+			Details:            instance.Details,
+		})
+	}
+
+	return AppDocument{
+		Name:             appSummary.Name,
+		GUID:             appSummary.GUID,
+		RequestedState:   strings.ToLower(string(appSummary.State)),
+		Instances:        appSummary.Instances,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		MemoryInMB:       appSummary.Memory,
+		DiskInMB:         appSummary.DiskQuota,
+		IsolationSegment: appSummary.IsolationSegment,
+		Routes:           routes,
+		LastUploaded:     documentDate(appSummary.PackageUpdatedAt),
+		Stack:            appSummary.Stack.Name,
+		Buildpack:        appSummary.Application.CalculatedBuildpack(),
+		HealthCheckType:  appSummary.HealthCheckType,
+		InstanceStats:    instances,
+	}
+}
+
+// NewOrgDocument converts an organization summary and the names of the
+// isolation segments entitled to it to an OrgDocument.
+func NewOrgDocument(orgSummary v2action.OrganizationSummary, isolationSegments []string, defaultIsolationSegment string) OrgDocument {
+	return OrgDocument{
+		Name:                    orgSummary.Name,
+		GUID:                    orgSummary.GUID,
+		Quota:                   orgSummary.QuotaName,
+		Domains:                 documentStrings(orgSummary.DomainNames),
+		Spaces:                  documentStrings(orgSummary.SpaceNames),
+		IsolationSegments:       documentStrings(isolationSegments),
+		DefaultIsolationSegment: defaultIsolationSegment,
+	}
+}
+
+// NewSpaceDocument converts a space summary and the name of its effective
+// isolation segment to a SpaceDocument. Security group rules are only
+// included when includeRules is true.
+func NewSpaceDocument(spaceSummary v2action.SpaceSummary, isolationSegment string, includeRules bool) SpaceDocument {
+	document := SpaceDocument{
+		Name:                  spaceSummary.Name,
+		GUID:                  spaceSummary.GUID,
+		Org:                   spaceSummary.OrgName,
+		Apps:                  documentStrings(spaceSummary.AppNames),
+		Services:              documentStrings(spaceSummary.ServiceInstanceNames),
+		IsolationSegment:      isolationSegment,
+		SpaceQuota:            spaceSummary.SpaceQuotaName,
+		RunningSecurityGroups: documentStrings(spaceSummary.RunningSecurityGroupNames),
+		StagingSecurityGroups: documentStrings(spaceSummary.StagingSecurityGroupNames),
+	}
+
+	if includeRules {
+		document.SecurityGroupRules = []SecurityGroupRuleDocument{}
+		for _, rule := range spaceSummary.SecurityGroupRules {
+			document.SecurityGroupRules = append(document.SecurityGroupRules, SecurityGroupRuleDocument{
+				SecurityGroup: rule.Name,
+				Destination:   rule.Destination,
+				Ports:         rule.Ports,
+				Protocol:      rule.Protocol,
+				Lifecycle:     string(rule.Lifecycle),
+				Description:   rule.Description,
+			})
+		}
+	}
+
+	return document
+}
+
+// NewSecurityGroupBindingDocuments converts security group bindings to
+// SecurityGroupBindingDocuments.
+func NewSecurityGroupBindingDocuments(secGroupOrgSpaces []v2action.SecurityGroupWithOrganizationSpaceAndLifecycle) []SecurityGroupBindingDocument {
+	documents := []SecurityGroupBindingDocument{}
+	for _, secGroupOrgSpace := range secGroupOrgSpaces {
+		document := SecurityGroupBindingDocument{
+			Name:      secGroupOrgSpace.SecurityGroup.Name,
+			Lifecycle: string(secGroupOrgSpace.Lifecycle),
+		}
+
+		if secGroupOrgSpace.Organization != nil {
+			document.Org = secGroupOrgSpace.Organization.Name
+		}
+		if secGroupOrgSpace.Space != nil {
+			document.Space = secGroupOrgSpace.Space.Name
+		}
+		document.Global = document.Org == "" && document.Space == "" &&
+			(secGroupOrgSpace.SecurityGroup.RunningDefault || secGroupOrgSpace.SecurityGroup.StagingDefault)
+
+		documents = append(documents, document)
+	}
+
+	return documents
+}
+
+// documentDate formats the time as RFC3339 in UTC, or returns an empty string
+// for the zero time.
+func documentDate(input time.Time) string {
+	if input.IsZero() {
+		return ""
+	}
+	return zuluDate(input)
+}
+
+// documentStrings makes sure empty lists are displayed as empty lists instead
+// of null.
+func documentStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
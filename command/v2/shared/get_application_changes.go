@@ -1,7 +1,10 @@
 package shared
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bytefmt"
 )
@@ -144,9 +147,37 @@ func GetApplicationChanges(appConfig pushaction.ApplicationConfig) []ui.Change {
 			NewValue:     desiredRotues,
 		})
 
+	if len(appConfig.CurrentServices) > 0 || len(appConfig.DesiredServices) > 0 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "services:",
+				CurrentValue: sortedServiceNames(appConfig.CurrentServices),
+				NewValue:     sortedServiceNames(appConfig.DesiredServices),
+			})
+	}
+
 	return changes
 }
 
+// HasApplicationChanges returns true if any of the changes has a new value
+// that differs from its current value.
+func HasApplicationChanges(changes []ui.Change) bool {
+	for _, change := range changes {
+		switch current := change.CurrentValue.(type) {
+		case []string:
+			desired, _ := change.NewValue.([]string)
+			if !sameStrings(current, desired) {
+				return true
+			}
+		default:
+			if change.CurrentValue != change.NewValue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func SelectNonBlankValue(str ...string) string {
 	for _, s := range str {
 		if s != "" {
@@ -156,6 +187,32 @@ func SelectNonBlankValue(str ...string) string {
 	return ""
 }
 
+func sortedServiceNames(services map[string]v2action.ServiceInstance) []string {
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sameStrings(list1 []string, list2 []string) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+
+	sorted1 := append([]string{}, list1...)
+	sorted2 := append([]string{}, list2...)
+	sort.Strings(sorted1)
+	sort.Strings(sorted2)
+	for i := range sorted1 {
+		if sorted1[i] != sorted2[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func MegabytesToString(value uint64) string {
 	return bytefmt.ByteSize(bytefmt.MEGABYTE * uint64(value))
 }
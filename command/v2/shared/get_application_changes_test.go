@@ -420,4 +420,50 @@ var _ = Describe("GetApplicationChanges", func() {
 		})
 	})
 
+	Context("services", func() {
+		Describe("when there are no current or desired services", func() {
+			It("does not provide a services change", func() {
+				for i, change := range changes {
+					Expect(change.Header).ToNot(Equal("services:"), fmt.Sprintf("entry %d should not be services", i))
+				}
+			})
+		})
+
+		Describe("when there are services", func() {
+			BeforeEach(func() {
+				appConfig.CurrentServices = map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1"},
+				}
+				appConfig.DesiredServices = map[string]v2action.ServiceInstance{
+					"service-2": {Name: "service-2"},
+					"service-1": {Name: "service-1"},
+				}
+			})
+
+			It("sets the fourth change to the sorted service names", func() {
+				Expect(changes[3]).To(Equal(ui.Change{
+					Header:       "services:",
+					CurrentValue: []string{"service-1"},
+					NewValue:     []string{"service-1", "service-2"},
+				}))
+			})
+		})
+	})
+
+})
+
+var _ = Describe("HasApplicationChanges", func() {
+	DescribeTable("comparing current and new values",
+		func(changes []ui.Change, expected bool) {
+			Expect(HasApplicationChanges(changes)).To(Equal(expected))
+		},
+		Entry("no changes", nil, false),
+		Entry("equal strings", []ui.Change{{Header: "name:", CurrentValue: "app", NewValue: "app"}}, false),
+		Entry("different strings", []ui.Change{{Header: "name:", CurrentValue: "", NewValue: "app"}}, true),
+		Entry("equal ints", []ui.Change{{Header: "instances:", CurrentValue: 2, NewValue: 2}}, false),
+		Entry("different ints", []ui.Change{{Header: "instances:", CurrentValue: 1, NewValue: 2}}, true),
+		Entry("the same strings in a different order", []ui.Change{{Header: "routes:", CurrentValue: []string{"a", "b"}, NewValue: []string{"b", "a"}}}, false),
+		Entry("nil and empty lists", []ui.Change{{Header: "routes:", CurrentValue: []string(nil), NewValue: []string{}}}, false),
+		Entry("different lists", []ui.Change{{Header: "routes:", CurrentValue: []string{"a"}, NewValue: []string{"a", "b"}}}, true),
+	)
 })
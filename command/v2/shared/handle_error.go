@@ -7,6 +7,8 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 )
 
 func HandleError(err error) error {
@@ -62,14 +64,38 @@ func HandleError(err error) error {
 		return translatableerror.AppNotFoundInManifestError(e)
 	case pushaction.CommandLineOptionsWithMultipleAppsError:
 		return translatableerror.CommandLineArgsWithMultipleAppsError{}
+	case pushaction.DependencyCycleError:
+		return translatableerror.DependencyCycleError(e)
+	case pushaction.DockerPasswordNotSetError:
+		return translatableerror.DockerPasswordNotSetError{}
 	case pushaction.NoDomainsFoundError:
 		return translatableerror.NoDomainsFoundError{}
+	case pushaction.NoMatchingDomainError:
+		return translatableerror.NoMatchingDomainError(e)
 	case pushaction.NonexistentAppPathError:
 		return translatableerror.FileNotFoundError(e)
 	case pushaction.MissingNameError:
 		return translatableerror.RequiredNameForPushError{}
+	case pushaction.ReplacementApplicationExistsError:
+		return translatableerror.ReplacementApplicationExistsError(e)
+	case pushaction.UnknownDependencyError:
+		return translatableerror.UnknownDependencyError(e)
+	case pushaction.UploadChecksumError:
+		return translatableerror.UploadChecksumError(e)
 	case pushaction.UploadFailedError:
 		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
+
+	case manifestvars.InvalidVarError:
+		return translatableerror.InvalidVarError(e)
+	case manifestvars.InvalidVarsFileError:
+		return translatableerror.InvalidVarsFileError(e)
+	case manifestvars.UnresolvedVariablesError:
+		return translatableerror.UnresolvedVariablesError(e)
+
+	case configv3.ContextNotFoundError:
+		return translatableerror.ContextNotFoundError(e)
+	case configv3.ContextAlreadyExistsError:
+		return translatableerror.ContextAlreadyExistsError(e)
 	}
 
 	return err
@@ -10,6 +10,8 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestvars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -119,6 +121,26 @@ var _ = Describe("HandleError", func() {
 			translatableerror.AppNotFoundInManifestError{Name: "some-app"},
 		),
 
+		Entry("pushaction.DependencyCycleError -> DependencyCycleError",
+			pushaction.DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}},
+			translatableerror.DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}},
+		),
+
+		Entry("pushaction.UnknownDependencyError -> UnknownDependencyError",
+			pushaction.UnknownDependencyError{AppName: "app-1", Dependency: "app-2"},
+			translatableerror.UnknownDependencyError{AppName: "app-1", Dependency: "app-2"},
+		),
+
+		Entry("pushaction.DockerPasswordNotSetError -> DockerPasswordNotSetError",
+			pushaction.DockerPasswordNotSetError{},
+			translatableerror.DockerPasswordNotSetError{},
+		),
+
+		Entry("pushaction.NoMatchingDomainError -> NoMatchingDomainError",
+			pushaction.NoMatchingDomainError{Route: "some-route"},
+			translatableerror.NoMatchingDomainError{Route: "some-route"},
+		),
+
 		Entry("pushaction.NoDomainsFoundError -> NoDomainsFoundError",
 			pushaction.NoDomainsFoundError{OrganizationGUID: "some-guid"},
 			translatableerror.NoDomainsFoundError{},
@@ -129,6 +151,16 @@ var _ = Describe("HandleError", func() {
 			translatableerror.RequiredNameForPushError{},
 		),
 
+		Entry("pushaction.ReplacementApplicationExistsError -> ReplacementApplicationExistsError",
+			pushaction.ReplacementApplicationExistsError{Name: "some-app-new"},
+			translatableerror.ReplacementApplicationExistsError{Name: "some-app-new"},
+		),
+
+		Entry("pushaction.UploadChecksumError -> UploadChecksumError",
+			pushaction.UploadChecksumError{Files: []string{"file-1", "file-2"}},
+			translatableerror.UploadChecksumError{Files: []string{"file-1", "file-2"}},
+		),
+
 		Entry("pushaction.UploadFailedError -> UploadFailedError",
 			pushaction.UploadFailedError{Err: pushaction.NoDomainsFoundError{}},
 			translatableerror.UploadFailedError{Err: translatableerror.NoDomainsFoundError{}},
@@ -144,6 +176,31 @@ var _ = Describe("HandleError", func() {
 			translatableerror.CommandLineArgsWithMultipleAppsError{},
 		),
 
+		Entry("manifestvars.InvalidVarError -> InvalidVarError",
+			manifestvars.InvalidVarError{Var: "some-var"},
+			translatableerror.InvalidVarError{Var: "some-var"},
+		),
+
+		Entry("manifestvars.InvalidVarsFileError -> InvalidVarsFileError",
+			manifestvars.InvalidVarsFileError{Path: "some-path"},
+			translatableerror.InvalidVarsFileError{Path: "some-path"},
+		),
+
+		Entry("manifestvars.UnresolvedVariablesError -> UnresolvedVariablesError",
+			manifestvars.UnresolvedVariablesError{Names: []string{"some-var"}},
+			translatableerror.UnresolvedVariablesError{Names: []string{"some-var"}},
+		),
+
+		Entry("configv3.ContextNotFoundError -> ContextNotFoundError",
+			configv3.ContextNotFoundError{Name: "some-context"},
+			translatableerror.ContextNotFoundError{Name: "some-context"},
+		),
+
+		Entry("configv3.ContextAlreadyExistsError -> ContextAlreadyExistsError",
+			configv3.ContextAlreadyExistsError{Name: "some-context"},
+			translatableerror.ContextAlreadyExistsError{Name: "some-context"},
+		),
+
 		Entry("default case -> original error",
 			err,
 			err),
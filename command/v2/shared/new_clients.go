@@ -3,16 +3,31 @@ package shared
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+	"code.cloudfoundry.org/cli/api/retry"
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/cassette"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
 // passed in config.
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
+	ccRawWrappers := []ccv2.ConnectionWrapper{}
+	uaaRawWrappers := []uaa.ConnectionWrapper{}
+
+	requestCassette, err := cassette.Open(config.RecordPath(), config.ReplayPath())
+	if err != nil {
+		return nil, nil, err
+	}
+	if requestCassette != nil {
+		ccRawWrappers = append(ccRawWrappers, ccWrapper.NewRecorder(requestCassette))
+		uaaRawWrappers = append(uaaRawWrappers, uaaWrapper.NewRecorder(requestCassette))
+	}
 
 	verbose, location := config.Verbose()
 	if verbose {
@@ -23,10 +38,21 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
+	tracer := tracing.Current()
+	if tracer != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTracer(tracer))
+	}
+
+	authWrapper := ccWrapper.NewUAAAuthentication(nil, config, config.TokenRefreshSkew())
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	if config.MaxRequestsPerSecond() > 0 {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRateLimiter(ratelimit.NewLimiter(config.MaxRequestsPerSecond())))
+	}
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
+	if config.HTTPCacheEnabled() {
+		ccWrappers = append(ccWrappers, NewResponseCache(config))
+	}
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -34,6 +60,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 		Wrappers:           ccWrappers,
+		RawWrappers:        ccRawWrappers,
 	})
 
 	if !targetCF {
@@ -46,7 +73,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv2.TargetSettings{
+	_, err = ccClient.TargetCF(ccv2.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
@@ -60,7 +87,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		AppVersion:        config.BinaryVersion(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		DialTimeout:       config.DialTimeout(),
+		RawWrappers:       uaaRawWrappers,
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.TokenEndpoint(),
 	})
@@ -72,8 +101,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	if tracer != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestTracer(tracer))
+	}
+
+	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config, config.TokenRefreshSkew()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
 
 	authWrapper.SetClient(uaaClient)
 
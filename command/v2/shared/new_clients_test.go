@@ -1,12 +1,20 @@
 package shared_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/cassette"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -73,4 +81,79 @@ var _ = Describe("New Clients", func() {
 			Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(0))
 		})
 	})
+
+	Context("when replaying a cassette", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "new-clients")
+			Expect(err).ToNot(HaveOccurred())
+
+			interaction, err := json.Marshal(cassette.Interaction{
+				Request: cassette.Request{Method: http.MethodGet, Path: "/v2/info"},
+				Response: cassette.Response{
+					StatusCode: http.StatusOK,
+					Body:       `{"api_version":"2.59.0","token_endpoint":"https://uaa.potato.bananapants11122.co.uk"}`,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			cassettePath := filepath.Join(dir, "cassette.jsonl")
+			Expect(ioutil.WriteFile(cassettePath, interaction, 0600)).To(Succeed())
+
+			fakeConfig.TargetReturns("https://potato.bananapants11122.co.uk")
+			fakeConfig.ReplayPathReturns(cassettePath)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("targets the API from the cassette", func() {
+			ccClient, uaaClient, err := NewClients(fakeConfig, testUI, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ccClient.APIVersion()).To(Equal("2.59.0"))
+			Expect(uaaClient.URL).To(Equal("https://uaa.potato.bananapants11122.co.uk"))
+		})
+
+		Context("when tracing is on", func() {
+			var (
+				fakeExporter *tracingfakes.FakeExporter
+				tracer       *tracing.Tracer
+			)
+
+			BeforeEach(func() {
+				fakeExporter = new(tracingfakes.FakeExporter)
+				tracer = tracing.NewTracer(fakeExporter)
+				tracer.StartCommand("cf api")
+				tracing.SetCurrent(tracer)
+			})
+
+			AfterEach(func() {
+				tracing.SetCurrent(nil)
+			})
+
+			It("records a span for each request", func() {
+				_, _, err := NewClients(fakeConfig, testUI, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(tracer.Flush()).To(Succeed())
+				spans := fakeExporter.ExportArgsForCall(0)
+				Expect(spans).To(HaveLen(1))
+				Expect(spans[0].Name).To(Equal("GET /v2/info"))
+			})
+		})
+	})
+
+	Context("when the replay cassette does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.ReplayPathReturns("does-not-exist")
+		})
+
+		It("returns an error", func() {
+			_, _, err := NewClients(fakeConfig, testUI, false)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
 })
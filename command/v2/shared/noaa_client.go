@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
@@ -20,6 +22,7 @@ type RequestLoggerOutput interface {
 
 type DebugPrinter struct {
 	outputs []RequestLoggerOutput
+	tracer  *WebsocketTracer
 }
 
 func (p *DebugPrinter) addOutput(output RequestLoggerOutput) {
@@ -27,6 +30,10 @@ func (p *DebugPrinter) addOutput(output RequestLoggerOutput) {
 }
 
 func (p DebugPrinter) Print(title string, dump string) {
+	if p.tracer != nil {
+		p.tracer.Print(title, dump)
+	}
+
 	for _, output := range p.outputs {
 		_ = output.Start()
 		defer output.Stop()
@@ -37,8 +44,9 @@ func (p DebugPrinter) Print(title string, dump string) {
 
 }
 
-// NewNOAAClient returns back a configured NOAA Client.
-func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *consumer.Consumer {
+// NewNOAAClient returns back a configured NOAA Client. When the command is
+// traced, the client records spans for its recent logs requests.
+func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) v2action.NOAAClient {
 	client := consumer.New(
 		apiURL,
 		&tls.Config{
@@ -50,6 +58,10 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 	client.SetMaxRetryCount(5)
 
 	noaaDebugPrinter := DebugPrinter{}
+	tracer := tracing.Current()
+	if tracer != nil {
+		noaaDebugPrinter.tracer = NewWebsocketTracer(tracer)
+	}
 
 	// if verbose, set debug printer on noaa client
 	verbose, location := config.Verbose()
@@ -63,5 +75,8 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
 	}
 
+	if tracer != nil {
+		return NewTracedNOAAClient(client, tracer, apiURL)
+	}
 	return client
 }
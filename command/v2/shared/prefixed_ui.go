@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// PrefixedUI prefixes every line of text, warning and log displayed with the
+// name of an app, so that the output of apps pushed at the same time can be
+// told apart. Blank lines are dropped because they would separate output of
+// other apps.
+type PrefixedUI struct {
+	command.UI
+	Prefix string
+}
+
+// NewPrefixedUI returns a PrefixedUI that prefixes output with "[prefix]".
+func NewPrefixedUI(ui command.UI, prefix string) PrefixedUI {
+	return PrefixedUI{
+		UI:     ui,
+		Prefix: "[" + prefix + "]",
+	}
+}
+
+func (p PrefixedUI) DisplayNewline() {}
+
+func (p PrefixedUI) DisplayText(template string, data ...map[string]interface{}) {
+	p.displayPrefixed(p.UI.TranslateText(template, data...))
+}
+
+func (p PrefixedUI) DisplayTextWithFlavor(template string, data ...map[string]interface{}) {
+	p.displayPrefixed(p.UI.TranslateText(template, data...))
+}
+
+func (p PrefixedUI) DisplayWarning(template string, data ...map[string]interface{}) {
+	p.UI.DisplayWarning("{{.Prefix}} {{.Warning}}", map[string]interface{}{
+		"Prefix":  p.Prefix,
+		"Warning": p.UI.TranslateText(template, data...),
+	})
+}
+
+func (p PrefixedUI) DisplayWarnings(warnings []string) {
+	for _, warning := range warnings {
+		p.DisplayWarning(warning)
+	}
+}
+
+func (p PrefixedUI) DisplayLogMessage(message ui.LogMessage, displayHeader bool) {
+	p.UI.DisplayLogMessage(prefixedLogMessage{LogMessage: message, prefix: p.Prefix}, displayHeader)
+}
+
+func (p PrefixedUI) displayPrefixed(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		p.UI.DisplayText("{{.Prefix}} {{.Line}}", map[string]interface{}{
+			"Prefix": p.Prefix,
+			"Line":   line,
+		})
+	}
+}
+
+type prefixedLogMessage struct {
+	ui.LogMessage
+	prefix string
+}
+
+func (m prefixedLogMessage) Message() string {
+	lines := strings.Split(m.LogMessage.Message(), "\n")
+	for i, line := range lines {
+		lines[i] = m.prefix + " " + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// TranslateError returns the translated message of err.
+func TranslateError(commandUI command.UI, err error) string {
+	translatableError, ok := err.(ui.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableError.Translate(func(template string, data ...interface{}) string {
+		var templateValues []map[string]interface{}
+		for _, value := range data {
+			if values, ok := value.(map[string]interface{}); ok {
+				templateValues = append(templateValues, values)
+			}
+		}
+		return commandUI.TranslateText(template, templateValues...)
+	})
+}
@@ -0,0 +1,67 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PrefixedUI", func() {
+	var (
+		testUI     *ui.UI
+		prefixedUI PrefixedUI
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		prefixedUI = NewPrefixedUI(testUI, "some-app")
+	})
+
+	It("prefixes every line of text", func() {
+		prefixedUI.DisplayText("Uploading {{.Name}}...\nsecond line", map[string]interface{}{"Name": "files"})
+		prefixedUI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{"AppName": "some-app"})
+		prefixedUI.DisplayNewline()
+
+		Expect(testUI.Out).To(Say(`\[some-app\] Uploading files\.\.\.\n`))
+		Expect(testUI.Out).To(Say(`\[some-app\] second line\n`))
+		Expect(testUI.Out).To(Say(`\[some-app\] Creating app some-app\.\.\.\n$`))
+	})
+
+	It("prefixes warnings", func() {
+		prefixedUI.DisplayWarnings([]string{"warning-1", "warning-2"})
+
+		Expect(testUI.Err).To(Say(`\[some-app\] warning-1\n`))
+		Expect(testUI.Err).To(Say(`\[some-app\] warning-2\n`))
+	})
+
+	It("prefixes every line of log messages", func() {
+		prefixedUI.DisplayLogMessage(v2action.NewLogMessage("log line 1\nlog line 2", 1, time.Now(), "STG", "1"), false)
+
+		Expect(testUI.Out).To(Say(`\[some-app\] log line 1\n`))
+		Expect(testUI.Out).To(Say(`\[some-app\] log line 2\n`))
+	})
+})
+
+var _ = Describe("TranslateError", func() {
+	var testUI *ui.UI
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	It("translates translatable errors", func() {
+		Expect(TranslateError(testUI, translatableerror.ApplicationNotFoundError{Name: "some-app"})).To(Equal("App some-app not found"))
+	})
+
+	It("returns the message of other errors", func() {
+		Expect(TranslateError(testUI, errors.New("some-error"))).To(Equal("some-error"))
+	})
+})
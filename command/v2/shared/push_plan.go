@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// DisplayPushPlan displays the routes, services and bits a push would
+// change. The file rows are omitted when no resources were planned, such as
+// for docker apps.
+func DisplayPushPlan(ui command.UI, plan pushaction.PushPlan) {
+	table := [][]string{
+		{ui.TranslateText("routes to create:"), routeList(ui, plan.RoutesToCreate)},
+		{ui.TranslateText("routes to map:"), routeList(ui, plan.RoutesToBind)},
+		{ui.TranslateText("routes to unmap:"), routeList(ui, plan.RoutesToUnbind)},
+		{ui.TranslateText("services to bind:"), nameList(ui, plan.ServicesToBind)},
+		{ui.TranslateText("environment variables to set:"), nameList(ui, plan.EnvironmentVariablesToSet)},
+	}
+
+	if len(plan.MatchedResources) > 0 || len(plan.UnmatchedResources) > 0 {
+		table = append(table,
+			[]string{
+				ui.TranslateText("files to upload:"),
+				ui.TranslateText("{{.Count}} ({{.Size}})", map[string]interface{}{
+					"Count": len(plan.UnmatchedResources),
+					"Size":  bytefmt.ByteSize(uint64(plan.UnmatchedSize())),
+				}),
+			},
+			[]string{
+				ui.TranslateText("files already uploaded:"),
+				ui.TranslateText("{{.Count}} ({{.Size}} saved)", map[string]interface{}{
+					"Count": len(plan.MatchedResources),
+					"Size":  bytefmt.ByteSize(uint64(plan.MatchedSize())),
+				}),
+			},
+		)
+	}
+
+	ui.DisplayKeyValueTable("", table, 3)
+}
+
+func routeList(ui command.UI, routes []v2action.Route) string {
+	var names []string
+	for _, route := range routes {
+		names = append(names, route.String())
+	}
+	return nameList(ui, names)
+}
+
+func nameList(ui command.UI, names []string) string {
+	if len(names) == 0 {
+		return ui.TranslateText("none")
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,59 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayPushPlan", func() {
+	var (
+		testUI *ui.UI
+		plan   pushaction.PushPlan
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		plan = pushaction.PushPlan{
+			RoutesToCreate: []v2action.Route{
+				{Host: "new", Domain: v2action.Domain{Name: "example.com"}},
+			},
+			RoutesToBind: []v2action.Route{
+				{Host: "new", Domain: v2action.Domain{Name: "example.com"}},
+				{Host: "existing", Domain: v2action.Domain{Name: "example.com"}},
+			},
+			ServicesToBind:     []string{"service-1", "service-2"},
+			MatchedResources:   []v2action.Resource{{Size: 2048}, {Size: 1024}},
+			UnmatchedResources: []v2action.Resource{{Size: 1024}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		DisplayPushPlan(testUI, plan)
+	})
+
+	It("displays the planned routes, services and files", func() {
+		Expect(testUI.Out).To(Say(`routes to create:\s+new\.example\.com`))
+		Expect(testUI.Out).To(Say(`routes to map:\s+new\.example\.com, existing\.example\.com`))
+		Expect(testUI.Out).To(Say(`routes to unmap:\s+none`))
+		Expect(testUI.Out).To(Say(`services to bind:\s+service-1, service-2`))
+		Expect(testUI.Out).To(Say(`files to upload:\s+1 \(1K\)`))
+		Expect(testUI.Out).To(Say(`files already uploaded:\s+2 \(3K saved\)`))
+	})
+
+	Context("when no resources were planned", func() {
+		BeforeEach(func() {
+			plan.MatchedResources = nil
+			plan.UnmatchedResources = nil
+		})
+
+		It("does not display the file rows", func() {
+			Expect(testUI.Out).ToNot(Say("files"))
+		})
+	})
+})
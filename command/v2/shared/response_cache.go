@@ -0,0 +1,23 @@
+package shared
+
+import (
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/httpcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// NewResponseCache returns a wrapper that caches Cloud Controller responses
+// under CF_HOME, separately for each target and user.
+func NewResponseCache(config command.Config) *ccWrapper.ResponseCache {
+	return ccWrapper.NewResponseCache(
+		httpcache.NewStore(configv3.HTTPCacheDir()),
+		httpcache.DefaultTTLs,
+		func() string {
+			// The user is looked up for every request because commands such as
+			// login change it.
+			user, _ := config.CurrentUser()
+			return config.Target() + "\n" + user.Name
+		},
+	)
+}
@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/tracing"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// TracedNOAAClient is a NOAA client that records a span for each request it
+// makes for an app's recent logs. NOAA does not expose these requests, so the
+// span covers the whole call and the traceparent header cannot be sent with
+// it.
+type TracedNOAAClient struct {
+	v2action.NOAAClient
+
+	tracer               *tracing.Tracer
+	trafficControllerURL string
+}
+
+// NewTracedNOAAClient returns a TracedNOAAClient that wraps client and
+// records spans with tracer.
+func NewTracedNOAAClient(client v2action.NOAAClient, tracer *tracing.Tracer, trafficControllerURL string) *TracedNOAAClient {
+	return &TracedNOAAClient{
+		NOAAClient:           client,
+		tracer:               tracer,
+		trafficControllerURL: trafficControllerURL,
+	}
+}
+
+// RecentLogs records a span for the request for the app's recent logs.
+func (client *TracedNOAAClient) RecentLogs(appGUID string, authToken string) ([]*events.LogMessage, error) {
+	path := fmt.Sprintf("/apps/%s/recentlogs", appGUID)
+
+	span := client.tracer.StartClient(http.MethodGet + " " + path)
+	span.SetAttribute(tracing.AttributePeerService, "doppler")
+	span.SetAttribute(tracing.AttributeMethod, http.MethodGet)
+	span.SetAttribute(tracing.AttributePath, path)
+	if trafficControllerURL, err := url.Parse(client.trafficControllerURL); err == nil {
+		span.SetAttribute(tracing.AttributeServerAddress, trafficControllerURL.Host)
+	}
+
+	logs, err := client.NOAAClient.RecentLogs(appGUID, authToken)
+	span.SetError(err)
+	span.Finish()
+	return logs, err
+}
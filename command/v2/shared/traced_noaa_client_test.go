@@ -0,0 +1,81 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/sonde-go/events"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TracedNOAAClient", func() {
+	var (
+		fakeNOAAClient *v2actionfakes.FakeNOAAClient
+		fakeExporter   *tracingfakes.FakeExporter
+		tracer         *tracing.Tracer
+		root           *tracing.Span
+
+		logs []*events.LogMessage
+		err  error
+	)
+
+	BeforeEach(func() {
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = tracing.NewTracer(fakeExporter)
+		root = tracer.StartCommand("cf logs")
+	})
+
+	JustBeforeEach(func() {
+		client := NewTracedNOAAClient(fakeNOAAClient, tracer, "wss://doppler.example.com:443")
+		logs, err = client.RecentLogs("some-app-guid", "some-token")
+
+		root.Finish()
+		Expect(tracer.Flush()).To(Succeed())
+	})
+
+	Context("when getting the recent logs succeeds", func() {
+		var message *events.LogMessage
+
+		BeforeEach(func() {
+			message = &events.LogMessage{Message: []byte("some-message")}
+			fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{message}, nil)
+		})
+
+		It("returns the logs and records a child span of the command", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logs).To(ConsistOf(message))
+
+			Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+			appGUID, authToken := fakeNOAAClient.RecentLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(authToken).To(Equal("some-token"))
+
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Name).To(Equal("GET /apps/some-app-guid/recentlogs"))
+			Expect(spans[0].ParentSpanID).To(Equal(root.SpanID))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("peer.service", "doppler"))
+			Expect(spans[0].Attributes).To(HaveKeyWithValue("server.address", "doppler.example.com:443"))
+			Expect(spans[0].Error).To(BeEmpty())
+		})
+	})
+
+	Context("when getting the recent logs fails", func() {
+		BeforeEach(func() {
+			fakeNOAAClient.RecentLogsReturns(nil, errors.New("no network"))
+		})
+
+		It("records the error", func() {
+			Expect(err).To(MatchError("no network"))
+
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans[0].Error).To(Equal("no network"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+)
+
+// WebsocketTracer records a span for each websocket connection the NOAA
+// client makes to the traffic controller. NOAA does not expose its requests,
+// so the spans are built from the messages it prints for debugging, and the
+// traceparent header cannot be sent with them.
+type WebsocketTracer struct {
+	tracer *tracing.Tracer
+
+	mutex sync.Mutex
+	span  *tracing.Span
+}
+
+// NewWebsocketTracer returns a WebsocketTracer that records spans with
+// tracer.
+func NewWebsocketTracer(tracer *tracing.Tracer) *WebsocketTracer {
+	return &WebsocketTracer{tracer: tracer}
+}
+
+// Print starts a span when NOAA dials the traffic controller, and finishes it
+// when the dial gets a response or fails.
+func (t *WebsocketTracer) Print(title string, dump string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	firstLine := strings.Fields(strings.SplitN(dump, "\n", 2)[0])
+
+	switch title {
+	case "WEBSOCKET REQUEST":
+		t.finish(nil)
+		if len(firstLine) < 2 {
+			return
+		}
+		t.span = t.tracer.StartClient(firstLine[0] + " " + firstLine[1])
+		t.span.SetAttribute(tracing.AttributePeerService, "doppler")
+		t.span.SetAttribute(tracing.AttributeMethod, firstLine[0])
+		t.span.SetAttribute(tracing.AttributePath, firstLine[1])
+	case "WEBSOCKET RESPONSE":
+		if t.span != nil && len(firstLine) >= 2 {
+			t.span.SetAttribute(tracing.AttributeStatusCode, firstLine[1])
+		}
+		t.finish(nil)
+	case "WEBSOCKET ERROR":
+		t.finish(errors.New(dump))
+	}
+}
+
+func (t *WebsocketTracer) finish(err error) {
+	if t.span == nil {
+		return
+	}
+	t.span.SetError(err)
+	t.span.Finish()
+	t.span = nil
+}
@@ -0,0 +1,61 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebsocketTracer", func() {
+	var (
+		fakeExporter    *tracingfakes.FakeExporter
+		tracer          *tracing.Tracer
+		websocketTracer *WebsocketTracer
+	)
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = tracing.NewTracer(fakeExporter)
+		tracer.StartCommand("cf logs")
+		websocketTracer = NewWebsocketTracer(tracer)
+	})
+
+	flush := func() []*tracing.Span {
+		Expect(tracer.Flush()).To(Succeed())
+		if fakeExporter.ExportCallCount() == 0 {
+			return nil
+		}
+		return fakeExporter.ExportArgsForCall(0)
+	}
+
+	It("records a span from each request to its response", func() {
+		websocketTracer.Print("WEBSOCKET REQUEST", "GET /apps/some-guid/stream HTTP/1.1\nHost: wss://doppler.example.com\n")
+		Expect(flush()).To(BeEmpty())
+
+		websocketTracer.Print("WEBSOCKET RESPONSE", "HTTP/1.1 101 Switching Protocols\nUpgrade: websocket\n")
+		spans := flush()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("GET /apps/some-guid/stream"))
+		Expect(spans[0].Kind).To(Equal(tracing.SpanKindClient))
+		Expect(spans[0].Attributes).To(HaveKeyWithValue("peer.service", "doppler"))
+		Expect(spans[0].Attributes).To(HaveKeyWithValue("http.response.status_code", "101"))
+		Expect(spans[0].Error).To(BeEmpty())
+	})
+
+	It("records the error of a failed request", func() {
+		websocketTracer.Print("WEBSOCKET REQUEST", "GET /apps/some-guid/stream HTTP/1.1\n")
+		websocketTracer.Print("WEBSOCKET ERROR", "connection refused. Retrying...")
+
+		spans := flush()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Error).To(Equal("connection refused. Retrying..."))
+	})
+
+	It("ignores errors after the connection is established", func() {
+		websocketTracer.Print("WEBSOCKET ERROR", "EOF. Retrying...")
+		Expect(flush()).To(BeEmpty())
+	})
+})
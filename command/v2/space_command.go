@@ -114,6 +114,15 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		return err
 	}
 
+	isolationSegmentName, displayIsolationSegment, err := cmd.isolationSegmentName(spaceSummary)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.SpaceDocumentKind, shared.NewSpaceDocument(spaceSummary, isolationSegmentName, displaySecurityGroupRules))
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), spaceSummary.Name},
 		{cmd.UI.TranslateText("org:"), spaceSummary.OrgName},
@@ -121,12 +130,8 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		{cmd.UI.TranslateText("services:"), strings.Join(spaceSummary.ServiceInstanceNames, ", ")},
 	}
 
-	isolationSegmentRow, err := cmd.isolationSegmentRow(spaceSummary)
-	if err != nil {
-		return err
-	}
-	if isolationSegmentRow != nil {
-		table = append(table, isolationSegmentRow)
+	if displayIsolationSegment {
+		table = append(table, []string{cmd.UI.TranslateText("isolation segment:"), isolationSegmentName})
 	}
 
 	table = append(table,
@@ -180,27 +185,25 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 	return nil
 }
 
-func (cmd SpaceCommand) isolationSegmentRow(spaceSummary v2action.SpaceSummary) ([]string, error) {
+func (cmd SpaceCommand) isolationSegmentName(spaceSummary v2action.SpaceSummary) (string, bool, error) {
 	if cmd.ActorV3 == nil {
-		return nil, nil
+		return "", false, nil
 	}
 
 	apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), command.MinVersionIsolationSegmentV3)
 	if apiCheck != nil {
-		return nil, nil
+		return "", false, nil
 	}
 
-	isolationSegmentName := ""
 	isolationSegment, v3Warnings, err := cmd.ActorV3.GetEffectiveIsolationSegmentBySpace(
 		spaceSummary.GUID, spaceSummary.OrgDefaultIsolationSegmentGUID)
 	cmd.UI.DisplayWarnings(v3Warnings)
-	if err == nil {
-		isolationSegmentName = isolationSegment.Name
-	} else {
+	if err != nil {
 		if _, ok := err.(v3action.NoRelationshipError); !ok {
-			return nil, err
+			return "", false, err
 		}
+		return "", true, nil
 	}
 
-	return []string{cmd.UI.TranslateText("isolation segment:"), isolationSegmentName}, nil
+	return isolationSegment.Name, true, nil
 }
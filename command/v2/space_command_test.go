@@ -165,7 +165,7 @@ var _ = Describe("space Command", func() {
 							Name: "some-space",
 							GUID: "some-space-guid",
 						},
-						OrgName: "some-org",
+						OrgName:                        "some-org",
 						OrgDefaultIsolationSegmentGUID: "some-org-default-isolation-segment-guid",
 						AppNames:                       []string{"app1", "app2", "app3"},
 						ServiceInstanceNames:           []string{"service1", "service2", "service3"},
@@ -229,6 +229,40 @@ var _ = Describe("space Command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(orgDefaultIsolationSegmentGUID).To(Equal("some-org-default-isolation-segment-guid"))
 				})
+
+				Context("when the output format is JSON", func() {
+					var documentOut *Buffer
+
+					BeforeEach(func() {
+						documentOut = NewBuffer()
+						testUI.OutputFormat = ui.OutputFormatJSON
+						testUI.DocumentOut = documentOut
+					})
+
+					It("displays the space summary as a JSON document and all warnings", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(documentOut.Contents()).To(MatchJSON(`{
+							"schema_version": 1,
+							"kind": "space",
+							"data": {
+								"name": "some-space",
+								"guid": "some-space-guid",
+								"org": "some-org",
+								"apps": ["app1", "app2", "app3"],
+								"services": ["service1", "service2", "service3"],
+								"isolation_segment": "some-isolation-segment",
+								"space_quota": "some-space-quota",
+								"running_security_groups": ["public_networks", "dns", "load_balancer"],
+								"staging_security_groups": ["staging-sec-1", "staging-sec-2"]
+							}
+						}`))
+						Expect(testUI.Out).ToNot(Say("space quota:"))
+
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("v3-warning-1"))
+					})
+				})
 			})
 
 			Context("when v3 api version is below 3.11.0 and the v2 api version is no less than 2.68.0", func() {
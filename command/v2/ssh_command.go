@@ -10,15 +10,20 @@ import (
 
 type SSHCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
+	AllInstances        bool         `long:"all-instances" description:"Run the command on every running instance of the application"`
 	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
 	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	Concurrency         int          `long:"concurrency" description:"Maximum number of instances to run the command on at once with --all-instances (Default: 8)"`
 	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPort         string       `short:"D" description:"Dynamic port forward specification, starting a local SOCKS5 proxy. This flag can be defined more than once."`
 	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	ForwardOnly         bool         `long:"forward-only" description:"Keep port forwards open without running a shell or command, until interrupted"`
 	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	RemotePort          string       `short:"R" description:"Remote port forward specification, listening on the application instance. This flag can be defined more than once."`
 	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
 	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
 	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"`
+	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--forward-only] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]\n\n   CF_NAME ssh APP_NAME --all-instances -c command [--concurrency max-instances] [--skip-host-validation] [--disable-pseudo-tty]"`
 	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 }
 
@@ -0,0 +1,26 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SSHConfigCommand struct {
+	OptionalArgs     flag.OptionalAppName `positional-args:"yes"`
+	AppInstanceIndex int                  `long:"app-instance-index" short:"i" description:"Only print the configuration for this application instance index"`
+	Askpass          bool                 `long:"askpass" description:"Print a one time passcode for an ssh client, ignoring any prompt argument"`
+	usage            interface{}          `usage:"CF_NAME ssh-config APP_NAME [-i app-instance-index]\n\n   CF_NAME ssh-config --askpass [PROMPT]\n\n   Prints a Host entry named APP_NAME.INDEX for each instance, which can be added to ~/.ssh/config.\n   The password for each connection is a one time passcode, which ssh can get from CF_NAME ssh-config --askpass.\n\nEXAMPLES:\n   CF_NAME ssh-config my-app >> ~/.ssh/config\n   ssh my-app.0"`
+	relatedCommands  interface{}          `related_commands:"ssh, ssh-code"`
+}
+
+func (SSHConfigCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (SSHConfigCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -1,8 +1,6 @@
 package v2
 
 import (
-	"github.com/cloudfoundry/noaa/consumer"
-
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
@@ -28,7 +26,7 @@ type StartCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       StartActor
-	NOAAClient  *consumer.Consumer
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type UseContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME use-context CONTEXT_NAME\n\nTIP:\n   Use '--context CONTEXT_NAME' with any command to run it against a context without switching to it."`
+	relatedCommands interface{}      `related_commands:"contexts, create-context, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	err := cmd.Config.UseContext(cmd.RequiredArgs.ContextName)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Switched to context {{.ContextName}}.", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+	cmd.UI.DisplayNewline()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("user:"), user.Name},
+		{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganization().Name},
+		{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name},
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	return nil
+}
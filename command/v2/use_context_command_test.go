@@ -0,0 +1,68 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "prod"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.prod.com")
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		})
+
+		It("switches to the context and displays the target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.UseContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseContextArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).To(Say("Switched to context prod\\."))
+			Expect(testUI.Out).To(Say("api endpoint:\\s+https://api.prod.com"))
+			Expect(testUI.Out).To(Say("user:\\s+some-user"))
+			Expect(testUI.Out).To(Say("org:\\s+some-org"))
+			Expect(testUI.Out).To(Say("space:\\s+some-space"))
+		})
+	})
+
+	Context("when the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(configv3.ContextNotFoundError{Name: "prod"})
+		})
+
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "prod"}))
+		})
+	})
+})
@@ -14,7 +14,8 @@ import (
 	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/progressbar"
-	"github.com/cloudfoundry/noaa/consumer"
+	"code.cloudfoundry.org/cli/util/pushcache"
+	"github.com/cloudfoundry/bytefmt"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -30,39 +31,49 @@ type ProgressBar interface {
 
 type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig, progressBar pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	CompleteDeployment(strategy pushaction.DeploymentStrategy, original pushaction.ApplicationConfig, replacement pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	DeleteReplacementApplication(replacement pushaction.ApplicationConfig) (pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
-	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+	PlanPush(config pushaction.ApplicationConfig) (pushaction.PushPlan, pushaction.Warnings)
+	PrepareReplacementApplication(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []string) ([]manifest.Application, error)
 }
 
 type V2PushCommand struct {
 	OptionalArgs  flag.OptionalAppName `positional-args:"yes"`
 	BuildpackName string               `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	Command       string               `short:"c" description:"Startup command, set to null to reset to default start command"`
+	DetectChanges bool                 `long:"detect-changes" description:"With --dry-run, exit with an error if the push would change any app"`
+	DryRun        bool                 `long:"dry-run" description:"Display the changes the push would make without making them"`
 	// Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage flag.DockerImage `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	// DockerUsername       string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DockerImage     flag.DockerImage            `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername  string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
 	HealthCheckType flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	MaxInFlight     int                         `long:"max-in-flight" description:"Maximum number of apps from a multi-app manifest to push at the same time (Default: 1)"`
 	// Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
 	Instances int            `short:"i" description:"Number of instances"`
 	DiskQuota flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory    flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	// NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest bool `long:"no-manifest" description:"Ignore manifest file"`
-	// NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	// RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
+	NoManifest  bool                        `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute     bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart     bool                        `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath     flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	RandomRoute bool                        `long:"random-route" description:"Create a random route for this app"`
 	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	StackName          string `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	HealthCheckTimeout int    `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	StackName          string                        `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	Strategy           flag.DeploymentStrategy       `long:"strategy" choice:"rolling" choice:"blue-green" description:"Replace an existing app without downtime: 'rolling' deletes the old app, 'blue-green' keeps it stopped as APP_NAME-venerable"`
+	HealthCheckTimeout int                           `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	Vars               []string                      `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	VarsFiles          []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 
-	usage               interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage               interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]... [--dry-run [--detect-changes]]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n   [--strategy (rolling | blue-green)]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--max-in-flight NUM_APPS]\n   [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]... [--dry-run [--detect-changes]]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	// dockerPassword       interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
+	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	relatedCommands     interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
 	Config      command.Config
@@ -70,8 +81,9 @@ type V2PushCommand struct {
 	Actor       V2PushActor
 	ProgressBar ProgressBar
 
-	RestartActor RestartActor
-	NOAAClient   *consumer.Consumer
+	RestartActor  RestartActor
+	NOAAClient    v2action.NOAAClient
+	NewNOAAClient func() v2action.NOAAClient
 }
 
 func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -84,12 +96,18 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 	v2Actor := v2action.NewActor(ccClient, uaaClient, config)
+	v2Actor.PushCache = pushcache.NewCache(configv3.PushCacheDir())
 	cmd.RestartActor = v2Actor
-	cmd.Actor = pushaction.NewActor(v2Actor)
+	pushActor := pushaction.NewActor(v2Actor)
+	pushActor.UploadStateDir = filepath.Join(filepath.Dir(configv3.ConfigFilePath()), "uploads")
+	cmd.Actor = pushActor
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NewNOAAClient = func() v2action.NOAAClient {
+		return shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	}
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	cmd.ProgressBar = progressbar.NewProgressBar(ui.DisplayText)
 	return nil
 }
 
@@ -141,6 +159,11 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		log.Errorln("converting manifest:", err)
 		return shared.HandleError(err)
 	}
+	appConfigs = pushaction.SortApplicationConfigsByDependencies(appConfigs)
+
+	if cmd.DryRun {
+		return cmd.dryRun(appConfigs)
+	}
 
 	for _, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
@@ -158,49 +181,327 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.MaxInFlight > 1 && len(appConfigs) > 1 {
+		return cmd.pushInParallel(user, appConfigs)
+	}
+
 	for appNumber, appConfig := range appConfigs {
+		if cmd.Strategy != "" && appConfig.UpdatingApplication() {
+			err = cmd.deploy(user, appConfig)
+		} else {
+			err = cmd.pushInPlace(user, appConfig)
+		}
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+		if appNumber+1 <= len(appConfigs) {
+			cmd.UI.DisplayNewline()
+		}
+	}
+
+	return nil
+}
+
+// dryRun displays the changes pushing each application would make without
+// making them. With --detect-changes an error is returned if any application
+// would change, or if it cannot be determined whether it would.
+func (cmd V2PushCommand) dryRun(appConfigs []pushaction.ApplicationConfig) error {
+	var changedApps, undetectedApps []string
+
+	for _, appConfig := range appConfigs {
+		log.Infoln("planning push:", appConfig.DesiredApplication.Name)
+		plan, warnings := cmd.Actor.PlanPush(appConfig)
+		cmd.UI.DisplayWarnings(warnings)
+
 		if appConfig.CreatingApplication() {
-			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
+			cmd.UI.DisplayText("App {{.AppName}} would be created with these attributes...", map[string]interface{}{
 				"AppName": appConfig.DesiredApplication.Name,
 			})
 		} else {
-			cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
+			cmd.UI.DisplayText("App {{.AppName}} would be updated with these attributes...", map[string]interface{}{
 				"AppName": appConfig.DesiredApplication.Name,
 			})
 		}
-
-		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
-		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+		changes := shared.GetApplicationChanges(appConfig)
+		err := cmd.UI.DisplayChangesForPush(changes)
 		if err != nil {
-			log.Errorln("process apply stream:", err)
+			log.Errorln("display changes:", err)
 			return shared.HandleError(err)
 		}
+		cmd.UI.DisplayNewline()
+		shared.DisplayPushPlan(cmd.UI, plan)
+		cmd.UI.DisplayNewline()
 
-		if !cmd.NoStart {
-			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
-			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
-			if err != nil {
-				return err
+		if appConfig.CreatingApplication() || shared.HasApplicationChanges(changes) || plan.HasChanges() {
+			changedApps = append(changedApps, appConfig.DesiredApplication.Name)
+		} else if len(plan.UndetectableResources()) > 0 {
+			undetectedApps = append(undetectedApps, appConfig.DesiredApplication.Name)
+		}
+	}
+
+	if len(changedApps) == 0 && len(undetectedApps) == 0 {
+		cmd.UI.DisplayText("Dry run complete, no apps would change.")
+		return nil
+	}
+
+	if len(changedApps) == 0 {
+		cmd.UI.DisplayText("Dry run complete, nothing was changed.")
+		if cmd.DetectChanges {
+			return translatableerror.PushChangesUndetectableError{
+				AppNames: undetectedApps,
+				Size:     bytefmt.ByteSize(uint64(pushaction.MinimumMatchableResourceSize)),
 			}
 		}
+		return nil
+	}
 
-		cmd.UI.DisplayNewline()
-		appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
-		cmd.UI.DisplayWarnings(warnings)
-		if err != nil {
-			return shared.HandleError(err)
+	cmd.UI.DisplayText("Dry run complete, nothing was changed.")
+	if cmd.DetectChanges {
+		return translatableerror.PushChangesDetectedError{AppNames: changedApps}
+	}
+	return nil
+}
+
+// pushInParallel pushes up to --max-in-flight apps at the same time. An app is
+// pushed once the apps it depends on have been pushed and started, and is
+// skipped if one of them was not. The output of every app is prefixed with its
+// name and a summary of the results is displayed at the end.
+func (cmd V2PushCommand) pushInParallel(user configv3.User, appConfigs []pushaction.ApplicationConfig) error {
+	type pushResult struct {
+		name string
+		err  error
+	}
+
+	results := make(chan pushResult)
+	pushed := map[string]bool{}
+	failed := map[string]error{}
+	skipped := map[string]string{}
+
+	pending := appConfigs
+	var inFlight int
+	for len(pending) > 0 || inFlight > 0 {
+		var waiting []pushaction.ApplicationConfig
+		for _, appConfig := range pending {
+			name := appConfig.DesiredApplication.Name
+			if dependency, ok := unpushedDependency(appConfig, failed, skipped); ok {
+				log.WithField("app", name).Infoln("skipping, dependency not pushed:", dependency)
+				skipped[name] = dependency
+				continue
+			}
+
+			if inFlight < cmd.MaxInFlight && pushaction.DependenciesSatisfied(appConfig, appConfigs, pushed) {
+				log.WithField("app", name).Info("starting parallel push")
+				inFlight++
+				go func(appConfig pushaction.ApplicationConfig) {
+					results <- pushResult{
+						name: appConfig.DesiredApplication.Name,
+						err:  cmd.pushApp(user, appConfig),
+					}
+				}(appConfig)
+				continue
+			}
+
+			waiting = append(waiting, appConfig)
 		}
+		pending = waiting
 
-		shared.DisplayAppSummary(cmd.UI, appSummary, true)
+		if inFlight == 0 {
+			break
+		}
 
-		if appNumber+1 <= len(appConfigs) {
+		result := <-results
+		inFlight--
+		if result.err != nil {
+			log.WithField("app", result.name).Errorln("parallel push:", result.err)
+			failed[result.name] = result.err
+		} else {
+			pushed[result.name] = true
+		}
+	}
+
+	var summary [][]string
+	var failedApps []string
+	for _, appConfig := range appConfigs {
+		name := appConfig.DesiredApplication.Name
+		switch {
+		case pushed[name]:
 			cmd.UI.DisplayNewline()
+			appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(name, cmd.Config.TargetedSpace().GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+			summary = append(summary, []string{name, cmd.UI.TranslateText("pushed")})
+		case failed[name] != nil:
+			failedApps = append(failedApps, name)
+			summary = append(summary, []string{name, cmd.UI.TranslateText("failed: {{.Error}}", map[string]interface{}{
+				"Error": shared.TranslateError(cmd.UI, failed[name]),
+			})})
+		default:
+			failedApps = append(failedApps, name)
+			summary = append(summary, []string{name, cmd.UI.TranslateText("skipped: {{.Dependency}} was not pushed", map[string]interface{}{
+				"Dependency": skipped[name],
+			})})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Push summary:")
+	cmd.UI.DisplayKeyValueTable("", summary, 3)
+
+	if len(failedApps) > 0 {
+		return translatableerror.AppsFailedToPushError{AppNames: failedApps}
+	}
+	return nil
+}
+
+// pushApp pushes one app of a parallel push. The app gets its own prefixed
+// UI, progress bar and log stream so that it does not interfere with the other
+// apps being pushed.
+func (cmd V2PushCommand) pushApp(user configv3.User, appConfig pushaction.ApplicationConfig) error {
+	appUI := shared.NewPrefixedUI(cmd.UI, appConfig.DesiredApplication.Name)
+
+	appCmd := cmd
+	appCmd.UI = appUI
+	appCmd.ProgressBar = progressbar.NewStepProgressBar(appUI.DisplayText)
+	if cmd.NewNOAAClient != nil {
+		appCmd.NOAAClient = cmd.NewNOAAClient()
+	}
+
+	var err error
+	if cmd.Strategy != "" && appConfig.UpdatingApplication() {
+		err = appCmd.deploy(user, appConfig)
+	} else {
+		err = appCmd.pushInPlace(user, appConfig)
+	}
+	if err != nil {
+		appUI.DisplayText("Push failed: {{.Error}}", map[string]interface{}{
+			"Error": shared.TranslateError(cmd.UI, err),
+		})
+	}
+	return err
+}
+
+// unpushedDependency returns the first dependency of appConfig that failed to
+// push or was skipped.
+func unpushedDependency(appConfig pushaction.ApplicationConfig, failed map[string]error, skipped map[string]string) (string, bool) {
+	for _, dependency := range appConfig.Dependencies {
+		if _, ok := failed[dependency]; ok {
+			return dependency, true
+		}
+		if _, ok := skipped[dependency]; ok {
+			return dependency, true
+		}
+	}
+	return "", false
+}
+
+// pushInPlace updates or creates the application and restarts it.
+func (cmd V2PushCommand) pushInPlace(user configv3.User, appConfig pushaction.ApplicationConfig) error {
+	if appConfig.CreatingApplication() {
+		cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	}
+
+	configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
+	updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+	if err != nil {
+		log.Errorln("process apply stream:", err)
+		return shared.HandleError(err)
+	}
+
+	if !cmd.NoStart {
+		messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
+		err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// deploy pushes the application into a replacement application, starts it and
+// moves the routes over once it is running. The replacement is deleted if it
+// fails to upload, stage or start.
+func (cmd V2PushCommand) deploy(user configv3.User, appConfig pushaction.ApplicationConfig) error {
+	replacementConfig, warnings, err := cmd.Actor.PrepareReplacementApplication(appConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("preparing replacement:", err)
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deploying app {{.AppName}} with the {{.Strategy}} strategy...", map[string]interface{}{
+		"AppName":  appConfig.DesiredApplication.Name,
+		"Strategy": cmd.Strategy,
+	})
+	cmd.UI.DisplayTextWithFlavor("Creating replacement app {{.ReplacementName}}...", map[string]interface{}{
+		"ReplacementName": replacementConfig.DesiredApplication.Name,
+	})
+
+	configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(replacementConfig, cmd.ProgressBar)
+	updatedConfig, err := cmd.processApplyStreams(user, replacementConfig, configStream, eventStream, warningsStream, errorStream)
+	if err != nil {
+		log.Errorln("process apply stream:", err)
+		cmd.rollBack(replacementConfig)
+		return shared.HandleError(err)
+	}
+
+	messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
+	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	if err != nil {
+		log.Errorln("starting replacement:", err)
+		cmd.rollBack(replacementConfig)
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	deploymentEvents, deploymentWarnings, deploymentErrs := cmd.Actor.CompleteDeployment(pushaction.DeploymentStrategy(cmd.Strategy), appConfig, updatedConfig)
+	err = cmd.processDeploymentStreams(appConfig, replacementConfig, deploymentEvents, deploymentWarnings, deploymentErrs)
+	if err != nil {
+		log.Errorln("complete deployment:", err)
+		return shared.HandleError(err)
+	}
+
+	return nil
+}
+
+// rollBack deletes the replacement application. The original application has
+// not been changed at this point.
+func (cmd V2PushCommand) rollBack(replacementConfig pushaction.ApplicationConfig) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Rolling back: deleting replacement app {{.ReplacementName}}...", map[string]interface{}{
+		"ReplacementName": replacementConfig.DesiredApplication.Name,
+	})
+	warnings, err := cmd.Actor.DeleteReplacementApplication(replacementConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("deleting replacement:", err)
+		cmd.UI.DisplayWarning("Unable to delete replacement app {{.ReplacementName}}: {{.Error}}", map[string]interface{}{
+			"ReplacementName": replacementConfig.DesiredApplication.Name,
+			"Error":           err.Error(),
+		})
+	}
+}
+
 func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings, error) {
 	err := cmd.validateArgs()
 	if err != nil {
@@ -218,12 +519,16 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 		CurrentDirectory:   pwd,
 		DiskQuota:          cmd.DiskQuota.Size,
 		DockerImage:        cmd.DockerImage.Path,
+		DockerPassword:     cmd.Config.DockerPassword(),
+		DockerUsername:     cmd.DockerUsername,
 		HealthCheckTimeout: cmd.HealthCheckTimeout,
 		HealthCheckType:    cmd.HealthCheckType.Type,
 		Instances:          cmd.Instances,
 		Memory:             cmd.Memory.Size,
 		Name:               cmd.OptionalArgs.AppName,
+		NoRoute:            cmd.NoRoute,
 		ProvidedAppPath:    string(cmd.AppPath),
+		RandomRoute:        cmd.RandomRoute,
 		StackName:          cmd.StackName,
 	}
 
@@ -261,7 +566,11 @@ func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSett
 	cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
 		"Path": pathToManifest,
 	})
-	return cmd.Actor.ReadManifest(pathToManifest)
+	var pathsToVarsFiles []string
+	for _, path := range cmd.VarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+	return cmd.Actor.ReadManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
 }
 
 func (cmd V2PushCommand) processApplyStreams(
@@ -317,12 +626,101 @@ func (cmd V2PushCommand) processApplyStreams(
 	return updatedConfig, nil
 }
 
+func (cmd V2PushCommand) processDeploymentStreams(
+	appConfig pushaction.ApplicationConfig,
+	replacementConfig pushaction.ApplicationConfig,
+	eventStream <-chan pushaction.Event,
+	warningsStream <-chan pushaction.Warnings,
+	errorStream <-chan error,
+) error {
+	var eventClosed, warningsClosed, errorClosed bool
+
+	for !eventClosed || !warningsClosed || !errorClosed {
+		select {
+		case event, ok := <-eventStream:
+			if !ok {
+				log.Debug("processing deployment event stream closed")
+				eventClosed = true
+				break
+			}
+			cmd.processDeploymentEvent(appConfig, replacementConfig, event)
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				log.Debug("processing deployment warnings stream closed")
+				warningsClosed = true
+				break
+			}
+			cmd.UI.DisplayWarnings(warnings)
+		case err, ok := <-errorStream:
+			if !ok {
+				log.Debug("processing deployment error stream closed")
+				errorClosed = true
+				break
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd V2PushCommand) processDeploymentEvent(appConfig pushaction.ApplicationConfig, replacementConfig pushaction.ApplicationConfig, event pushaction.Event) {
+	log.Infoln("received deployment event:", event)
+
+	appName := appConfig.DesiredApplication.Name
+	venerableName := appName + pushaction.VenerableApplicationSuffix
+	switch event {
+	case pushaction.DeletingVenerableApplication:
+		cmd.UI.DisplayTextWithFlavor("Deleting app {{.AppName}} from a previous deployment...", map[string]interface{}{
+			"AppName": venerableName,
+		})
+	case pushaction.MappingReplacementRoutes:
+		cmd.UI.DisplayTextWithFlavor("Mapping routes to {{.AppName}}...", map[string]interface{}{
+			"AppName": replacementConfig.DesiredApplication.Name,
+		})
+	case pushaction.UnmappingOriginalRoutes:
+		cmd.UI.DisplayTextWithFlavor("Unmapping routes from {{.AppName}}...", map[string]interface{}{
+			"AppName": appName,
+		})
+	case pushaction.RenamingOriginalApplication:
+		cmd.UI.DisplayTextWithFlavor("Renaming app {{.AppName}} to {{.NewName}}...", map[string]interface{}{
+			"AppName": appName,
+			"NewName": venerableName,
+		})
+	case pushaction.RenamingReplacementApplication:
+		cmd.UI.DisplayTextWithFlavor("Renaming app {{.AppName}} to {{.NewName}}...", map[string]interface{}{
+			"AppName": replacementConfig.DesiredApplication.Name,
+			"NewName": appName,
+		})
+	case pushaction.DeletingOriginalApplication:
+		cmd.UI.DisplayTextWithFlavor("Deleting app {{.AppName}}...", map[string]interface{}{
+			"AppName": venerableName,
+		})
+	case pushaction.StoppingOriginalApplication:
+		cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}}...", map[string]interface{}{
+			"AppName": venerableName,
+		})
+	case pushaction.RollingBack:
+		cmd.UI.DisplayText("Rolling back: restoring routes and deleting the replacement app...")
+	case pushaction.RolledBack:
+		cmd.UI.DisplayTextWithFlavor("Rolled back, app {{.AppName}} was not changed.", map[string]interface{}{
+			"AppName": appName,
+		})
+	default:
+		log.WithField("event", event).Debug("ignoring event")
+	}
+}
+
 func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.ApplicationConfig, event pushaction.Event) bool {
 	log.Infoln("received apply event:", event)
 
 	switch event {
 	case pushaction.ConfiguringRoutes:
 		cmd.UI.DisplayText("Mapping routes...")
+	case pushaction.UnboundRoutes:
+		cmd.UI.DisplayText("Unmapping routes...")
+	case pushaction.BoundServices:
+		cmd.UI.DisplayText("Binding services...")
 	case pushaction.ResourceMatching:
 		cmd.UI.DisplayText("Comparing local files to remote cache...")
 	case pushaction.CreatingArchive:
@@ -331,6 +729,8 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 		cmd.UI.DisplayText("Uploading files...")
 		log.Debug("starting progress bar")
 		cmd.ProgressBar.Ready()
+	case pushaction.ResumingUpload:
+		cmd.UI.DisplayText("Resuming upload of files from an earlier push...")
 	case pushaction.RetryUpload:
 		cmd.UI.DisplayText("Retrying upload due to an error...")
 	case pushaction.UploadComplete:
@@ -347,16 +747,48 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 
 func (cmd V2PushCommand) validateArgs() error {
 	switch {
+	case cmd.MaxInFlight < 0:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--max-in-flight",
+			ExpectedType: "a positive integer",
+		}
+	case cmd.DetectChanges && !cmd.DryRun:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--dry-run",
+			Arg2: "--detect-changes",
+		}
 	case cmd.DockerImage.Path != "" && cmd.AppPath != "":
 		return translatableerror.ArgumentCombinationError{
 			Arg1: "--docker-image, -o",
 			Arg2: "-p",
 		}
+	case cmd.NoManifest && (len(cmd.Vars) > 0 || len(cmd.VarsFiles) > 0):
+		return translatableerror.ArgumentCombinationError{
+			Arg1: "--no-manifest",
+			Arg2: "--var, --vars-file",
+		}
 	case cmd.PathToManifest != "" && cmd.NoManifest:
 		return translatableerror.ArgumentCombinationError{
 			Arg1: "-f",
 			Arg2: "--no-manifest",
 		}
+	case cmd.DockerUsername != "" && cmd.DockerImage.Path == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--docker-image, -o",
+			Arg2: "--docker-username",
+		}
+	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
+		return translatableerror.DockerPasswordNotSetError{}
+	case cmd.NoRoute && cmd.RandomRoute:
+		return translatableerror.ArgumentCombinationError{
+			Arg1: "--no-route",
+			Arg2: "--random-route",
+		}
+	case cmd.Strategy != "" && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Arg1: "--strategy",
+			Arg2: "--no-start",
+		}
 	}
 
 	return nil
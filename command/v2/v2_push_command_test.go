@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
@@ -160,7 +161,10 @@ var _ = Describe("v2-push Command", func() {
 								Eventually(eventStream).Should(BeSent(pushaction.ConfiguringRoutes))
 								Eventually(eventStream).Should(BeSent(pushaction.CreatedRoutes))
 								Eventually(eventStream).Should(BeSent(pushaction.BoundRoutes))
+								Eventually(eventStream).Should(BeSent(pushaction.UnboundRoutes))
+								Eventually(eventStream).Should(BeSent(pushaction.BoundServices))
 								Eventually(eventStream).Should(BeSent(pushaction.ResourceMatching))
+								Eventually(eventStream).Should(BeSent(pushaction.ResumingUpload))
 								Eventually(eventStream).Should(BeSent(pushaction.CreatingArchive))
 								Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
 								Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
@@ -300,7 +304,8 @@ var _ = Describe("v2-push Command", func() {
 									Expect(executeErr).ToNot(HaveOccurred())
 
 									Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-									Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+									passedPath, _, _ := fakeActor.ReadManifestArgsForCall(0)
+									Expect(passedPath).To(Equal(pathToManifest))
 
 									Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
 									cmdSettings, manifestApps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
@@ -357,7 +362,8 @@ var _ = Describe("v2-push Command", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
 								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-								Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+								passedPath, _, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(passedPath).To(Equal(pathToManifest))
 							})
 						})
 
@@ -374,7 +380,25 @@ var _ = Describe("v2-push Command", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
 								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
-								Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(pathToManifest))
+								passedPath, _, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(passedPath).To(Equal(pathToManifest))
+							})
+
+							Context("when vars and vars files are provided", func() {
+								BeforeEach(func() {
+									cmd.Vars = []string{"name=some-app", "instances=2"}
+									cmd.VarsFiles = []flag.PathWithExistenceCheck{"some-vars-file.yml", "other-vars-file.yml"}
+								})
+
+								It("reads the manifest with the vars", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+									passedPath, pathsToVarsFiles, vars := fakeActor.ReadManifestArgsForCall(0)
+									Expect(passedPath).To(Equal(pathToManifest))
+									Expect(pathsToVarsFiles).To(Equal([]string{"some-vars-file.yml", "other-vars-file.yml"}))
+									Expect(vars).To(Equal([]string{"name=some-app", "instances=2"}))
+								})
 							})
 						})
 					})
@@ -426,7 +450,10 @@ var _ = Describe("v2-push Command", func() {
 
 							Expect(testUI.Out).To(Say("Creating app with these attributes\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Mapping routes\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Unmapping routes\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Binding services\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Comparing local files to remote cache\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Resuming upload of files from an earlier push\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Packaging files to upload\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Uploading files\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Retrying upload due to an error\\.\\.\\."))
@@ -599,6 +626,380 @@ var _ = Describe("v2-push Command", func() {
 						Expect(testUI.Err).To(Say("apply-2"))
 					})
 				})
+
+				Context("when --dry-run is provided", func() {
+					BeforeEach(func() {
+						cmd.DryRun = true
+						appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+
+						fakeActor.PlanPushReturns(pushaction.PushPlan{
+							RoutesToBind: []v2action.Route{
+								{Host: "route3", Domain: v2action.Domain{Name: "example.com"}},
+							},
+							MatchedResources:   []v2action.Resource{{Size: 2048}},
+							UnmatchedResources: []v2action.Resource{{Size: 1024}},
+						}, pushaction.Warnings{"plan-warning"})
+					})
+
+					It("displays the planned changes without applying them", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.PlanPushCallCount()).To(Equal(1))
+						Expect(fakeActor.PlanPushArgsForCall(0)).To(Equal(appConfigs[0]))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+						Expect(fakeActor.PrepareReplacementApplicationCallCount()).To(Equal(0))
+						Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+
+						Expect(testUI.Out).To(Say("App %s would be updated with these attributes...", appName))
+						Expect(testUI.Out).To(Say(`\-\s+route1.example.com`))
+						Expect(testUI.Out).To(Say(`\+\s+route3.example.com`))
+						Expect(testUI.Out).To(Say(`routes to map:\s+route3.example.com`))
+						Expect(testUI.Out).To(Say(`files to upload:\s+1 \(1K\)`))
+						Expect(testUI.Out).To(Say(`files already uploaded:\s+1 \(2K saved\)`))
+						Expect(testUI.Out).To(Say("Dry run complete, nothing was changed."))
+						Expect(testUI.Err).To(Say("plan-warning"))
+					})
+
+					Context("when --detect-changes is provided", func() {
+						BeforeEach(func() {
+							cmd.DetectChanges = true
+						})
+
+						Context("when an app would change", func() {
+							It("returns a PushChangesDetectedError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PushChangesDetectedError{
+									AppNames: []string{appName},
+								}))
+							})
+						})
+
+						Context("when no app would change", func() {
+							BeforeEach(func() {
+								appConfigs[0].DesiredRoutes = appConfigs[0].CurrentRoutes
+								fakeActor.PlanPushReturns(pushaction.PushPlan{
+									MatchedResources: []v2action.Resource{{Size: 2048}},
+								}, nil)
+							})
+
+							It("does not return an error", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Dry run complete, no apps would change."))
+							})
+						})
+
+						Context("when only files too small to be resource matched would be uploaded", func() {
+							BeforeEach(func() {
+								appConfigs[0].DesiredRoutes = appConfigs[0].CurrentRoutes
+								fakeActor.PlanPushReturns(pushaction.PushPlan{
+									MatchedResources:   []v2action.Resource{{Size: 2048}},
+									UnmatchedResources: []v2action.Resource{{Filename: "small-file", SHA1: "some-sha", Size: 1024}},
+								}, nil)
+							})
+
+							It("returns a PushChangesUndetectableError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PushChangesUndetectableError{
+									AppNames: []string{appName},
+									Size:     "64K",
+								}))
+								Expect(testUI.Out).ToNot(Say("no apps would change"))
+							})
+						})
+
+						Context("when only environment variables would change", func() {
+							BeforeEach(func() {
+								appConfigs[0].DesiredRoutes = appConfigs[0].CurrentRoutes
+								fakeActor.PlanPushReturns(pushaction.PushPlan{
+									EnvironmentVariablesToSet: []string{"SOME_VAR"},
+								}, nil)
+							})
+
+							It("returns a PushChangesDetectedError", func() {
+								Expect(testUI.Out).To(Say(`environment variables to set:\s+SOME_VAR`))
+								Expect(executeErr).To(MatchError(translatableerror.PushChangesDetectedError{
+									AppNames: []string{appName},
+								}))
+							})
+						})
+					})
+
+					Context("when the app does not exist yet", func() {
+						BeforeEach(func() {
+							appConfigs[0].CurrentApplication = pushaction.Application{}
+							appConfigs[0].CurrentRoutes = nil
+							fakeActor.PlanPushReturns(pushaction.PushPlan{}, nil)
+							cmd.DetectChanges = true
+						})
+
+						It("reports the app as changed", func() {
+							Expect(testUI.Out).To(Say("App %s would be created with these attributes...", appName))
+							Expect(executeErr).To(MatchError(translatableerror.PushChangesDetectedError{
+								AppNames: []string{appName},
+							}))
+						})
+					})
+				})
+
+				Context("when --max-in-flight is greater than 1 and there are multiple apps", func() {
+					var (
+						callsMutex sync.Mutex
+						calls      []string
+					)
+
+					BeforeEach(func() {
+						cmd.MaxInFlight = 2
+						calls = nil
+
+						newConfig := func(name string, dependencies ...string) pushaction.ApplicationConfig {
+							return pushaction.ApplicationConfig{
+								DesiredApplication: pushaction.Application{Application: v2action.Application{Name: name}},
+								Dependencies:       dependencies,
+								TargetedSpaceGUID:  "some-space-guid",
+								Path:               pwd,
+							}
+						}
+						appConfigs = []pushaction.ApplicationConfig{
+							newConfig("app-2", "app-1"),
+							newConfig("app-1"),
+							newConfig("app-3"),
+							newConfig("app-4", "app-3"),
+						}
+						fakeActor.ConvertToApplicationConfigsReturns(appConfigs, nil, nil)
+
+						fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+							callsMutex.Lock()
+							calls = append(calls, "apply "+config.DesiredApplication.Name)
+							callsMutex.Unlock()
+
+							configStream := make(chan pushaction.ApplicationConfig)
+							eventStream := make(chan pushaction.Event)
+							warningsStream := make(chan pushaction.Warnings)
+							errorStream := make(chan error)
+
+							go func() {
+								defer close(configStream)
+								defer close(eventStream)
+								defer close(warningsStream)
+								defer close(errorStream)
+
+								warningsStream <- pushaction.Warnings{config.DesiredApplication.Name + "-warning"}
+								if config.DesiredApplication.Name == "app-3" {
+									errorStream <- errors.New("some-apply-error")
+									return
+								}
+								config.CurrentApplication = config.DesiredApplication
+								configStream <- config
+								eventStream <- pushaction.Complete
+							}()
+
+							return configStream, eventStream, warningsStream, errorStream
+						}
+
+						fakeRestartActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+							callsMutex.Lock()
+							calls = append(calls, "start "+app.Name)
+							callsMutex.Unlock()
+
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error)
+							appState := make(chan v2action.ApplicationStateChange)
+							warnings := make(chan string)
+							errs := make(chan error)
+							close(messages)
+							close(logErrs)
+							close(appState)
+							close(warnings)
+							close(errs)
+							return messages, logErrs, appState, warnings, errs
+						}
+
+						fakeRestartActor.GetApplicationSummaryByNameAndSpaceStub = func(name string, _ string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+							return v2action.ApplicationSummary{
+								Application: v2action.Application{Name: name, State: ccv2.ApplicationStarted},
+							}, nil, nil
+						}
+					})
+
+					It("pushes the apps after their dependencies and prefixes their output", func() {
+						callsMutex.Lock()
+						defer callsMutex.Unlock()
+
+						Expect(calls).To(ConsistOf("apply app-1", "start app-1", "apply app-2", "start app-2", "apply app-3"))
+						Expect(indexOf(calls, "apply app-2")).To(BeNumerically(">", indexOf(calls, "start app-1")))
+
+						Expect(testUI.Err).To(Say(`\[app-\d\] app-\d-warning`))
+						Expect(testUI.Err).To(Say(`\[app-\d\] app-\d-warning`))
+						Expect(testUI.Err).To(Say(`\[app-\d\] app-\d-warning`))
+						Expect(testUI.Out).To(Say(`\[app-3\] Push failed: some-apply-error`))
+					})
+
+					It("displays a summary and returns an error listing the apps that were not pushed", func() {
+						Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{
+							AppNames: []string{"app-3", "app-4"},
+						}))
+
+						Expect(fakeRestartActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(2))
+						Expect(testUI.Out).To(Say("Push summary:"))
+						Expect(testUI.Out).To(Say(`app-1\s+pushed`))
+						Expect(testUI.Out).To(Say(`app-2\s+pushed`))
+						Expect(testUI.Out).To(Say(`app-3\s+failed: some-apply-error`))
+						Expect(testUI.Out).To(Say(`app-4\s+skipped: app-3 was not pushed`))
+					})
+				})
+
+				Context("when a deployment strategy is provided", func() {
+					var replacementConfig pushaction.ApplicationConfig
+
+					BeforeEach(func() {
+						cmd.Strategy = "rolling"
+
+						replacementConfig = pushaction.ApplicationConfig{
+							DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "some-app-new"}},
+							TargetedSpaceGUID:  "some-space-guid",
+							Path:               pwd,
+						}
+						fakeActor.PrepareReplacementApplicationReturns(replacementConfig, pushaction.Warnings{"prepare-warning"}, nil)
+
+						fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+							configStream := make(chan pushaction.ApplicationConfig, 1)
+							eventStream := make(chan pushaction.Event, 1)
+							warningsStream := make(chan pushaction.Warnings)
+							errorStream := make(chan error)
+
+							config.CurrentApplication = pushaction.Application{Application: v2action.Application{Name: config.DesiredApplication.Name, GUID: "replacement-guid"}}
+							configStream <- config
+							eventStream <- pushaction.Complete
+							close(configStream)
+							close(eventStream)
+							close(warningsStream)
+							close(errorStream)
+
+							return configStream, eventStream, warningsStream, errorStream
+						}
+
+						fakeRestartActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{
+							Application: v2action.Application{Name: appName, GUID: "replacement-guid", State: ccv2.ApplicationStarted},
+						}, nil, nil)
+
+						fakeRestartActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error)
+							appState := make(chan v2action.ApplicationStateChange)
+							warnings := make(chan string)
+							errs := make(chan error)
+							close(messages)
+							close(logErrs)
+							close(appState)
+							close(warnings)
+							close(errs)
+							return messages, logErrs, appState, warnings, errs
+						}
+					})
+
+					Context("when the app already exists", func() {
+						BeforeEach(func() {
+							appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+						})
+
+						Context("when the replacement app starts", func() {
+							BeforeEach(func() {
+								fakeActor.CompleteDeploymentStub = func(_ pushaction.DeploymentStrategy, _ pushaction.ApplicationConfig, _ pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+									eventStream := make(chan pushaction.Event, 7)
+									warningsStream := make(chan pushaction.Warnings, 1)
+									errorStream := make(chan error)
+
+									eventStream <- pushaction.DeletingVenerableApplication
+									eventStream <- pushaction.MappingReplacementRoutes
+									eventStream <- pushaction.UnmappingOriginalRoutes
+									eventStream <- pushaction.RenamingOriginalApplication
+									eventStream <- pushaction.RenamingReplacementApplication
+									eventStream <- pushaction.DeletingOriginalApplication
+									eventStream <- pushaction.Complete
+									warningsStream <- pushaction.Warnings{"deployment-warning"}
+									close(eventStream)
+									close(warningsStream)
+									close(errorStream)
+
+									return eventStream, warningsStream, errorStream
+								}
+							})
+
+							It("pushes into the replacement app and moves the routes over to it", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.PrepareReplacementApplicationCallCount()).To(Equal(1))
+								Expect(fakeActor.PrepareReplacementApplicationArgsForCall(0)).To(Equal(appConfigs[0]))
+
+								Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+								config, _ := fakeActor.ApplyArgsForCall(0)
+								Expect(config).To(Equal(replacementConfig))
+
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(1))
+								app, _, _ := fakeRestartActor.RestartApplicationArgsForCall(0)
+								Expect(app.GUID).To(Equal("replacement-guid"))
+
+								Expect(fakeActor.CompleteDeploymentCallCount()).To(Equal(1))
+								strategy, original, replacement := fakeActor.CompleteDeploymentArgsForCall(0)
+								Expect(strategy).To(Equal(pushaction.RollingStrategy))
+								Expect(original).To(Equal(appConfigs[0]))
+								Expect(replacement.CurrentApplication.GUID).To(Equal("replacement-guid"))
+
+								Expect(testUI.Out).To(Say("Deploying app some-app with the rolling strategy\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Creating replacement app some-app-new\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Deleting app some-app-venerable from a previous deployment\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Mapping routes to some-app-new\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Unmapping routes from some-app\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Renaming app some-app to some-app-venerable\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Renaming app some-app-new to some-app\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Deleting app some-app-venerable\\.\\.\\."))
+								Expect(testUI.Out).To(Say("name:\\s+some-app"))
+
+								Expect(testUI.Err).To(Say("prepare-warning"))
+								Expect(testUI.Err).To(Say("deployment-warning"))
+							})
+						})
+
+						Context("when the replacement app crashes", func() {
+							BeforeEach(func() {
+								fakeRestartActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+									messages := make(chan *v2action.LogMessage)
+									logErrs := make(chan error)
+									appState := make(chan v2action.ApplicationStateChange)
+									warnings := make(chan string)
+									errs := make(chan error, 1)
+									errs <- v2action.ApplicationInstanceCrashedError{Name: app.Name}
+									close(messages)
+									close(logErrs)
+									close(appState)
+									close(warnings)
+									close(errs)
+									return messages, logErrs, appState, warnings, errs
+								}
+								fakeActor.DeleteReplacementApplicationReturns(pushaction.Warnings{"delete-warning"}, nil)
+							})
+
+							It("deletes the replacement app and returns the error", func() {
+								Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app-new", BinaryName: binaryName}))
+
+								Expect(testUI.Out).To(Say("Rolling back: deleting replacement app some-app-new\\.\\.\\."))
+								Expect(testUI.Err).To(Say("delete-warning"))
+
+								Expect(fakeActor.DeleteReplacementApplicationCallCount()).To(Equal(1))
+								Expect(fakeActor.DeleteReplacementApplicationArgsForCall(0)).To(Equal(replacementConfig))
+								Expect(fakeActor.CompleteDeploymentCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the app does not exist yet", func() {
+						It("pushes the app normally", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.PrepareReplacementApplicationCallCount()).To(Equal(0))
+							Expect(fakeActor.CompleteDeploymentCallCount()).To(Equal(0))
+							Expect(testUI.Out).To(Say("Creating app some-app\\.\\.\\."))
+						})
+					})
+				})
 			})
 
 			Context("when there is an error converting the app setting into a config", func() {
@@ -688,6 +1089,147 @@ var _ = Describe("v2-push Command", func() {
 			})
 		})
 
+		Context("when --no-manifest and --var are both given", func() {
+			BeforeEach(func() {
+				cmd.NoManifest = true
+				cmd.Vars = []string{"name=some-app"}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Arg1: "--no-manifest",
+					Arg2: "--var, --vars-file",
+				}))
+			})
+		})
+
+		Context("when --max-in-flight is negative", func() {
+			BeforeEach(func() {
+				cmd.MaxInFlight = -1
+			})
+
+			It("returns a ParseArgumentError", func() {
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "--max-in-flight",
+					ExpectedType: "a positive integer",
+				}))
+			})
+		})
+
+		Context("when --detect-changes is given without --dry-run", func() {
+			BeforeEach(func() {
+				cmd.DetectChanges = true
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+					Arg1: "--dry-run",
+					Arg2: "--detect-changes",
+				}))
+			})
+		})
+
+		Context("when the --strategy and --no-start flags are both given", func() {
+			BeforeEach(func() {
+				cmd.Strategy = "blue-green"
+				cmd.NoStart = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Arg1: "--strategy",
+					Arg2: "--no-start",
+				}))
+			})
+		})
+
+		Context("when the --no-route and --random-route flags are both given", func() {
+			BeforeEach(func() {
+				cmd.NoRoute = true
+				cmd.RandomRoute = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				_, err := cmd.GetCommandLineSettings()
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Arg1: "--no-route",
+					Arg2: "--random-route",
+				}))
+			})
+		})
+
+		Context("when the --no-route flag is given", func() {
+			BeforeEach(func() {
+				cmd.NoRoute = true
+			})
+
+			It("sets no route on the command line settings", func() {
+				settings, err := cmd.GetCommandLineSettings()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(settings.NoRoute).To(BeTrue())
+				Expect(settings.RandomRoute).To(BeFalse())
+			})
+		})
+
+		Context("when the --random-route flag is given", func() {
+			BeforeEach(func() {
+				cmd.RandomRoute = true
+			})
+
+			It("sets random route on the command line settings", func() {
+				settings, err := cmd.GetCommandLineSettings()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(settings.RandomRoute).To(BeTrue())
+				Expect(settings.NoRoute).To(BeFalse())
+			})
+		})
+
+		Context("when the --docker-username flag is given", func() {
+			BeforeEach(func() {
+				cmd.DockerUsername = "some-docker-username"
+			})
+
+			Context("when the -o flag is not given", func() {
+				It("returns a RequiredFlagsError", func() {
+					_, err := cmd.GetCommandLineSettings()
+					Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+						Arg1: "--docker-image, -o",
+						Arg2: "--docker-username",
+					}))
+				})
+			})
+
+			Context("when the -o flag is given", func() {
+				BeforeEach(func() {
+					cmd.DockerImage.Path = "some-docker-image-path"
+				})
+
+				Context("when CF_DOCKER_PASSWORD is set", func() {
+					BeforeEach(func() {
+						fakeConfig.DockerPasswordReturns("some-docker-password")
+					})
+
+					It("sets the docker username and password on the command line settings", func() {
+						settings, err := cmd.GetCommandLineSettings()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(settings.DockerUsername).To(Equal("some-docker-username"))
+						Expect(settings.DockerPassword).To(Equal("some-docker-password"))
+					})
+				})
+
+				Context("when CF_DOCKER_PASSWORD is not set", func() {
+					It("returns a DockerPasswordNotSetError", func() {
+						_, err := cmd.GetCommandLineSettings()
+						Expect(err).To(MatchError(translatableerror.DockerPasswordNotSetError{}))
+					})
+				})
+			})
+		})
+
 		Context("when only -o flag is passed", func() {
 			BeforeEach(func() {
 				cmd.DockerImage.Path = "some-docker-image-path"
@@ -715,3 +1257,12 @@ var _ = Describe("v2-push Command", func() {
 		})
 	})
 })
+
+func indexOf(list []string, item string) int {
+	for i, listItem := range list {
+		if listItem == item {
+			return i
+		}
+	}
+	return -1
+}
@@ -28,6 +28,23 @@ type FakeV2PushActor struct {
 		result3 <-chan pushaction.Warnings
 		result4 <-chan error
 	}
+	CompleteDeploymentStub        func(strategy pushaction.DeploymentStrategy, original pushaction.ApplicationConfig, replacement pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	completeDeploymentMutex       sync.RWMutex
+	completeDeploymentArgsForCall []struct {
+		strategy    pushaction.DeploymentStrategy
+		original    pushaction.ApplicationConfig
+		replacement pushaction.ApplicationConfig
+	}
+	completeDeploymentReturns struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	completeDeploymentReturnsOnCall map[int]struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
 	ConvertToApplicationConfigsStub        func(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	convertToApplicationConfigsMutex       sync.RWMutex
 	convertToApplicationConfigsArgsForCall []struct {
@@ -46,6 +63,19 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	DeleteReplacementApplicationStub        func(replacement pushaction.ApplicationConfig) (pushaction.Warnings, error)
+	deleteReplacementApplicationMutex       sync.RWMutex
+	deleteReplacementApplicationArgsForCall []struct {
+		replacement pushaction.ApplicationConfig
+	}
+	deleteReplacementApplicationReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	deleteReplacementApplicationReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
 	MergeAndValidateSettingsAndManifestsStub        func(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
 	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
@@ -60,10 +90,40 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
-	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
+	PlanPushStub        func(config pushaction.ApplicationConfig) (pushaction.PushPlan, pushaction.Warnings)
+	planPushMutex       sync.RWMutex
+	planPushArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	planPushReturns struct {
+		result1 pushaction.PushPlan
+		result2 pushaction.Warnings
+	}
+	planPushReturnsOnCall map[int]struct {
+		result1 pushaction.PushPlan
+		result2 pushaction.Warnings
+	}
+	PrepareReplacementApplicationStub        func(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings, error)
+	prepareReplacementApplicationMutex       sync.RWMutex
+	prepareReplacementApplicationArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	prepareReplacementApplicationReturns struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	prepareReplacementApplicationReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	ReadManifestStub        func(pathToManifest string, pathsToVarsFiles []string, vars []string) ([]manifest.Application, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
-		pathToManifest string
+		pathToManifest   string
+		pathsToVarsFiles []string
+		vars             []string
 	}
 	readManifestReturns struct {
 		result1 []manifest.Application
@@ -135,6 +195,62 @@ func (fake *FakeV2PushActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV2PushActor) CompleteDeployment(strategy pushaction.DeploymentStrategy, original pushaction.ApplicationConfig, replacement pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+	fake.completeDeploymentMutex.Lock()
+	ret, specificReturn := fake.completeDeploymentReturnsOnCall[len(fake.completeDeploymentArgsForCall)]
+	fake.completeDeploymentArgsForCall = append(fake.completeDeploymentArgsForCall, struct {
+		strategy    pushaction.DeploymentStrategy
+		original    pushaction.ApplicationConfig
+		replacement pushaction.ApplicationConfig
+	}{strategy, original, replacement})
+	fake.recordInvocation("CompleteDeployment", []interface{}{strategy, original, replacement})
+	fake.completeDeploymentMutex.Unlock()
+	if fake.CompleteDeploymentStub != nil {
+		return fake.CompleteDeploymentStub(strategy, original, replacement)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.completeDeploymentReturns.result1, fake.completeDeploymentReturns.result2, fake.completeDeploymentReturns.result3
+}
+
+func (fake *FakeV2PushActor) CompleteDeploymentCallCount() int {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	return len(fake.completeDeploymentArgsForCall)
+}
+
+func (fake *FakeV2PushActor) CompleteDeploymentArgsForCall(i int) (pushaction.DeploymentStrategy, pushaction.ApplicationConfig, pushaction.ApplicationConfig) {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	return fake.completeDeploymentArgsForCall[i].strategy, fake.completeDeploymentArgsForCall[i].original, fake.completeDeploymentArgsForCall[i].replacement
+}
+
+func (fake *FakeV2PushActor) CompleteDeploymentReturns(result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.CompleteDeploymentStub = nil
+	fake.completeDeploymentReturns = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) CompleteDeploymentReturnsOnCall(i int, result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.CompleteDeploymentStub = nil
+	if fake.completeDeploymentReturnsOnCall == nil {
+		fake.completeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 <-chan pushaction.Event
+			result2 <-chan pushaction.Warnings
+			result3 <-chan error
+		})
+	}
+	fake.completeDeploymentReturnsOnCall[i] = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -197,6 +313,57 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) DeleteReplacementApplication(replacement pushaction.ApplicationConfig) (pushaction.Warnings, error) {
+	fake.deleteReplacementApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteReplacementApplicationReturnsOnCall[len(fake.deleteReplacementApplicationArgsForCall)]
+	fake.deleteReplacementApplicationArgsForCall = append(fake.deleteReplacementApplicationArgsForCall, struct {
+		replacement pushaction.ApplicationConfig
+	}{replacement})
+	fake.recordInvocation("DeleteReplacementApplication", []interface{}{replacement})
+	fake.deleteReplacementApplicationMutex.Unlock()
+	if fake.DeleteReplacementApplicationStub != nil {
+		return fake.DeleteReplacementApplicationStub(replacement)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteReplacementApplicationReturns.result1, fake.deleteReplacementApplicationReturns.result2
+}
+
+func (fake *FakeV2PushActor) DeleteReplacementApplicationCallCount() int {
+	fake.deleteReplacementApplicationMutex.RLock()
+	defer fake.deleteReplacementApplicationMutex.RUnlock()
+	return len(fake.deleteReplacementApplicationArgsForCall)
+}
+
+func (fake *FakeV2PushActor) DeleteReplacementApplicationArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.deleteReplacementApplicationMutex.RLock()
+	defer fake.deleteReplacementApplicationMutex.RUnlock()
+	return fake.deleteReplacementApplicationArgsForCall[i].replacement
+}
+
+func (fake *FakeV2PushActor) DeleteReplacementApplicationReturns(result1 pushaction.Warnings, result2 error) {
+	fake.DeleteReplacementApplicationStub = nil
+	fake.deleteReplacementApplicationReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) DeleteReplacementApplicationReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.DeleteReplacementApplicationStub = nil
+	if fake.deleteReplacementApplicationReturnsOnCall == nil {
+		fake.deleteReplacementApplicationReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.deleteReplacementApplicationReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
@@ -254,16 +421,133 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
-func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+func (fake *FakeV2PushActor) PlanPush(config pushaction.ApplicationConfig) (pushaction.PushPlan, pushaction.Warnings) {
+	fake.planPushMutex.Lock()
+	ret, specificReturn := fake.planPushReturnsOnCall[len(fake.planPushArgsForCall)]
+	fake.planPushArgsForCall = append(fake.planPushArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("PlanPush", []interface{}{config})
+	fake.planPushMutex.Unlock()
+	if fake.PlanPushStub != nil {
+		return fake.PlanPushStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.planPushReturns.result1, fake.planPushReturns.result2
+}
+
+func (fake *FakeV2PushActor) PlanPushCallCount() int {
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
+	return len(fake.planPushArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanPushArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
+	return fake.planPushArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PlanPushReturns(result1 pushaction.PushPlan, result2 pushaction.Warnings) {
+	fake.PlanPushStub = nil
+	fake.planPushReturns = struct {
+		result1 pushaction.PushPlan
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) PlanPushReturnsOnCall(i int, result1 pushaction.PushPlan, result2 pushaction.Warnings) {
+	fake.PlanPushStub = nil
+	if fake.planPushReturnsOnCall == nil {
+		fake.planPushReturnsOnCall = make(map[int]struct {
+			result1 pushaction.PushPlan
+			result2 pushaction.Warnings
+		})
+	}
+	fake.planPushReturnsOnCall[i] = struct {
+		result1 pushaction.PushPlan
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) PrepareReplacementApplication(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings, error) {
+	fake.prepareReplacementApplicationMutex.Lock()
+	ret, specificReturn := fake.prepareReplacementApplicationReturnsOnCall[len(fake.prepareReplacementApplicationArgsForCall)]
+	fake.prepareReplacementApplicationArgsForCall = append(fake.prepareReplacementApplicationArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("PrepareReplacementApplication", []interface{}{config})
+	fake.prepareReplacementApplicationMutex.Unlock()
+	if fake.PrepareReplacementApplicationStub != nil {
+		return fake.PrepareReplacementApplicationStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.prepareReplacementApplicationReturns.result1, fake.prepareReplacementApplicationReturns.result2, fake.prepareReplacementApplicationReturns.result3
+}
+
+func (fake *FakeV2PushActor) PrepareReplacementApplicationCallCount() int {
+	fake.prepareReplacementApplicationMutex.RLock()
+	defer fake.prepareReplacementApplicationMutex.RUnlock()
+	return len(fake.prepareReplacementApplicationArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PrepareReplacementApplicationArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.prepareReplacementApplicationMutex.RLock()
+	defer fake.prepareReplacementApplicationMutex.RUnlock()
+	return fake.prepareReplacementApplicationArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PrepareReplacementApplicationReturns(result1 pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.PrepareReplacementApplicationStub = nil
+	fake.prepareReplacementApplicationReturns = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) PrepareReplacementApplicationReturnsOnCall(i int, result1 pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.PrepareReplacementApplicationStub = nil
+	if fake.prepareReplacementApplicationReturnsOnCall == nil {
+		fake.prepareReplacementApplicationReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.prepareReplacementApplicationReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []string) ([]manifest.Application, error) {
+	var pathsToVarsFilesCopy []string
+	if pathsToVarsFiles != nil {
+		pathsToVarsFilesCopy = make([]string, len(pathsToVarsFiles))
+		copy(pathsToVarsFilesCopy, pathsToVarsFiles)
+	}
+	var varsCopy []string
+	if vars != nil {
+		varsCopy = make([]string, len(vars))
+		copy(varsCopy, vars)
+	}
 	fake.readManifestMutex.Lock()
 	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
 	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
-		pathToManifest string
-	}{pathToManifest})
-	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest})
+		pathToManifest   string
+		pathsToVarsFiles []string
+		vars             []string
+	}{pathToManifest, pathsToVarsFilesCopy, varsCopy})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest, pathsToVarsFilesCopy, varsCopy})
 	fake.readManifestMutex.Unlock()
 	if fake.ReadManifestStub != nil {
-		return fake.ReadManifestStub(pathToManifest)
+		return fake.ReadManifestStub(pathToManifest, pathsToVarsFiles, vars)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -277,10 +561,10 @@ func (fake *FakeV2PushActor) ReadManifestCallCount() int {
 	return len(fake.readManifestArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) string {
+func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) (string, []string, []string) {
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
-	return fake.readManifestArgsForCall[i].pathToManifest
+	return fake.readManifestArgsForCall[i].pathToManifest, fake.readManifestArgsForCall[i].pathsToVarsFiles, fake.readManifestArgsForCall[i].vars
 }
 
 func (fake *FakeV2PushActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
@@ -310,10 +594,18 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyMutex.RLock()
 	defer fake.applyMutex.RUnlock()
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
 	fake.convertToApplicationConfigsMutex.RLock()
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.deleteReplacementApplicationMutex.RLock()
+	defer fake.deleteReplacementApplicationMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
+	fake.prepareReplacementApplicationMutex.RLock()
+	defer fake.prepareReplacementApplicationMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
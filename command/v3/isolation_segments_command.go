@@ -65,6 +65,11 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	if err != nil {
 		return shared.HandleError(err)
 	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.IsolationSegmentListDocumentKind, shared.NewIsolationSegmentDocuments(summaries))
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
@@ -121,6 +121,36 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+
+				Context("when the output format is YAML", func() {
+					var documentOut *Buffer
+
+					BeforeEach(func() {
+						documentOut = NewBuffer()
+						testUI.OutputFormat = ui.OutputFormatYAML
+						testUI.DocumentOut = documentOut
+					})
+
+					It("displays the isolation segment summaries as a YAML document and all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(documentOut.Contents()).To(MatchYAML(`
+schema_version: 1
+kind: isolation_segment_list
+data:
+- name: some-iso-1
+  orgs: []
+- name: some-iso-2
+  orgs: [some-org-1]
+- name: some-iso-3
+  orgs: [some-org-1, some-org-2]
+`))
+						Expect(testUI.Out).ToNot(Say("OK"))
+
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("warning-2"))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
@@ -2,19 +2,37 @@ package v3
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+const (
+	// taskLogQuietPeriod is how long --wait keeps displaying logs after the
+	// task completes when no more logs arrive. Logs can reach the log stream
+	// after the task has already completed.
+	taskLogQuietPeriod = time.Second
+
+	// taskLogDrainTimeout is the longest --wait keeps displaying logs after
+	// the task completes.
+	taskLogDrainTimeout = 5 * time.Second
+)
+
 //go:generate counterfeiter . RunTaskActor
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	CheckTaskCompletion(appGUID string, task v3action.Task) (v3action.Task, bool, v3action.Warnings, error)
+	TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
 	CloudControllerAPIVersion() string
 }
 
@@ -23,13 +41,20 @@ type RunTaskCommand struct {
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Wait            bool             `long:"wait" description:"Wait for the task to complete, displaying its logs"`
+	Timeout         int              `long:"timeout" description:"Time (in seconds) to wait for the task to complete when using --wait (Default: no limit)"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait [--timeout SECONDS]]\n\nTIP:\n   Use --wait to display the logs of the task until it completes. The command fails if the task fails, and interrupting it terminates the task.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait --timeout 600"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
+	NOAAClient  v3action.NOAAClient
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+
+	// Interrupt is used by --wait to terminate the task. When it is nil, the
+	// process' interrupt signal is used.
+	Interrupt <-chan os.Signal
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -37,11 +62,12 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	client, _, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client, config)
+	cmd.Actor = v3action.NewActor(ccClient, config)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.APIInfo.Logging(), config, uaaClient, ui)
 
 	return nil
 }
@@ -52,6 +78,13 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Timeout != 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}
+	}
+	if cmd.Timeout < 0 {
+		return translatableerror.ParseArgumentError{ArgumentName: "--timeout", ExpectedType: "positive integer"}
+	}
+
 	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -91,6 +124,15 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		inputTask.MemoryInMB = cmd.Memory.Size
 	}
 
+	var (
+		logStream    <-chan *v3action.LogMessage
+		logErrStream <-chan error
+	)
+	if cmd.Wait {
+		// Logs are streamed before the task is created so that none are missed.
+		logStream, logErrStream = cmd.Actor.GetStreamingLogs(application.GUID, cmd.NOAAClient)
+	}
+
 	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -105,5 +147,127 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
-	return nil
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(application.GUID, task, logStream, logErrStream)
+}
+
+// waitForTask displays the task's logs until it completes, fails, is
+// interrupted or the timeout is reached. The log stream is closed before it
+// returns.
+func (cmd RunTaskCommand) waitForTask(appGUID string, task v3action.Task, logStream <-chan *v3action.LogMessage, logErrStream <-chan error) error {
+	defer cmd.NOAAClient.Close()
+
+	interrupt := cmd.Interrupt
+	if interrupt == nil {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt)
+		defer signal.Stop(signals)
+		interrupt = signals
+	}
+
+	var timeout <-chan time.Time
+	if cmd.Timeout > 0 {
+		timeout = time.After(time.Duration(cmd.Timeout) * time.Second)
+	}
+
+	ticker := time.NewTicker(cmd.Config.PollingInterval())
+	defer ticker.Stop()
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to complete...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	sourceType := "APP/TASK/" + task.Name
+	for {
+		select {
+		case log, ok := <-logStream:
+			if !ok {
+				logStream = nil
+				break
+			}
+			if log.SourceType() == sourceType {
+				cmd.UI.DisplayLogMessage(log, true)
+			}
+		case logErr, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+				break
+			}
+			cmd.UI.DisplayWarning(logErr.Error())
+		case <-ticker.C:
+			_, done, warnings, err := cmd.Actor.CheckTaskCompletion(appGUID, task)
+			cmd.UI.DisplayWarnings(warnings)
+			if done {
+				cmd.displayRemainingTaskLogs(sourceType, logStream, logErrStream)
+			}
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			if done {
+				cmd.UI.DisplayNewline()
+				cmd.UI.DisplayText("Task {{.TaskName}} succeeded.", map[string]interface{}{
+					"TaskName": task.Name,
+				})
+				return nil
+			}
+		case <-interrupt:
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Terminating task {{.TaskName}}...", map[string]interface{}{
+				"TaskName": task.Name,
+			})
+			_, warnings, err := cmd.Actor.TerminateTask(task.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			return translatableerror.TaskTerminatedError{Name: task.Name}
+		case <-timeout:
+			return translatableerror.TaskTimeoutError{
+				Name:    task.Name,
+				Timeout: time.Duration(cmd.Timeout) * time.Second,
+			}
+		}
+	}
+}
+
+// displayRemainingTaskLogs displays the logs that arrive after the task has
+// completed, until the log stream has been quiet for taskLogQuietPeriod, it is
+// closed, or taskLogDrainTimeout is reached.
+func (cmd RunTaskCommand) displayRemainingTaskLogs(sourceType string, logStream <-chan *v3action.LogMessage, logErrStream <-chan error) {
+	drainTimeout := time.After(taskLogDrainTimeout)
+	quiet := time.NewTimer(taskLogQuietPeriod)
+	defer quiet.Stop()
+
+	for logStream != nil || logErrStream != nil {
+		select {
+		case log, ok := <-logStream:
+			if !ok {
+				logStream = nil
+				break
+			}
+			if log.SourceType() == sourceType {
+				cmd.UI.DisplayLogMessage(log, true)
+			}
+		case logErr, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+				break
+			}
+			cmd.UI.DisplayWarning(logErr.Error())
+		case <-quiet.C:
+			return
+		case <-drainTimeout:
+			return
+		}
+
+		if !quiet.Stop() {
+			<-quiet.C
+		}
+		quiet.Reset(taskLogQuietPeriod)
+	}
 }
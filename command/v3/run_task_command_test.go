@@ -2,9 +2,12 @@ package v3_test
 
 import (
 	"errors"
+	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -68,6 +71,17 @@ var _ = Describe("run-task Command", func() {
 		})
 	})
 
+	Context("when --timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout = 60
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}))
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -287,6 +301,176 @@ get-application-warning-2
 get-application-warning-3`))
 					})
 				})
+				Context("when --wait is provided", func() {
+					var (
+						fakeNOAAClient *v3actionfakes.FakeNOAAClient
+						logStream      chan *v3action.LogMessage
+						logErrStream   chan error
+						interrupt      chan os.Signal
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+						fakeConfig.PollingIntervalReturns(time.Millisecond)
+
+						logStream = make(chan *v3action.LogMessage, 2)
+						logStream <- v3action.NewLogMessage("some task log", 1, time.Now(), "APP/TASK/some-task-name", "0")
+						logStream <- v3action.NewLogMessage("some web log", 1, time.Now(), "APP/PROC/WEB", "0")
+						logErrStream = make(chan error, 1)
+						logErrStream <- errors.New("some-log-error")
+						fakeActor.GetStreamingLogsReturns(logStream, logErrStream)
+
+						interrupt = make(chan os.Signal, 1)
+						cmd.Interrupt = interrupt
+
+						fakeActor.RunTaskReturns(
+							v3action.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							nil,
+							nil)
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.CheckTaskCompletionStub = func(_ string, task v3action.Task) (v3action.Task, bool, v3action.Warnings, error) {
+								// complete once the logs have been displayed
+								done := len(logStream) == 0 && len(logErrStream) == 0
+								return task, done, v3action.Warnings{"check-task-warning"}, nil
+							}
+						})
+
+						It("displays the task's logs and waits for it to complete", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+							appGUID, _ := fakeActor.GetStreamingLogsArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+
+							Expect(fakeActor.CheckTaskCompletionCallCount()).To(BeNumerically(">=", 1))
+							appGUID, task := fakeActor.CheckTaskCompletionArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task.SequenceID).To(Equal(3))
+
+							Expect(testUI.Out).To(Say("task id:     3"))
+							Expect(testUI.Out).To(Say("Waiting for task some-task-name to complete..."))
+							Expect(testUI.Out).To(Say("some task log"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							Expect(testUI.Out).ToNot(Say("some web log"))
+
+							Expect(testUI.Err).To(Say("some-log-error"))
+							Expect(testUI.Err).To(Say("check-task-warning"))
+
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when logs arrive after the task completes", func() {
+						BeforeEach(func() {
+							fakeActor.CheckTaskCompletionStub = func(_ string, task v3action.Task) (v3action.Task, bool, v3action.Warnings, error) {
+								if len(logStream) != 0 || len(logErrStream) != 0 {
+									return task, false, nil, nil
+								}
+								go func() {
+									logStream <- v3action.NewLogMessage("late task log", 1, time.Now(), "APP/TASK/some-task-name", "0")
+								}()
+								return task, true, nil, nil
+							}
+						})
+
+						It("displays them before exiting", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("some task log"))
+							Expect(testUI.Out).To(Say("late task log"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.CheckTaskCompletionReturns(
+								v3action.Task{Name: "some-task-name", State: "FAILED"},
+								true,
+								nil,
+								v3action.TaskFailedError{Name: "some-task-name", FailureReason: "Exited with status 1"})
+						})
+
+						It("returns a TaskFailedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.TaskFailedError{
+								Name:          "some-task-name",
+								FailureReason: "Exited with status 1",
+							}))
+							Expect(testUI.Out).To(Say("some task log"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when checking the task returns an error", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("check task error")
+							fakeActor.CheckTaskCompletionReturns(v3action.Task{}, false, v3action.Warnings{"check-task-warning"}, expectedErr)
+						})
+
+						It("returns the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("check-task-warning"))
+						})
+					})
+
+					Context("when interrupted", func() {
+						BeforeEach(func() {
+							interrupt <- os.Interrupt
+							fakeActor.TerminateTaskReturns(v3action.Task{}, v3action.Warnings{"terminate-task-warning"}, nil)
+						})
+
+						It("terminates the task and returns a TaskTerminatedError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.TaskTerminatedError{Name: "some-task-name"}))
+
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(1))
+							Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("some-task-guid"))
+
+							Expect(testUI.Out).To(Say("Terminating task some-task-name..."))
+							Expect(testUI.Err).To(Say("terminate-task-warning"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+
+						Context("when terminating the task returns an error", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = errors.New("terminate task error")
+								fakeActor.TerminateTaskReturns(v3action.Task{}, nil, expectedErr)
+							})
+
+							It("returns the error", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+							})
+						})
+					})
+
+					Context("when the timeout is reached", func() {
+						BeforeEach(func() {
+							cmd.Timeout = 1
+						})
+
+						It("returns a TaskTimeoutError without terminating the task", func() {
+							Expect(executeErr).To(MatchError(translatableerror.TaskTimeoutError{
+								Name:    "some-task-name",
+								Timeout: time.Second,
+							}))
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
 			})
 
 			Context("when there are errors", func() {
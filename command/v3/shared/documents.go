@@ -0,0 +1,157 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// Document kinds displayed by the V3 commands with --output.
+const (
+	IsolationSegmentListDocumentKind = "isolation_segment_list"
+	TaskListDocumentKind             = "task_list"
+	V3AppListDocumentKind            = "v3_app_list"
+)
+
+// V3AppDocument is the structured representation of a V3 application
+// summary.
+type V3AppDocument struct {
+	Name           string            `json:"name" yaml:"name"`
+	GUID           string            `json:"guid" yaml:"guid"`
+	RequestedState string            `json:"requested_state" yaml:"requested_state"`
+	Processes      []ProcessDocument `json:"processes" yaml:"processes"`
+	Routes         []string          `json:"routes" yaml:"routes"`
+	Stack          string            `json:"stack" yaml:"stack"`
+	Buildpacks     []string          `json:"buildpacks" yaml:"buildpacks"`
+}
+
+// ProcessDocument is the structured representation of an application
+// process.
+type ProcessDocument struct {
+	Type             string             `json:"type" yaml:"type"`
+	MemoryInMB       int                `json:"memory_in_mb" yaml:"memory_in_mb"`
+	HealthyInstances int                `json:"healthy_instances" yaml:"healthy_instances"`
+	TotalInstances   int                `json:"total_instances" yaml:"total_instances"`
+	Instances        []InstanceDocument `json:"instances" yaml:"instances"`
+}
+
+// InstanceDocument is the structured representation of a process instance.
+type InstanceDocument struct {
+	Index              int     `json:"index" yaml:"index"`
+	State              string  `json:"state" yaml:"state"`
+	UptimeInSeconds    int     `json:"uptime_in_seconds" yaml:"uptime_in_seconds"`
+	CPU                float64 `json:"cpu" yaml:"cpu"`
+	MemoryInBytes      uint64  `json:"memory_in_bytes" yaml:"memory_in_bytes"`
+	MemoryQuotaInBytes uint64  `json:"memory_quota_in_bytes" yaml:"memory_quota_in_bytes"`
+	DiskInBytes        uint64  `json:"disk_in_bytes" yaml:"disk_in_bytes"`
+	DiskQuotaInBytes   uint64  `json:"disk_quota_in_bytes" yaml:"disk_quota_in_bytes"`
+}
+
+// TaskDocument is the structured representation of a task.
+type TaskDocument struct {
+	ID         int    `json:"id" yaml:"id"`
+	GUID       string `json:"guid" yaml:"guid"`
+	Name       string `json:"name" yaml:"name"`
+	State      string `json:"state" yaml:"state"`
+	Command    string `json:"command" yaml:"command"`
+	CreatedAt  string `json:"created_at" yaml:"created_at"`
+	MemoryInMB uint64 `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB   uint64 `json:"disk_in_mb" yaml:"disk_in_mb"`
+}
+
+// IsolationSegmentDocument is the structured representation of an isolation
+// segment and the orgs entitled to it.
+type IsolationSegmentDocument struct {
+	Name string   `json:"name" yaml:"name"`
+	Orgs []string `json:"orgs" yaml:"orgs"`
+}
+
+// NewV3AppDocument converts an application summary and its routes to a
+// V3AppDocument.
+func NewV3AppDocument(summary v3action.ApplicationSummary, routes v2action.Routes) V3AppDocument {
+	summary.Processes.Sort()
+
+	processes := []ProcessDocument{}
+	for _, process := range summary.Processes {
+		instances := []InstanceDocument{}
+		for _, instance := range process.Instances {
+			instances = append(instances, InstanceDocument{
+				Index:              instance.Index,
+				State:              strings.ToLower(instance.State),
+				UptimeInSeconds:    instance.Uptime,
+				CPU:                instance.CPU,
+				MemoryInBytes:      instance.MemoryUsage,
+				MemoryQuotaInBytes: instance.MemoryQuota,
+				DiskInBytes:        instance.DiskUsage,
+				DiskQuotaInBytes:   instance.DiskQuota,
+			})
+		}
+
+		processes = append(processes, ProcessDocument{
+			Type:             process.Type,
+			MemoryInMB:       process.MemoryInMB,
+			HealthyInstances: process.HealthyInstanceCount(),
+			TotalInstances:   process.TotalInstanceCount(),
+			Instances:        instances,
+		})
+	}
+
+	formattedRoutes := []string{}
+	for _, route := range routes {
+		formattedRoutes = append(formattedRoutes, route.String())
+	}
+
+	buildpacks := []string{}
+	for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+		buildpacks = append(buildpacks, buildpack.Name)
+	}
+
+	return V3AppDocument{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(summary.State),
+		Processes:      processes,
+		Routes:         formattedRoutes,
+		Stack:          summary.CurrentDroplet.Stack,
+		Buildpacks:     buildpacks,
+	}
+}
+
+// NewTaskDocuments converts tasks to TaskDocuments.
+func NewTaskDocuments(tasks []v3action.Task) []TaskDocument {
+	documents := []TaskDocument{}
+	for _, task := range tasks {
+		documents = append(documents, TaskDocument{
+			ID:         task.SequenceID,
+			GUID:       task.GUID,
+			Name:       task.Name,
+			State:      task.State,
+			Command:    task.Command,
+			CreatedAt:  task.CreatedAt,
+			MemoryInMB: task.MemoryInMB,
+			DiskInMB:   task.DiskInMB,
+		})
+	}
+
+	return documents
+}
+
+// NewIsolationSegmentDocuments converts isolation segment summaries to
+// IsolationSegmentDocuments.
+func NewIsolationSegmentDocuments(summaries []v3action.IsolationSegmentSummary) []IsolationSegmentDocument {
+	documents := []IsolationSegmentDocument{}
+	for _, summary := range summaries {
+		orgs := summary.EntitledOrgs
+		if orgs == nil {
+			orgs = []string{}
+		}
+
+		documents = append(documents, IsolationSegmentDocument{
+			Name: summary.Name,
+			Orgs: orgs,
+		})
+	}
+
+	return documents
+}
@@ -46,6 +46,8 @@ func HandleError(err error) error {
 		return translatableerror.ProcessNotFoundError(e)
 	case v3action.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
+	case v3action.TaskFailedError:
+		return translatableerror.TaskFailedError(e)
 	case v3action.TaskWorkersUnavailableError:
 		return translatableerror.RunTaskError{Message: "Task workers are unavailable."}
 	}
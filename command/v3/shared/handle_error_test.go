@@ -57,6 +57,10 @@ var _ = Describe("HandleError", func() {
 			v3action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v3action.TaskFailedError -> TaskFailedError",
+			v3action.TaskFailedError{Name: "some-task", FailureReason: "Exited with status 1"},
+			translatableerror.TaskFailedError{Name: "some-task", FailureReason: "Exited with status 1"}),
+
 		Entry("v3action.TaskWorkersUnavailableError -> RunTaskError",
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			translatableerror.RunTaskError{Message: "Task workers are unavailable."}),
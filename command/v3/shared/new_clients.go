@@ -6,16 +6,32 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/ratelimit"
+	"code.cloudfoundry.org/cli/api/retry"
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/cassette"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
 // passed in config.
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Client, *uaa.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
+	ccRawWrappers := []ccv3.ConnectionWrapper{}
+	uaaRawWrappers := []uaa.ConnectionWrapper{}
+
+	requestCassette, err := cassette.Open(config.RecordPath(), config.ReplayPath())
+	if err != nil {
+		return nil, nil, err
+	}
+	if requestCassette != nil {
+		ccRawWrappers = append(ccRawWrappers, ccWrapper.NewRecorder(requestCassette))
+		uaaRawWrappers = append(uaaRawWrappers, uaaWrapper.NewRecorder(requestCassette))
+	}
 
 	verbose, location := config.Verbose()
 	if verbose {
@@ -25,15 +41,27 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
+	tracer := tracing.Current()
+	if tracer != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTracer(tracer))
+	}
+
+	authWrapper := ccWrapper.NewUAAAuthentication(nil, config, config.TokenRefreshSkew())
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	if config.MaxRequestsPerSecond() > 0 {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRateLimiter(ratelimit.NewLimiter(config.MaxRequestsPerSecond())))
+	}
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
+	if config.HTTPCacheEnabled() {
+		ccWrappers = append(ccWrappers, sharedV2.NewResponseCache(config))
+	}
 
 	ccClient := ccv3.NewClient(ccv3.Config{
-		AppName:    config.BinaryName(),
-		AppVersion: config.BinaryVersion(),
-		Wrappers:   ccWrappers,
+		AppName:     config.BinaryName(),
+		AppVersion:  config.BinaryVersion(),
+		Wrappers:    ccWrappers,
+		RawWrappers: ccRawWrappers,
 	})
 
 	if !targetCF {
@@ -46,7 +74,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv3.TargetSettings{
+	_, err = ccClient.TargetCF(ccv3.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
@@ -64,7 +92,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		AppVersion:        config.BinaryVersion(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		DialTimeout:       config.DialTimeout(),
+		RawWrappers:       uaaRawWrappers,
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.UAA(),
 	})
@@ -76,8 +106,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	if tracer != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestTracer(tracer))
+	}
+
+	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config, config.TokenRefreshSkew()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
 
 	authWrapper.SetClient(uaaClient)
 
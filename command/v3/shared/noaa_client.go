@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -20,6 +22,7 @@ type RequestLoggerOutput interface {
 
 type DebugPrinter struct {
 	outputs []RequestLoggerOutput
+	tracer  *sharedV2.WebsocketTracer
 }
 
 func (p *DebugPrinter) addOutput(output RequestLoggerOutput) {
@@ -27,6 +30,10 @@ func (p *DebugPrinter) addOutput(output RequestLoggerOutput) {
 }
 
 func (p DebugPrinter) Print(title string, dump string) {
+	if p.tracer != nil {
+		p.tracer.Print(title, dump)
+	}
+
 	for _, output := range p.outputs {
 		_ = output.Start()
 		defer output.Stop()
@@ -50,6 +57,9 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 	client.SetMaxRetryCount(5)
 
 	noaaDebugPrinter := DebugPrinter{}
+	if tracer := tracing.Current(); tracer != nil {
+		noaaDebugPrinter.tracer = sharedV2.NewWebsocketTracer(tracer)
+	}
 
 	// if verbose, set debug printer on noaa client
 	verbose, location := config.Verbose()
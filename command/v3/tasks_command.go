@@ -89,6 +89,10 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.TaskListDocumentKind, shared.NewTaskDocuments(tasks))
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
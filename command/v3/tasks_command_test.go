@@ -180,6 +180,34 @@ get-application-warning-2
 get-tasks-warning-1`))
 				})
 
+				Context("when the output format is JSON", func() {
+					var documentOut *Buffer
+
+					BeforeEach(func() {
+						documentOut = NewBuffer()
+						testUI.OutputFormat = ui.OutputFormatJSON
+						testUI.DocumentOut = documentOut
+					})
+
+					It("displays the tasks as a JSON document and all warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(documentOut.Contents()).To(MatchJSON(`{
+							"schema_version": 1,
+							"kind": "task_list",
+							"data": [
+								{"id": 3, "guid": "task-3-guid", "name": "task-3", "state": "RUNNING", "command": "some-command", "created_at": "2016-11-08T22:26:02Z", "memory_in_mb": 0, "disk_in_mb": 0},
+								{"id": 2, "guid": "task-2-guid", "name": "task-2", "state": "FAILED", "command": "some-command", "created_at": "2016-11-08T22:26:02Z", "memory_in_mb": 0, "disk_in_mb": 0},
+								{"id": 1, "guid": "task-1-guid", "name": "task-1", "state": "SUCCEEDED", "command": "some-command", "created_at": "2016-11-08T22:26:02Z", "memory_in_mb": 0, "disk_in_mb": 0}
+							]
+						}`))
+						Expect(testUI.Out).ToNot(Say("OK"))
+
+						Expect(testUI.Err).To(Say("get-application-warning-1"))
+						Expect(testUI.Err).To(Say("get-tasks-warning-1"))
+					})
+				})
+
 				Context("when the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns(
@@ -72,7 +72,7 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	if len(summaries) == 0 {
+	if len(summaries) == 0 && !cmd.UI.IsStructuredOutput() {
 		cmd.UI.DisplayText("No apps found")
 		return nil
 	}
@@ -85,24 +85,30 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("routes"),
 		},
 	}
+	documents := []shared.V3AppDocument{}
 
 	for _, summary := range summaries {
-		var routesList string
+		var routes v2action.Routes
 		if len(summary.Processes) > 0 {
-			routes, warnings, err := cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
+			var warnings v2action.Warnings
+			routes, warnings, err = cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
 			cmd.UI.DisplayWarnings(warnings)
 			if err != nil {
 				return shared.HandleError(err)
 			}
-			routesList = routes.Summary()
 		}
 
 		table = append(table, []string{
 			summary.Name,
 			cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
 			summary.Processes.Summary(),
-			routesList,
+			routes.Summary(),
 		})
+		documents = append(documents, shared.NewV3AppDocument(summary, routes))
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayDocument(shared.V3AppListDocumentKind, documents)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, 3)
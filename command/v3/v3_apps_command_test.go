@@ -265,6 +265,81 @@ var _ = Describe("v3-apps Command", func() {
 				appGUID = fakeV2Actor.GetApplicationRoutesArgsForCall(1)
 				Expect(appGUID).To(Equal("app-guid-2"))
 			})
+
+			Context("when the output format is JSON", func() {
+				var documentOut *Buffer
+
+				BeforeEach(func() {
+					documentOut = NewBuffer()
+					testUI.OutputFormat = ui.OutputFormatJSON
+					testUI.DocumentOut = documentOut
+				})
+
+				It("displays the application summaries as a JSON document and outputs warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(documentOut.Contents()).To(MatchJSON(`{
+						"schema_version": 1,
+						"kind": "v3_app_list",
+						"data": [
+							{
+								"name": "some-app-1",
+								"guid": "app-guid-1",
+								"requested_state": "started",
+								"processes": [
+									{
+										"type": "web",
+										"memory_in_mb": 0,
+										"healthy_instances": 2,
+										"total_instances": 2,
+										"instances": [
+											{"index": 0, "state": "running", "uptime_in_seconds": 0, "cpu": 0, "memory_in_bytes": 0, "memory_quota_in_bytes": 0, "disk_in_bytes": 0, "disk_quota_in_bytes": 0},
+											{"index": 1, "state": "running", "uptime_in_seconds": 0, "cpu": 0, "memory_in_bytes": 0, "memory_quota_in_bytes": 0, "disk_in_bytes": 0, "disk_quota_in_bytes": 0}
+										]
+									},
+									{"type": "console", "memory_in_mb": 0, "healthy_instances": 0, "total_instances": 0, "instances": []},
+									{
+										"type": "worker",
+										"memory_in_mb": 0,
+										"healthy_instances": 0,
+										"total_instances": 1,
+										"instances": [
+											{"index": 0, "state": "down", "uptime_in_seconds": 0, "cpu": 0, "memory_in_bytes": 0, "memory_quota_in_bytes": 0, "disk_in_bytes": 0, "disk_quota_in_bytes": 0}
+										]
+									}
+								],
+								"routes": ["some-app-1.some-other-domain", "some-app-1.some-domain"],
+								"stack": "",
+								"buildpacks": []
+							},
+							{
+								"name": "some-app-2",
+								"guid": "app-guid-2",
+								"requested_state": "stopped",
+								"processes": [
+									{
+										"type": "web",
+										"memory_in_mb": 0,
+										"healthy_instances": 0,
+										"total_instances": 2,
+										"instances": [
+											{"index": 0, "state": "down", "uptime_in_seconds": 0, "cpu": 0, "memory_in_bytes": 0, "memory_quota_in_bytes": 0, "disk_in_bytes": 0, "disk_quota_in_bytes": 0},
+											{"index": 1, "state": "down", "uptime_in_seconds": 0, "cpu": 0, "memory_in_bytes": 0, "memory_quota_in_bytes": 0, "disk_in_bytes": 0, "disk_quota_in_bytes": 0}
+										]
+									}
+								],
+								"routes": ["some-app-2.some-domain"],
+								"stack": "",
+								"buildpacks": []
+							}
+						]
+					}`))
+					Expect(testUI.Out).ToNot(Say("requested state"))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("route-warning-4"))
+				})
+			})
 		})
 
 		Context("when app does not have processes", func() {
@@ -310,6 +385,27 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Out).To(Say("Getting apps in org some-org / space some-space as steve\\.\\.\\."))
 				Expect(testUI.Out).To(Say("No apps found"))
 			})
+
+			Context("when the output format is YAML", func() {
+				var documentOut *Buffer
+
+				BeforeEach(func() {
+					documentOut = NewBuffer()
+					testUI.OutputFormat = ui.OutputFormatYAML
+					testUI.DocumentOut = documentOut
+				})
+
+				It("displays an empty list", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(documentOut.Contents()).To(MatchYAML(`
+schema_version: 1
+kind: v3_app_list
+data: []
+`))
+					Expect(testUI.Out).ToNot(Say("No apps found"))
+				})
+			})
 		})
 	})
 })
@@ -41,6 +41,53 @@ type FakeRunTaskActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CheckTaskCompletionStub        func(appGUID string, task v3action.Task) (v3action.Task, bool, v3action.Warnings, error)
+	checkTaskCompletionMutex       sync.RWMutex
+	checkTaskCompletionArgsForCall []struct {
+		appGUID string
+		task    v3action.Task
+	}
+	checkTaskCompletionReturns struct {
+		result1 v3action.Task
+		result2 bool
+		result3 v3action.Warnings
+		result4 error
+	}
+	checkTaskCompletionReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 bool
+		result3 v3action.Warnings
+		result4 error
+	}
+	TerminateTaskStub        func(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	terminateTaskMutex       sync.RWMutex
+	terminateTaskArgsForCall []struct {
+		taskGUID string
+	}
+	terminateTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	terminateTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetStreamingLogsStub        func(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -164,6 +211,170 @@ func (fake *FakeRunTaskActor) RunTaskReturnsOnCall(i int, result1 v3action.Task,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRunTaskActor) CheckTaskCompletion(appGUID string, task v3action.Task) (v3action.Task, bool, v3action.Warnings, error) {
+	fake.checkTaskCompletionMutex.Lock()
+	ret, specificReturn := fake.checkTaskCompletionReturnsOnCall[len(fake.checkTaskCompletionArgsForCall)]
+	fake.checkTaskCompletionArgsForCall = append(fake.checkTaskCompletionArgsForCall, struct {
+		appGUID string
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("CheckTaskCompletion", []interface{}{appGUID, task})
+	fake.checkTaskCompletionMutex.Unlock()
+	if fake.CheckTaskCompletionStub != nil {
+		return fake.CheckTaskCompletionStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.checkTaskCompletionReturns.result1, fake.checkTaskCompletionReturns.result2, fake.checkTaskCompletionReturns.result3, fake.checkTaskCompletionReturns.result4
+}
+
+func (fake *FakeRunTaskActor) CheckTaskCompletionCallCount() int {
+	fake.checkTaskCompletionMutex.RLock()
+	defer fake.checkTaskCompletionMutex.RUnlock()
+	return len(fake.checkTaskCompletionArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) CheckTaskCompletionArgsForCall(i int) (string, v3action.Task) {
+	fake.checkTaskCompletionMutex.RLock()
+	defer fake.checkTaskCompletionMutex.RUnlock()
+	return fake.checkTaskCompletionArgsForCall[i].appGUID, fake.checkTaskCompletionArgsForCall[i].task
+}
+
+func (fake *FakeRunTaskActor) CheckTaskCompletionReturns(result1 v3action.Task, result2 bool, result3 v3action.Warnings, result4 error) {
+	fake.CheckTaskCompletionStub = nil
+	fake.checkTaskCompletionReturns = struct {
+		result1 v3action.Task
+		result2 bool
+		result3 v3action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeRunTaskActor) CheckTaskCompletionReturnsOnCall(i int, result1 v3action.Task, result2 bool, result3 v3action.Warnings, result4 error) {
+	fake.CheckTaskCompletionStub = nil
+	if fake.checkTaskCompletionReturnsOnCall == nil {
+		fake.checkTaskCompletionReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 bool
+			result3 v3action.Warnings
+			result4 error
+		})
+	}
+	fake.checkTaskCompletionReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 bool
+		result3 v3action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeRunTaskActor) TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.terminateTaskMutex.Lock()
+	ret, specificReturn := fake.terminateTaskReturnsOnCall[len(fake.terminateTaskArgsForCall)]
+	fake.terminateTaskArgsForCall = append(fake.terminateTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("TerminateTask", []interface{}{taskGUID})
+	fake.terminateTaskMutex.Unlock()
+	if fake.TerminateTaskStub != nil {
+		return fake.TerminateTaskStub(taskGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.terminateTaskReturns.result1, fake.terminateTaskReturns.result2, fake.terminateTaskReturns.result3
+}
+
+func (fake *FakeRunTaskActor) TerminateTaskCallCount() int {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return len(fake.terminateTaskArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) TerminateTaskArgsForCall(i int) string {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return fake.terminateTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeRunTaskActor) TerminateTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.TerminateTaskStub = nil
+	fake.terminateTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) TerminateTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.TerminateTaskStub = nil
+	if fake.terminateTaskReturnsOnCall == nil {
+		fake.terminateTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.terminateTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{appGUID, client})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].appGUID, fake.getStreamingLogsArgsForCall[i].client
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeRunTaskActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -211,6 +422,12 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
+	fake.checkTaskCompletionMutex.RLock()
+	defer fake.checkTaskCompletionMutex.RUnlock()
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
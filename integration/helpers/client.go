@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -22,9 +23,9 @@ func CreateV2Client(homeDir string) *ccv2.Client {
 	Expect(err).ToNot(HaveOccurred())
 
 	ccWrappers := []ccv2.ConnectionWrapper{}
-	authWrapper := ccWrapper.NewUAAAuthentication(nil, &config)
+	authWrapper := ccWrapper.NewUAAAuthentication(nil, &config, config.TokenRefreshSkew())
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -51,8 +52,8 @@ func CreateV2Client(homeDir string) *ccv2.Client {
 		URL:               ccClient.TokenEndpoint(),
 	})
 
-	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, &config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, &config, config.TokenRefreshSkew()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(retry.NewPolicy(config.RetryMax(), config.RetryBackoff())))
 	authWrapper.SetClient(uaaClient)
 
 	return ccClient
@@ -7,12 +7,15 @@ import (
 	"reflect"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
+	"code.cloudfoundry.org/cli/util/timings"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/jessevdk/go-flags"
 	log "github.com/sirupsen/logrus"
@@ -140,7 +143,11 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Context:      common.Commands.Context,
+		NoCache:      common.Commands.NoCache,
+		OutputFormat: common.Commands.OutputFormat,
+		Timings:      common.Commands.Timings,
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if err != nil {
 		return err
@@ -162,16 +169,84 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
-		err = extendedCmd.Setup(cfConfig, commandUI)
-		if err != nil {
-			return handleError(err, commandUI)
+		name := commandName(cmd)
+		if commandUI.IsStructuredOutput() && !common.Commands.HasStructuredOutput(name) {
+			return handleError(translatableerror.OutputFormatNotSupportedError{
+				Command:           name,
+				Format:            cfConfig.OutputFormat(),
+				SupportedCommands: common.Commands.StructuredOutputCommands(),
+			}, commandUI)
+		}
+
+		var commandErr error
+		finishTrace := traceCommand(cfConfig, commandUI, name)
+		defer func() { finishTrace(commandErr) }()
+
+		commandErr = extendedCmd.Setup(cfConfig, commandUI)
+		if commandErr != nil {
+			return handleError(commandErr, commandUI)
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+		commandErr = extendedCmd.Execute(args)
+		return handleError(commandErr, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// traceCommand starts recording spans for the command when its spans are
+// exported or its timings are printed, and returns the function that
+// finishes recording them once the command is done.
+func traceCommand(cfConfig *configv3.Config, commandUI *ui.UI, name string) func(error) {
+	var exporters tracing.MultiExporter
+	if cfConfig.TraceExport() != "" {
+		exporters = append(exporters, tracing.NewExporter(cfConfig.TraceExport(), cfConfig.BinaryVersion()))
+	}
+
+	recorder := new(tracing.SpanRecorder)
+	if cfConfig.TimingsFormat() != "" {
+		exporters = append(exporters, recorder)
+	}
+
+	if len(exporters) == 0 {
+		return func(error) {}
+	}
+
+	tracer := tracing.NewTracer(exporters)
+	tracing.SetCurrent(tracer)
+	span := tracer.StartCommand(cfConfig.BinaryName() + " " + name)
+
+	return func(commandErr error) {
+		if commandErr != nil {
+			span.SetError(errors.New(commandUI.TranslateError(commandErr)))
+		}
+		span.Finish()
+
+		flushErr := tracer.Flush()
+		if flushErr != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting trace: %s\n", flushErr.Error())
+		}
+
+		if cfConfig.TimingsFormat() != "" {
+			writeErr := timings.Summarize(recorder.Spans).Write(os.Stderr, cfConfig.TimingsFormat())
+			if writeErr != nil {
+				fmt.Fprintf(os.Stderr, "Error writing timings: %s\n", writeErr.Error())
+			}
+		}
+	}
+}
+
+// commandName returns the name of the command whose options cmd points to.
+func commandName(cmd flags.Commander) string {
+	commands := reflect.ValueOf(&common.Commands).Elem()
+	for i := 0; i < commands.NumField(); i++ {
+		field := commands.Field(i)
+		if field.CanAddr() && field.Addr().Interface() == cmd {
+			return commands.Type().Field(i).Tag.Get("command")
+		}
+	}
+	return ""
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -34,6 +35,10 @@ type CliRpcService struct {
 	Pinged   bool
 	RpcCmd   *CliRpcCmd
 	Server   *rpc.Server
+
+	// Tracer, when set, records a span for the plugin command and for each
+	// call the plugin makes to the CLI.
+	Tracer *tracing.Tracer
 }
 
 type CliRpcCmd struct {
@@ -125,7 +130,11 @@ func (cli *CliRpcService) Start() error {
 					fmt.Println(err)
 				}
 			} else {
-				go cli.Server.ServeConn(conn)
+				if cli.Tracer != nil {
+					go cli.Server.ServeCodec(newTracingServerCodec(newGobServerCodec(conn), cli.Tracer))
+				} else {
+					go cli.Server.ServeConn(conn)
+				}
 			}
 		}
 	}()
@@ -222,23 +231,41 @@ func (cmd *CliRpcCmd) GetCurrentSpace(args string, retVal *plugin_models.Space)
 }
 
 func (cmd *CliRpcCmd) Username(args string, retVal *string) error {
+	if err := cmd.checkUserSession("Username"); err != nil {
+		return err
+	}
 	*retVal = cmd.cliConfig.Username()
 
 	return nil
 }
 
 func (cmd *CliRpcCmd) UserGuid(args string, retVal *string) error {
+	if err := cmd.checkUserSession("UserGuid"); err != nil {
+		return err
+	}
 	*retVal = cmd.cliConfig.UserGUID()
 
 	return nil
 }
 
 func (cmd *CliRpcCmd) UserEmail(args string, retVal *string) error {
+	if err := cmd.checkUserSession("UserEmail"); err != nil {
+		return err
+	}
 	*retVal = cmd.cliConfig.UserEmail()
 
 	return nil
 }
 
+// checkUserSession returns an error when the CLI is authenticated as a UAA
+// client, because there is no user to describe.
+func (cmd *CliRpcCmd) checkUserSession(method string) error {
+	if cmd.cliConfig.UAAGrantType() == coreconfig.ClientCredentialsGrantType {
+		return fmt.Errorf("%s is not available when authenticated with client credentials", method)
+	}
+	return nil
+}
+
 func (cmd *CliRpcCmd) IsLoggedIn(args string, retVal *bool) error {
 	*retVal = cmd.cliConfig.IsLoggedIn()
 
@@ -294,7 +321,7 @@ func (cmd *CliRpcCmd) DopplerEndpoint(args string, retVal *string) error {
 }
 
 func (cmd *CliRpcCmd) AccessToken(args string, retVal *string) error {
-	token, err := cmd.repoLocator.GetAuthenticationRepository().RefreshAuthToken()
+	token, err := cmd.repoLocator.GetAuthenticationRepository().ValidAccessToken()
 	if err != nil {
 		return err
 	}
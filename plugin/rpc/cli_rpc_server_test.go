@@ -7,6 +7,8 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/tracing"
+	"code.cloudfoundry.org/cli/api/tracing/tracingfakes"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -101,6 +103,61 @@ var _ = Describe("Server", func() {
 		})
 	})
 
+	Describe("tracing", func() {
+		var (
+			fakeExporter *tracingfakes.FakeExporter
+			tracer       *tracing.Tracer
+		)
+
+		BeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeExporter = new(tracingfakes.FakeExporter)
+			tracer = tracing.NewTracer(fakeExporter)
+			rpcService.Tracer = tracer
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		It("records a span for each call", func() {
+			root := tracer.StartCommand("cf some-plugin-command")
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+
+			var result bool
+			err = client.Call("CliRpcCmd.SetPluginMetadata", plugin.PluginMetadata{Name: "foo"}, &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+
+			err = client.Call("CliRpcCmd.NoSuchMethod", "", &result)
+			Expect(err).To(HaveOccurred())
+
+			Expect(tracer.Flush()).To(Succeed())
+			spans := fakeExporter.ExportArgsForCall(0)
+			Expect(spans).To(HaveLen(2))
+
+			Expect(spans[0].Name).To(Equal("rpc CliRpcCmd.SetPluginMetadata"))
+			Expect(spans[0].Kind).To(Equal(tracing.SpanKindServer))
+			Expect(spans[0].ParentSpanID).To(Equal(root.SpanID))
+			Expect(spans[0].Error).To(BeEmpty())
+
+			Expect(spans[1].Name).To(Equal("rpc CliRpcCmd.NoSuchMethod"))
+			Expect(spans[1].Error).To(Equal(err.Error()))
+		})
+	})
+
 	// Describe(".IsMinCliVersion()", func() {
 	// 	BeforeEach(func() {
 	// 		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
@@ -565,6 +622,27 @@ var _ = Describe("Server", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(Equal("my-user-email"))
 				})
+
+				Context("when authenticated with client credentials", func() {
+					BeforeEach(func() {
+						config.SetUAAGrantType("client_credentials")
+					})
+
+					It("returns an error for each", func() {
+						client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+						Expect(err).ToNot(HaveOccurred())
+
+						var result string
+						err = client.Call("CliRpcCmd.Username", "", &result)
+						Expect(err).To(MatchError("Username is not available when authenticated with client credentials"))
+
+						err = client.Call("CliRpcCmd.UserGuid", "", &result)
+						Expect(err).To(MatchError("UserGuid is not available when authenticated with client credentials"))
+
+						err = client.Call("CliRpcCmd.UserEmail", "", &result)
+						Expect(err).To(MatchError("UserEmail is not available when authenticated with client credentials"))
+					})
+				})
 			})
 
 			Context(".IsSSLDisabled", func() {
@@ -707,7 +785,7 @@ var _ = Describe("Server", func() {
 					pingCli(rpcService.Port())
 				})
 
-				It("refreshes the token", func() {
+				It("gets an access token that is valid for the refresh skew", func() {
 					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
 					Expect(err).ToNot(HaveOccurred())
 
@@ -715,11 +793,11 @@ var _ = Describe("Server", func() {
 					err = client.Call("CliRpcCmd.AccessToken", "", &result)
 					Expect(err).ToNot(HaveOccurred())
 
-					Expect(authRepo.RefreshAuthTokenCallCount()).To(Equal(1))
+					Expect(authRepo.ValidAccessTokenCallCount()).To(Equal(1))
 				})
 
 				It("returns the access token", func() {
-					authRepo.RefreshAuthTokenReturns("fake-access-token", nil)
+					authRepo.ValidAccessTokenReturns("fake-access-token", nil)
 
 					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
 					Expect(err).ToNot(HaveOccurred())
@@ -731,7 +809,7 @@ var _ = Describe("Server", func() {
 				})
 
 				It("returns the error from refreshing the access token", func() {
-					authRepo.RefreshAuthTokenReturns("", errors.New("refresh error"))
+					authRepo.ValidAccessTokenReturns("", errors.New("refresh error"))
 
 					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
 					Expect(err).ToNot(HaveOccurred())
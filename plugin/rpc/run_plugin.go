@@ -1,9 +1,11 @@
 package rpc
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 
+	"code.cloudfoundry.org/cli/api/tracing"
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
 )
 
@@ -13,6 +15,11 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 			if command.Name == args[0] || command.Alias == args[0] {
 				args[0] = command.Name
 
+				var span *tracing.Span
+				if rpcService.Tracer != nil {
+					span = rpcService.Tracer.StartCommand("cf " + command.Name)
+				}
+
 				rpcService.Start()
 				defer rpcService.Stop()
 
@@ -22,9 +29,17 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 				cmd.Stdout = os.Stdout
 				cmd.Stdin = os.Stdin
 				cmd.Stderr = os.Stderr
+				if span != nil {
+					// Plugins that trace their own requests can continue the
+					// trace from the environment.
+					cmd.Env = append(os.Environ(), "TRACEPARENT="+span.TraceParent())
+				}
 
 				defer stopPlugin(cmd)
 				err := cmd.Run()
+				if span != nil {
+					finishTrace(rpcService.Tracer, span, err)
+				}
 				if err != nil {
 					os.Exit(1)
 				}
@@ -35,6 +50,14 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 	return false
 }
 
+func finishTrace(tracer *tracing.Tracer, span *tracing.Span, err error) {
+	span.SetError(err)
+	span.Finish()
+	if flushErr := tracer.Flush(); flushErr != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting trace: %s\n", flushErr.Error())
+	}
+}
+
 func stopPlugin(plugin *exec.Cmd) {
 	plugin.Process.Kill()
 	plugin.Wait()
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"io"
+	"net/rpc"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+)
+
+// gobServerCodec is the codec net/rpc uses in ServeConn, which it does not
+// export.
+type gobServerCodec struct {
+	rwc    io.ReadWriteCloser
+	dec    *gob.Decoder
+	enc    *gob.Encoder
+	encBuf *bufio.Writer
+	closed bool
+}
+
+func newGobServerCodec(conn io.ReadWriteCloser) *gobServerCodec {
+	buf := bufio.NewWriter(conn)
+	return &gobServerCodec{
+		rwc:    conn,
+		dec:    gob.NewDecoder(conn),
+		enc:    gob.NewEncoder(buf),
+		encBuf: buf,
+	}
+}
+
+func (c *gobServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	return c.dec.Decode(r)
+}
+
+func (c *gobServerCodec) ReadRequestBody(body interface{}) error {
+	return c.dec.Decode(body)
+}
+
+func (c *gobServerCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	if err := c.enc.Encode(r); err != nil {
+		if c.encBuf.Flush() == nil {
+			c.Close()
+		}
+		return err
+	}
+	if err := c.enc.Encode(body); err != nil {
+		if c.encBuf.Flush() == nil {
+			c.Close()
+		}
+		return err
+	}
+	return c.encBuf.Flush()
+}
+
+func (c *gobServerCodec) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.rwc.Close()
+}
+
+// tracingServerCodec records a server span for each call a plugin makes to
+// the CLI, from reading the request to responding to it.
+type tracingServerCodec struct {
+	rpc.ServerCodec
+	tracer *tracing.Tracer
+
+	mutex sync.Mutex
+	spans map[uint64]*tracing.Span
+}
+
+func newTracingServerCodec(codec rpc.ServerCodec, tracer *tracing.Tracer) *tracingServerCodec {
+	return &tracingServerCodec{
+		ServerCodec: codec,
+		tracer:      tracer,
+		spans:       map[uint64]*tracing.Span{},
+	}
+}
+
+func (c *tracingServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	err := c.ServerCodec.ReadRequestHeader(r)
+	if err != nil {
+		return err
+	}
+
+	span := c.tracer.StartServer("rpc " + r.ServiceMethod)
+	span.SetAttribute("rpc.system", "net_rpc")
+	span.SetAttribute("rpc.method", r.ServiceMethod)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.spans[r.Seq] = span
+	return nil
+}
+
+func (c *tracingServerCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	c.mutex.Lock()
+	span, ok := c.spans[r.Seq]
+	delete(c.spans, r.Seq)
+	c.mutex.Unlock()
+
+	// The span is finished before the response is written so that it has
+	// been recorded by the time the plugin receives the response.
+	if ok {
+		if r.Error != "" {
+			span.SetError(errors.New(r.Error))
+		}
+		span.Finish()
+	}
+
+	return c.ServerCodec.WriteResponse(r, body)
+}
@@ -0,0 +1,282 @@
+// Package cassette records the HTTP requests made by the CLI, and the
+// responses to them, to a file so that they can be replayed later without
+// network access.
+//
+// A cassette file contains one JSON encoded Interaction per line. Tokens,
+// passwords and secrets in request and response bodies are redacted with the
+// same rules as the request logger before anything is written, and requests
+// are matched on their method, path, query and redacted body.
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// Base64Encoding is the BodyEncoding of bodies that are not valid UTF-8.
+const Base64Encoding = "base64"
+
+// Request is the part of a recorded request that is used for matching.
+type Request struct {
+	Method       string
+	Path         string
+	Query        string
+	Body         string
+	BodyEncoding string `json:",omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode   int
+	Header       http.Header
+	Body         string
+	BodyEncoding string `json:",omitempty"`
+}
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request  Request
+	Response Response
+}
+
+// NoInteractionError is returned when replaying a request that is not in the
+// cassette.
+type NoInteractionError struct {
+	Method string
+	URL    string
+	Path   string
+}
+
+func (e NoInteractionError) Error() string {
+	return fmt.Sprintf("No recorded response for %s %s in cassette %s", e.Method, e.URL, e.Path)
+}
+
+// Cassette is a file of recorded interactions.
+type Cassette struct {
+	Path string
+
+	replaying    bool
+	interactions []Interaction
+	used         []bool
+	mutex        sync.Mutex
+}
+
+// Open returns a cassette that replays the interactions in replayPath or,
+// if replayPath is empty, one that records interactions to recordPath. It
+// returns nil when both paths are empty.
+func Open(recordPath string, replayPath string) (*Cassette, error) {
+	switch {
+	case replayPath != "":
+		return Load(replayPath)
+	case recordPath != "":
+		return New(recordPath), nil
+	default:
+		return nil, nil
+	}
+}
+
+// New returns a cassette that appends recorded interactions to the file at
+// path. The file is created when the first interaction is recorded.
+func New(path string) *Cassette {
+	return &Cassette{Path: path}
+}
+
+// Load returns a cassette that replays the interactions in the file at path.
+func Load(path string) (*Cassette, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	cassette := &Cassette{Path: path, replaying: true}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		err = json.Unmarshal(line, &interaction)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid interaction in cassette %s: %s", path, err)
+		}
+		cassette.interactions = append(cassette.interactions, interaction)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	cassette.used = make([]bool, len(cassette.interactions))
+	return cassette, nil
+}
+
+// Replaying returns true when the cassette replays interactions instead of
+// recording them.
+func (cassette *Cassette) Replaying() bool {
+	return cassette.replaying
+}
+
+// Record appends the request and response to the cassette file. The bodies
+// are passed separately because they have already been read from the request
+// and response.
+func (cassette *Cassette) Record(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error {
+	interaction := Interaction{
+		Request: newRequest(request, requestBody),
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Header:     response.Header,
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeBody(sanitizeBody(response.Header.Get("Content-Type"), responseBody))
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return err
+	}
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	file, err := os.OpenFile(cassette.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// RoundTrip returns the recorded response to the request. Each recorded
+// interaction is replayed once, in the order it was recorded; once every
+// matching interaction has been used the last one is replayed again, so that
+// polling requests still get a response.
+func (cassette *Cassette) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil && !isMultipart(request.Header.Get("Content-Type")) {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+	}
+	recorded := newRequest(request, body)
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	match := -1
+	for i, interaction := range cassette.interactions {
+		if interaction.Request != recorded {
+			continue
+		}
+		match = i
+		if !cassette.used[i] {
+			break
+		}
+	}
+	if match == -1 {
+		return nil, NoInteractionError{Method: request.Method, URL: request.URL.String(), Path: cassette.Path}
+	}
+	cassette.used[match] = true
+
+	recordedResponse := cassette.interactions[match].Response
+	responseBody, err := decodeBody(recordedResponse.Body, recordedResponse.BodyEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	for key, values := range recordedResponse.Header {
+		header[key] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResponse.StatusCode, http.StatusText(recordedResponse.StatusCode)),
+		StatusCode:    recordedResponse.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       request,
+	}, nil
+}
+
+func newRequest(request *http.Request, body []byte) Request {
+	recorded := Request{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		// Encode sorts the query by key so that the order parameters were added
+		// in does not matter.
+		Query: request.URL.Query().Encode(),
+	}
+	recorded.Body, recorded.BodyEncoding = encodeBody(sanitizeBody(request.Header.Get("Content-Type"), body))
+	return recorded
+}
+
+// sanitizeBody redacts the tokens, passwords and secrets in JSON and form
+// bodies. Multipart bodies, such as application bits, are not recorded.
+func sanitizeBody(contentType string, body []byte) []byte {
+	if len(body) == 0 || isMultipart(contentType) {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			return []byte(ui.SanitizeFormValues(values).Encode())
+		}
+		return body
+	}
+
+	sanitized, err := ui.SanitizeJSON(body)
+	if err != nil {
+		return body
+	}
+	// Marshalling the decoded body also normalizes its key order and
+	// whitespace.
+	rawSanitized, err := json.Marshal(sanitized)
+	if err != nil {
+		return body
+	}
+	return rawSanitized
+}
+
+func isMultipart(contentType string) bool {
+	return strings.HasPrefix(contentType, "multipart/")
+}
+
+func encodeBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), Base64Encoding
+}
+
+func decodeBody(body string, encoding string) ([]byte, error) {
+	if encoding == Base64Encoding {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
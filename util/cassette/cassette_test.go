@@ -0,0 +1,204 @@
+package cassette_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/cassette"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	newRequest := func(method string, url string, contentType string, body string) *http.Request {
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		return request
+	}
+
+	newResponse := func(statusCode int) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+		}
+	}
+
+	record := func(cassette *Cassette, request *http.Request, requestBody string, statusCode int, responseBody string) {
+		err := cassette.Record(request, []byte(requestBody), newResponse(statusCode), []byte(responseBody))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	replay := func(cassette *Cassette, request *http.Request) (int, string) {
+		response, err := cassette.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, string(body)
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cassette")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.jsonl")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Open", func() {
+		Context("when no paths are provided", func() {
+			It("returns nil", func() {
+				cassette, err := Open("", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cassette).To(BeNil())
+			})
+		})
+
+		Context("when a record path is provided", func() {
+			It("returns a recording cassette", func() {
+				cassette, err := Open(path, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cassette.Replaying()).To(BeFalse())
+			})
+		})
+
+		Context("when a replay path is provided", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, nil, 0600)).To(Succeed())
+			})
+
+			It("returns a replaying cassette, even when a record path is provided", func() {
+				cassette, err := Open(filepath.Join(dir, "other"), path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cassette.Replaying()).To(BeTrue())
+			})
+		})
+
+		Context("when the replay file does not exist", func() {
+			It("returns an error", func() {
+				_, err := Open("", filepath.Join(dir, "does-not-exist"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the replay file is invalid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("{"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := Open("", path)
+				Expect(err).To(MatchError(ContainSubstring("Invalid interaction in cassette")))
+			})
+		})
+	})
+
+	Describe("Record", func() {
+		var recorder *Cassette
+
+		BeforeEach(func() {
+			recorder = New(path)
+		})
+
+		It("appends one interaction per line to a file only the user can read", func() {
+			record(recorder, newRequest("GET", "https://api.example.com/v2/apps", "", ""), "", http.StatusOK, `{"resources":[]}`)
+			record(recorder, newRequest("GET", "https://api.example.com/v2/spaces", "", ""), "", http.StatusOK, `{"resources":[]}`)
+
+			rawCassette, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(rawCassette)), "\n")).To(HaveLen(2))
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("redacts tokens from JSON and form bodies", func() {
+			record(recorder,
+				newRequest("POST", "https://uaa.example.com/oauth/token", "application/x-www-form-urlencoded", ""),
+				"grant_type=password&password=some-password", http.StatusOK, `{"access_token":"some-access-token","scope":"cloud_controller.read"}`)
+			record(recorder,
+				newRequest("PUT", "https://api.example.com/v2/service_brokers/guid", "application/json", ""),
+				`{"auth_password":"some-secret"}`, http.StatusCreated, `{}`)
+
+			rawCassette, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawCassette)).ToNot(ContainSubstring("some-password"))
+			Expect(string(rawCassette)).ToNot(ContainSubstring("some-access-token"))
+			Expect(string(rawCassette)).ToNot(ContainSubstring("some-secret"))
+			Expect(string(rawCassette)).To(ContainSubstring("cloud_controller.read"))
+		})
+	})
+
+	Describe("RoundTrip", func() {
+		var player *Cassette
+
+		BeforeEach(func() {
+			recorder := New(path)
+			record(recorder, newRequest("GET", "https://api.example.com/v2/apps?q=name:a&page=1", "", ""), "", http.StatusOK, `{"name":"first"}`)
+			record(recorder, newRequest("GET", "https://api.example.com/v2/apps?q=name:a&page=1", "", ""), "", http.StatusOK, `{"name":"second"}`)
+			record(recorder, newRequest("POST", "https://api.example.com/v2/apps", "application/json", ""), `{"name":"a"}`, http.StatusCreated, `{"name":"created"}`)
+			record(recorder,
+				newRequest("POST", "https://uaa.example.com/oauth/token", "application/x-www-form-urlencoded", ""),
+				"grant_type=refresh_token&refresh_token=old-token", http.StatusOK, `{"access_token":"new-token"}`)
+			record(recorder, newRequest("GET", "https://api.example.com/v2/info", "", ""), "", http.StatusOK, string([]byte{0xff, 0xfe}))
+
+			var err error
+			player, err = Load(path)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("replays matching interactions in order and then repeats the last one", func() {
+			request := newRequest("GET", "https://other.example.com/v2/apps?page=1&q=name:a", "", "")
+			_, body := replay(player, request)
+			Expect(body).To(Equal(`{"name":"first"}`))
+
+			_, body = replay(player, request)
+			Expect(body).To(Equal(`{"name":"second"}`))
+
+			_, body = replay(player, request)
+			Expect(body).To(Equal(`{"name":"second"}`))
+		})
+
+		It("matches on the request body", func() {
+			statusCode, body := replay(player, newRequest("POST", "https://api.example.com/v2/apps", "application/json", `{ "name": "a" }`))
+			Expect(statusCode).To(Equal(http.StatusCreated))
+			Expect(body).To(Equal(`{"name":"created"}`))
+
+			_, err := player.RoundTrip(newRequest("POST", "https://api.example.com/v2/apps", "application/json", `{"name":"b"}`))
+			Expect(err).To(MatchError(NoInteractionError{Method: "POST", URL: "https://api.example.com/v2/apps", Path: path}))
+		})
+
+		It("matches requests whose tokens differ from the recording", func() {
+			_, body := replay(player, newRequest("POST", "https://uaa.example.com/oauth/token", "application/x-www-form-urlencoded", "refresh_token=other-token&grant_type=refresh_token"))
+			Expect(body).To(Equal(`{"access_token":"` + ui.RedactedValue + `"}`))
+		})
+
+		It("replays bodies that are not valid UTF-8", func() {
+			_, body := replay(player, newRequest("GET", "https://api.example.com/v2/info", "", ""))
+			Expect([]byte(body)).To(Equal([]byte{0xff, 0xfe}))
+		})
+
+		It("returns a NoInteractionError for unknown requests", func() {
+			_, err := player.RoundTrip(newRequest("DELETE", "https://api.example.com/v2/apps/guid", "", ""))
+			Expect(err).To(MatchError(NoInteractionError{Method: "DELETE", URL: "https://api.example.com/v2/apps/guid", Path: path}))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -37,6 +38,17 @@ const (
 	DefaultStartupTimeout = 5 * time.Minute
 	// DefaultPingerThrottle = 5 * time.Second
 
+	// DefaultRetryMax is the number of times a failed request is retried.
+	DefaultRetryMax = 2
+
+	// DefaultRetryBackoff is how long to wait before the first retry of a
+	// failed request.
+	DefaultRetryBackoff = 500 * time.Millisecond
+
+	// DefaultTokenRefreshSkew is how long before it expires an access token is
+	// refreshed.
+	DefaultTokenRefreshSkew = 60 * time.Second
+
 	// DefaultTarget is the default CFConfig value for Target.
 	DefaultTarget = ""
 
@@ -93,6 +105,14 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 			return nil, err
 		}
 
+		if IsExternalCredentialStore(config.ConfigFile.CredentialStore) {
+			err = config.ConfigFile.loadCredentials(config.credentialStore())
+			if err != nil {
+				return nil, err
+			}
+			config.storedCredentials = config.ConfigFile.storedCredentials(config.credentialStore())
+		}
+
 		if config.ConfigFile.UAAOAuthClient == "" {
 			config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
 			config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
@@ -100,19 +120,32 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
+		BinaryName:         filepath.Base(os.Args[0]),
+		CFCache:            os.Getenv("CF_CACHE"),
+		CFColor:            os.Getenv("CF_COLOR"),
+		CFContext:          os.Getenv("CF_CONTEXT"),
+		CFClientID:         os.Getenv("CF_CLIENT_ID"),
+		CFClientSecret:     os.Getenv("CF_CLIENT_SECRET"),
+		CFDockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
+		CFPassword:         os.Getenv("CF_PASSWORD"),
+		CFPluginHome:       os.Getenv("CF_PLUGIN_HOME"),
+		CFRecord:           os.Getenv("CF_RECORD"),
+		CFReplay:           os.Getenv("CF_REPLAY"),
+		CFRetryBackoff:     os.Getenv("CF_RETRY_BACKOFF"),
+		CFRetryMax:         os.Getenv("CF_RETRY_MAX"),
+		CFStagingTimeout:   os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:   os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTokenRefreshSkew: os.Getenv("CF_TOKEN_REFRESH_SKEW"),
+		CFTrace:            os.Getenv("CF_TRACE"),
+		CFTraceExport:      os.Getenv("CF_TRACE_EXPORT"),
+		CFUsername:         os.Getenv("CF_USERNAME"),
+		HTTPSProxy:         os.Getenv("https_proxy"),
+		Lang:               os.Getenv("LANG"),
+		LCAll:              os.Getenv("LC_ALL"),
+		Experimental:       os.Getenv("CF_CLI_EXPERIMENTAL"),
+		CFDialTimeout:      os.Getenv("CF_DIAL_TIMEOUT"),
+		ForceTTY:           os.Getenv("FORCE_TTY"),
+		CFLogLevel:         os.Getenv("CF_LOG_LEVEL"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -141,6 +174,11 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		config.Flags = flags[0]
 	}
 
+	err := config.loadActiveContext()
+	if err != nil {
+		return nil, err
+	}
+
 	pwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
@@ -169,9 +207,21 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. When an external credential store is configured, the tokens and
+// client secret are saved in the store instead of config.json. Once the file
+// is written, the credentials the config no longer keeps are erased from the
+// credential helper it was loaded with, such as after switching targets or
+// credential stores.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	configFile := c.persistedConfigFile()
+	if IsExternalCredentialStore(configFile.CredentialStore) {
+		err := configFile.moveCredentialsToStore(c.credentialStore())
+		if err != nil {
+			return err
+		}
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -181,7 +231,18 @@ func WriteConfig(c *Config) error {
 		return err
 	}
 
-	return ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	err = ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	if err != nil {
+		return err
+	}
+
+	stored := configFile.storedCredentials(c.credentialStore())
+	err = c.storedCredentials.EraseStale(stored)
+	if err != nil {
+		return err
+	}
+	c.storedCredentials = stored
+	return nil
 }
 
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
@@ -200,32 +261,53 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// overriddenTarget stores the current context's target values while a
+	// different context is active.
+	overriddenTarget *TargetContext
+
+	// store is the credential store named by the config file.
+	store CredentialStore
+
+	// storedCredentials are the credentials the config file kept in a
+	// credential store when it was last loaded or written.
+	storedCredentials StoredCredentials
+
+	// tokenMutex guards the tokens in the config file and its contexts, which
+	// are refreshed by requests made from several goroutines, such as apps
+	// pushed in parallel.
+	tokenMutex sync.RWMutex
 }
 
 // CFConfig represents .cf/config.json
 type CFConfig struct {
-	ConfigVersion            int                `json:"ConfigVersion"`
-	Target                   string             `json:"Target"`
-	APIVersion               string             `json:"APIVersion"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	AccessToken              string             `json:"AccessToken"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
-	RefreshToken             string             `json:"RefreshToken"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	Trace                    string             `json:"Trace"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	Locale                   string             `json:"Locale"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	ConfigVersion            int                      `json:"ConfigVersion"`
+	Target                   string                   `json:"Target"`
+	APIVersion               string                   `json:"APIVersion"`
+	AuthorizationEndpoint    string                   `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string                   `json:"DopplerEndPoint"`
+	UAAEndpoint              string                   `json:"UaaEndpoint"`
+	RoutingEndpoint          string                   `json:"RoutingAPIEndpoint"`
+	AccessToken              string                   `json:"AccessToken"`
+	SSHOAuthClient           string                   `json:"SSHOAuthClient"`
+	UAAOAuthClient           string                   `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                   `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string                   `json:"UAAGrantType"`
+	RefreshToken             string                   `json:"RefreshToken"`
+	TargetedOrganization     Organization             `json:"OrganizationFields"`
+	TargetedSpace            Space                    `json:"SpaceFields"`
+	SkipSSLValidation        bool                     `json:"SSLDisabled"`
+	AsyncTimeout             int                      `json:"AsyncTimeout"`
+	Trace                    string                   `json:"Trace"`
+	ColorEnabled             string                   `json:"ColorEnabled"`
+	Locale                   string                   `json:"Locale"`
+	PluginRepositories       []PluginRepository       `json:"PluginRepos"`
+	MinCLIVersion            string                   `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                   `json:"MinRecommendedCLIVersion"`
+	CurrentContext           string                   `json:"CurrentContext,omitempty"`
+	Contexts                 map[string]TargetContext `json:"Contexts,omitempty"`
+	CredentialStore          string                   `json:"CredentialStore,omitempty"`
+	MaxRequestsPerSecond     int                      `json:"MaxRequestsPerSecond,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -257,25 +339,42 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFHome           string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
-	Experimental     string
-	CFDialTimeout    string
-	ForceTTY         string
-	CFLogLevel       string
+	BinaryName         string
+	CFCache            string
+	CFColor            string
+	CFClientID         string
+	CFClientSecret     string
+	CFContext          string
+	CFDockerPassword   string
+	CFHome             string
+	CFPassword         string
+	CFPluginHome       string
+	CFRecord           string
+	CFReplay           string
+	CFRetryBackoff     string
+	CFRetryMax         string
+	CFStagingTimeout   string
+	CFStartupTimeout   string
+	CFTokenRefreshSkew string
+	CFTrace            string
+	CFTraceExport      string
+	CFUsername         string
+	HTTPSProxy         string
+	Lang               string
+	LCAll              string
+	Experimental       string
+	CFDialTimeout      string
+	ForceTTY           string
+	CFLogLevel         string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Context      string
+	NoCache      bool
+	OutputFormat string
+	Timings      string
+	Verbose      bool
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
@@ -314,11 +413,15 @@ func (config *Config) SkipSSLValidation() bool {
 
 // AccessToken returns the access token for making authenticated API calls
 func (config *Config) AccessToken() string {
+	config.tokenMutex.RLock()
+	defer config.tokenMutex.RUnlock()
 	return config.ConfigFile.AccessToken
 }
 
 // RefreshToken returns the refresh token for getting a new access token
 func (config *Config) RefreshToken() string {
+	config.tokenMutex.RLock()
+	defer config.tokenMutex.RUnlock()
 	return config.ConfigFile.RefreshToken
 }
 
@@ -332,6 +435,12 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAGrantType returns the grant the access token was obtained with. It is
+// empty for the password grant.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -394,6 +503,45 @@ func (config *Config) HTTPSProxy() string {
 	return ""
 }
 
+// DockerPassword returns the docker password from the environment.
+func (config *Config) DockerPassword() string {
+	return config.ENV.CFDockerPassword
+}
+
+// CFUsername returns the username to authenticate with from the environment.
+func (config *Config) CFUsername() string {
+	return config.ENV.CFUsername
+}
+
+// CFPassword returns the password to authenticate with from the environment.
+func (config *Config) CFPassword() string {
+	return config.ENV.CFPassword
+}
+
+// CFClientID returns the UAA client ID to authenticate with from the
+// environment.
+func (config *Config) CFClientID() string {
+	return config.ENV.CFClientID
+}
+
+// CFClientSecret returns the UAA client secret to authenticate with from the
+// environment.
+func (config *Config) CFClientSecret() string {
+	return config.ENV.CFClientSecret
+}
+
+// Secrets returns the credentials set in the environment, so that they can
+// be redacted from output.
+func (config *Config) Secrets() []string {
+	var secrets []string
+	for _, secret := range []string{config.ENV.CFPassword, config.ENV.CFClientSecret, config.ENV.CFDockerPassword} {
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
 // BinaryName returns the running name of the CF CLI
 func (config *Config) BinaryName() string {
 	return config.ENV.BinaryName
@@ -498,6 +646,19 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// OutputFormat returns the format command results are displayed in. This is
+// based off of the '--output' global flag and defaults to the table format.
+func (config *Config) OutputFormat() string {
+	return config.Flags.OutputFormat
+}
+
+// TimingsFormat returns the format of the summary of where the time went
+// that is printed when a command finishes, set with the '--timings' global
+// flag. It is empty when no summary is printed.
+func (config *Config) TimingsFormat() string {
+	return config.Flags.Timings
+}
+
 // TerminalWidth returns the width of the terminal from when the config
 // was loaded. If the terminal width has changed since the config has loaded,
 // it will **not** return the new width.
@@ -519,6 +680,102 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// HTTPCacheEnabled returns whether Cloud Controller responses are cached.
+// This is based off of:
+//   1. The '--no-cache' global flag, which turns the cache off
+//   2. The $CF_CACHE environment variable if set
+//   3. Defaults to false
+func (config *Config) HTTPCacheEnabled() bool {
+	if config.Flags.NoCache {
+		return false
+	}
+
+	if config.ENV.CFCache != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFCache)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
+// MaxRequestsPerSecond returns the number of requests per second the CLI
+// sends to the Cloud Controller, set with 'cf config
+// --max-requests-per-second'. Zero means there is no limit.
+func (config *Config) MaxRequestsPerSecond() int {
+	return config.ConfigFile.MaxRequestsPerSecond
+}
+
+// RecordPath returns the path of the cassette file that requests and their
+// responses are recorded to, from the $CF_RECORD environment variable.
+func (config *Config) RecordPath() string {
+	return config.ENV.CFRecord
+}
+
+// ReplayPath returns the path of the cassette file that responses are
+// replayed from instead of making requests, from the $CF_REPLAY environment
+// variable.
+func (config *Config) ReplayPath() string {
+	return config.ENV.CFReplay
+}
+
+// RetryMax returns the number of times a failed request is retried. This is
+// based off of:
+//   1. The $CF_RETRY_MAX environment variable if set
+//   2. Defaults to 2
+func (config *Config) RetryMax() int {
+	if config.ENV.CFRetryMax != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRetryMax)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryMax
+}
+
+// RetryBackoff returns how long to wait before the first retry of a failed
+// request; later retries wait exponentially longer. This is based off of:
+//   1. The $CF_RETRY_BACKOFF environment variable if set, either as a duration
+//     such as "250ms" or in seconds
+//   2. Defaults to 500 milliseconds
+func (config *Config) RetryBackoff() time.Duration {
+	if config.ENV.CFRetryBackoff != "" {
+		if envVal, err := strconv.ParseFloat(config.ENV.CFRetryBackoff, 64); err == nil && envVal >= 0 {
+			return time.Duration(envVal * float64(time.Second))
+		}
+		if envVal, err := time.ParseDuration(config.ENV.CFRetryBackoff); err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryBackoff
+}
+
+// TokenRefreshSkew returns how long before it expires an access token is
+// refreshed. This is based off of:
+//   1. The $CF_TOKEN_REFRESH_SKEW environment variable (in seconds) if set
+//   2. Defaults to 60 seconds
+func (config *Config) TokenRefreshSkew() time.Duration {
+	if config.ENV.CFTokenRefreshSkew != "" {
+		envVal, err := strconv.ParseInt(config.ENV.CFTokenRefreshSkew, 10, 64)
+		if err == nil && envVal >= 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	return DefaultTokenRefreshSkew
+}
+
+// TraceExport returns where the spans recorded while running a command are
+// exported to, from the $CF_TRACE_EXPORT environment variable. It is either
+// the URL of an OTLP/HTTP endpoint or the path of a file; tracing is off when
+// it is empty.
+func (config *Config) TraceExport() string {
+	return config.ENV.CFTraceExport
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -565,18 +822,50 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 
 // SetTokenInformation sets the current token/user information
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
 	config.ConfigFile.AccessToken = accessToken
 	config.ConfigFile.RefreshToken = refreshToken
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
 }
 
+// SetCredentialStore sets the store that keeps the tokens and client secret.
+// The credentials are moved to the new store when the config is written, and
+// are then erased from the credential helper that kept them before, if any.
+func (config *Config) SetCredentialStore(name string) {
+	config.ConfigFile.CredentialStore = name
+	config.store = nil
+}
+
+// SetUAAGrantType sets the grant the access token was obtained with
+func (config *Config) SetUAAGrantType(grantType string) {
+	config.ConfigFile.UAAGrantType = grantType
+}
+
+// SetUAAClientCredentials sets the UAA client ID and secret used to obtain
+// and refresh tokens
+func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.ConfigFile.UAAOAuthClient = client
+	config.ConfigFile.UAAOAuthClientSecret = clientSecret
+}
+
+// UnsetUAAClientCredentials resets the UAA client ID and secret to the CLI's
+// own client
+func (config *Config) UnsetUAAClientCredentials() {
+	config.SetUAAClientCredentials(DefaultUAAOAuthClient, DefaultUAAOAuthClientSecret)
+}
+
 // SetAccessToken sets the current access token
 func (config *Config) SetAccessToken(accessToken string) {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
 	config.ConfigFile.AccessToken = accessToken
 }
 
 // SetRefreshToken sets the current refresh token
 func (config *Config) SetRefreshToken(refreshToken string) {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
@@ -461,6 +462,183 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("HTTPCacheEnabled", func() {
+			It("returns the value of $CF_CACHE", func() {
+				Expect((&Config{ENV: EnvOverride{CFCache: "true"}}).HTTPCacheEnabled()).To(BeTrue())
+				Expect((&Config{ENV: EnvOverride{CFCache: "false"}}).HTTPCacheEnabled()).To(BeFalse())
+			})
+
+			It("is turned off by the --no-cache flag", func() {
+				config := Config{ENV: EnvOverride{CFCache: "true"}, Flags: FlagOverride{NoCache: true}}
+				Expect(config.HTTPCacheEnabled()).To(BeFalse())
+			})
+
+			It("defaults to false", func() {
+				Expect((&Config{}).HTTPCacheEnabled()).To(BeFalse())
+				Expect((&Config{ENV: EnvOverride{CFCache: "invalid"}}).HTTPCacheEnabled()).To(BeFalse())
+			})
+		})
+
+		Describe("MaxRequestsPerSecond", func() {
+			It("returns the limit from the config file", func() {
+				config := Config{ConfigFile: CFConfig{MaxRequestsPerSecond: 10}}
+				Expect(config.MaxRequestsPerSecond()).To(Equal(10))
+			})
+
+			It("defaults to no limit", func() {
+				Expect((&Config{}).MaxRequestsPerSecond()).To(BeZero())
+			})
+		})
+
+		Describe("RecordPath", func() {
+			It("returns the value of $CF_RECORD", func() {
+				config := Config{ENV: EnvOverride{CFRecord: "some-cassette"}}
+				Expect(config.RecordPath()).To(Equal("some-cassette"))
+			})
+		})
+
+		Describe("ReplayPath", func() {
+			It("returns the value of $CF_REPLAY", func() {
+				config := Config{ENV: EnvOverride{CFReplay: "some-cassette"}}
+				Expect(config.ReplayPath()).To(Equal("some-cassette"))
+			})
+		})
+
+		Describe("RetryMax", func() {
+			It("returns the value of $CF_RETRY_MAX", func() {
+				config := Config{ENV: EnvOverride{CFRetryMax: "5"}}
+				Expect(config.RetryMax()).To(Equal(5))
+			})
+
+			It("allows retries to be turned off", func() {
+				config := Config{ENV: EnvOverride{CFRetryMax: "0"}}
+				Expect(config.RetryMax()).To(Equal(0))
+			})
+
+			It("defaults when $CF_RETRY_MAX is not set or invalid", func() {
+				Expect((&Config{}).RetryMax()).To(Equal(DefaultRetryMax))
+				Expect((&Config{ENV: EnvOverride{CFRetryMax: "-1"}}).RetryMax()).To(Equal(DefaultRetryMax))
+			})
+		})
+
+		Describe("RetryBackoff", func() {
+			It("returns $CF_RETRY_BACKOFF in seconds", func() {
+				config := Config{ENV: EnvOverride{CFRetryBackoff: "2"}}
+				Expect(config.RetryBackoff()).To(Equal(2 * time.Second))
+			})
+
+			It("returns $CF_RETRY_BACKOFF as a duration", func() {
+				config := Config{ENV: EnvOverride{CFRetryBackoff: "250ms"}}
+				Expect(config.RetryBackoff()).To(Equal(250 * time.Millisecond))
+			})
+
+			It("defaults when $CF_RETRY_BACKOFF is not set or invalid", func() {
+				Expect((&Config{}).RetryBackoff()).To(Equal(DefaultRetryBackoff))
+				Expect((&Config{ENV: EnvOverride{CFRetryBackoff: "later"}}).RetryBackoff()).To(Equal(DefaultRetryBackoff))
+			})
+		})
+
+		Describe("TokenRefreshSkew", func() {
+			It("returns the skew from $CF_TOKEN_REFRESH_SKEW in seconds", func() {
+				config := Config{ENV: EnvOverride{CFTokenRefreshSkew: "30"}}
+				Expect(config.TokenRefreshSkew()).To(Equal(30 * time.Second))
+			})
+
+			It("defaults when $CF_TOKEN_REFRESH_SKEW is not set", func() {
+				config := Config{}
+				Expect(config.TokenRefreshSkew()).To(Equal(DefaultTokenRefreshSkew))
+			})
+
+			It("defaults when $CF_TOKEN_REFRESH_SKEW is invalid", func() {
+				config := Config{ENV: EnvOverride{CFTokenRefreshSkew: "soon"}}
+				Expect(config.TokenRefreshSkew()).To(Equal(DefaultTokenRefreshSkew))
+			})
+		})
+
+		Describe("TimingsFormat", func() {
+			It("returns the value of the --timings flag", func() {
+				config := Config{Flags: FlagOverride{Timings: "json"}}
+				Expect(config.TimingsFormat()).To(Equal("json"))
+			})
+		})
+
+		Describe("TraceExport", func() {
+			It("returns the value of $CF_TRACE_EXPORT", func() {
+				config := Config{ENV: EnvOverride{CFTraceExport: "http://localhost:4318/v1/traces"}}
+				Expect(config.TraceExport()).To(Equal("http://localhost:4318/v1/traces"))
+			})
+		})
+
+		Describe("DockerPassword", func() {
+			var (
+				originalDockerPassword string
+
+				config *Config
+			)
+
+			BeforeEach(func() {
+				originalDockerPassword = os.Getenv("CF_DOCKER_PASSWORD")
+				Expect(os.Setenv("CF_DOCKER_PASSWORD", "some-docker-password")).ToNot(HaveOccurred())
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			AfterEach(func() {
+				Expect(os.Setenv("CF_DOCKER_PASSWORD", originalDockerPassword)).ToNot(HaveOccurred())
+			})
+
+			It("returns the docker password", func() {
+				Expect(config.DockerPassword()).To(Equal("some-docker-password"))
+			})
+		})
+
+		Describe("credentials from the environment", func() {
+			var (
+				originalEnv map[string]string
+
+				config *Config
+			)
+
+			BeforeEach(func() {
+				originalEnv = map[string]string{}
+				for name, value := range map[string]string{
+					"CF_USERNAME":        "some-username",
+					"CF_PASSWORD":        "some-password",
+					"CF_CLIENT_ID":       "some-client",
+					"CF_CLIENT_SECRET":   "some-client-secret",
+					"CF_DOCKER_PASSWORD": "",
+				} {
+					originalEnv[name] = os.Getenv(name)
+					Expect(os.Setenv(name, value)).ToNot(HaveOccurred())
+				}
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			AfterEach(func() {
+				for name, value := range originalEnv {
+					Expect(os.Setenv(name, value)).ToNot(HaveOccurred())
+				}
+			})
+
+			It("returns the credentials", func() {
+				Expect(config.CFUsername()).To(Equal("some-username"))
+				Expect(config.CFPassword()).To(Equal("some-password"))
+				Expect(config.CFClientID()).To(Equal("some-client"))
+				Expect(config.CFClientSecret()).To(Equal("some-client-secret"))
+			})
+
+			It("returns the secrets so they can be redacted", func() {
+				Expect(config.Secrets()).To(ConsistOf("some-password", "some-client-secret"))
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -594,12 +772,62 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAGrantType", func() {
+			It("sets the grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
+		Describe("SetUAAClientCredentials", func() {
+			It("sets the UAA client ID and secret", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+				Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+				Expect(config.UAAOAuthClientSecret()).To(Equal("some-secret"))
+			})
+		})
+
+		Describe("UnsetUAAClientCredentials", func() {
+			It("resets the UAA client ID and secret to the defaults", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+				config.UnsetUAAClientCredentials()
+				Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+				Expect(config.UAAOAuthClientSecret()).To(Equal(DefaultUAAOAuthClientSecret))
+			})
+		})
+
 		Describe("SetAccessToken", func() {
 			It("sets the authentication token information", func() {
 				var config Config
 				config.SetAccessToken("I am the access token")
 				Expect(config.ConfigFile.AccessToken).To(Equal("I am the access token"))
 			})
+
+			It("can be called while other goroutines read the tokens", func() {
+				var (
+					config Config
+					wg     sync.WaitGroup
+				)
+				for i := 0; i < 5; i++ {
+					wg.Add(2)
+					go func(i int) {
+						defer wg.Done()
+						config.SetAccessToken(fmt.Sprintf("access-token-%d", i))
+						config.SetRefreshToken(fmt.Sprintf("refresh-token-%d", i))
+					}(i)
+					go func() {
+						defer wg.Done()
+						_ = config.AccessToken()
+						_ = config.RefreshToken()
+					}()
+				}
+				wg.Wait()
+
+				Expect(config.AccessToken()).To(HavePrefix("access-token-"))
+			})
 		})
 
 		Describe("SetRefreshToken", func() {
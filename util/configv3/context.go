@@ -0,0 +1,300 @@
+package configv3
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ContextNotFoundError is returned when a named context does not exist in the
+// config.
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (e ContextNotFoundError) Error() string {
+	return fmt.Sprintf("Context '%s' not found", e.Name)
+}
+
+// ContextAlreadyExistsError is returned when creating or renaming a context
+// to a name that is already in use.
+type ContextAlreadyExistsError struct {
+	Name string
+}
+
+func (e ContextAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Context '%s' already exists", e.Name)
+}
+
+// TargetContext is a named set of target, session and org/space information. The
+// top level target values of the config.json always mirror the current
+// context so that older CLIs and plugins keep working.
+type TargetContext struct {
+	Name                     string       `json:"-"`
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	RefreshToken             string       `json:"RefreshToken"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// CurrentUser returns user information decoded from the context's access
+// token.
+func (context TargetContext) CurrentUser() (User, error) {
+	return decodeUserFromJWT(context.AccessToken)
+}
+
+// ActiveContext returns the name of the context commands run against. This is
+// based off of:
+//   1. The '--context' global flag
+//   2. The $CF_CONTEXT environment variable if set
+//   3. The config file's CurrentContext value
+func (config *Config) ActiveContext() string {
+	if config.Flags.Context != "" {
+		return config.Flags.Context
+	}
+
+	if config.ENV.CFContext != "" {
+		return config.ENV.CFContext
+	}
+
+	return config.ConfigFile.CurrentContext
+}
+
+// Contexts returns all the contexts stored in the config, sorted by name.
+func (config *Config) Contexts() []TargetContext {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	config.saveActiveContext()
+
+	contexts := []TargetContext{}
+	for name, context := range config.ConfigFile.Contexts {
+		context.Name = name
+		contexts = append(contexts, context)
+	}
+
+	sort.Slice(contexts, func(i, j int) bool {
+		return strings.ToLower(contexts[i].Name) < strings.ToLower(contexts[j].Name)
+	})
+	return contexts
+}
+
+// CreateContext stores the current target, session and org/space information
+// as a new context. If no context is current, the new context becomes the
+// current one.
+func (config *Config) CreateContext(name string) error {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	if _, exists := config.ConfigFile.Contexts[name]; exists {
+		return ContextAlreadyExistsError{Name: name}
+	}
+
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]TargetContext{}
+	}
+
+	context := config.ConfigFile.snapshotContext()
+	context.Name = name
+	config.ConfigFile.Contexts[name] = context
+
+	if config.ConfigFile.CurrentContext == "" {
+		config.ConfigFile.CurrentContext = name
+	}
+	return nil
+}
+
+// RenameContext changes the name of an existing context.
+func (config *Config) RenameContext(oldName string, newName string) error {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	context, exists := config.ConfigFile.Contexts[oldName]
+	if !exists {
+		return ContextNotFoundError{Name: oldName}
+	}
+
+	if _, taken := config.ConfigFile.Contexts[newName]; taken {
+		return ContextAlreadyExistsError{Name: newName}
+	}
+
+	delete(config.ConfigFile.Contexts, oldName)
+	context.Name = newName
+	config.ConfigFile.Contexts[newName] = context
+
+	if config.ConfigFile.CurrentContext == oldName {
+		config.ConfigFile.CurrentContext = newName
+	}
+	if config.Flags.Context == oldName {
+		config.Flags.Context = newName
+	}
+	if config.ENV.CFContext == oldName {
+		config.ENV.CFContext = newName
+	}
+	return nil
+}
+
+// DeleteContext removes a context from the config. Deleting the current
+// context leaves the target untouched, but it is no longer associated with a
+// name. Deleting a context selected with the context flag or $CF_CONTEXT
+// switches back to the current context.
+func (config *Config) DeleteContext(name string) error {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	if _, exists := config.ConfigFile.Contexts[name]; !exists {
+		return ContextNotFoundError{Name: name}
+	}
+
+	delete(config.ConfigFile.Contexts, name)
+
+	if config.ConfigFile.CurrentContext == name {
+		config.ConfigFile.CurrentContext = ""
+	}
+	if config.ActiveContext() == name {
+		config.Flags.Context = ""
+		config.ENV.CFContext = ""
+		if config.overriddenTarget != nil {
+			config.ConfigFile.applyContext(*config.overriddenTarget)
+			config.overriddenTarget = nil
+		}
+	}
+	return nil
+}
+
+// UseContext makes the provided context the current one. The values of the
+// previously active context are saved before switching.
+func (config *Config) UseContext(name string) error {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	context, exists := config.ConfigFile.Contexts[name]
+	if !exists {
+		return ContextNotFoundError{Name: name}
+	}
+
+	config.saveActiveContext()
+	config.ConfigFile.applyContext(context)
+	config.ConfigFile.CurrentContext = name
+	config.Flags.Context = ""
+	config.ENV.CFContext = ""
+	config.overriddenTarget = nil
+	return nil
+}
+
+// loadActiveContext replaces the top level target values with the values of
+// the active context when it differs from the current context. The replaced
+// values are kept so that they can be persisted untouched.
+func (config *Config) loadActiveContext() error {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	name := config.ActiveContext()
+	if name == config.ConfigFile.CurrentContext {
+		return nil
+	}
+
+	context, exists := config.ConfigFile.Contexts[name]
+	if !exists {
+		return ContextNotFoundError{Name: name}
+	}
+
+	current := config.ConfigFile.snapshotContext()
+	if _, exists := config.ConfigFile.Contexts[config.ConfigFile.CurrentContext]; exists {
+		current.Name = config.ConfigFile.CurrentContext
+		config.ConfigFile.Contexts[current.Name] = current
+	}
+	config.overriddenTarget = &current
+	config.ConfigFile.applyContext(context)
+	return nil
+}
+
+// saveActiveContext copies the top level target values back into the active
+// context. The caller must hold tokenMutex.
+func (config *Config) saveActiveContext() {
+	name := config.ActiveContext()
+	if _, exists := config.ConfigFile.Contexts[name]; name == "" || !exists {
+		return
+	}
+
+	context := config.ConfigFile.snapshotContext()
+	context.Name = name
+	config.ConfigFile.Contexts[name] = context
+}
+
+// persistedConfigFile returns the CFConfig that should be written to disk.
+// Changes made while a context was overridden are stored in that context
+// only; the top level values keep mirroring the current context. The
+// contexts are copied so that the tokens can be refreshed while the returned
+// CFConfig is written.
+func (config *Config) persistedConfigFile() CFConfig {
+	config.tokenMutex.Lock()
+	defer config.tokenMutex.Unlock()
+	config.saveActiveContext()
+
+	configFile := config.ConfigFile
+	if config.ConfigFile.Contexts != nil {
+		configFile.Contexts = make(map[string]TargetContext, len(config.ConfigFile.Contexts))
+		for name, context := range config.ConfigFile.Contexts {
+			configFile.Contexts[name] = context
+		}
+	}
+	if config.overriddenTarget != nil {
+		configFile.applyContext(*config.overriddenTarget)
+	}
+	return configFile
+}
+
+func (cfConfig CFConfig) snapshotContext() TargetContext {
+	return TargetContext{
+		Target:                   cfConfig.Target,
+		APIVersion:               cfConfig.APIVersion,
+		AuthorizationEndpoint:    cfConfig.AuthorizationEndpoint,
+		DopplerEndpoint:          cfConfig.DopplerEndpoint,
+		UAAEndpoint:              cfConfig.UAAEndpoint,
+		RoutingEndpoint:          cfConfig.RoutingEndpoint,
+		AccessToken:              cfConfig.AccessToken,
+		SSHOAuthClient:           cfConfig.SSHOAuthClient,
+		UAAOAuthClient:           cfConfig.UAAOAuthClient,
+		UAAOAuthClientSecret:     cfConfig.UAAOAuthClientSecret,
+		UAAGrantType:             cfConfig.UAAGrantType,
+		RefreshToken:             cfConfig.RefreshToken,
+		TargetedOrganization:     cfConfig.TargetedOrganization,
+		TargetedSpace:            cfConfig.TargetedSpace,
+		SkipSSLValidation:        cfConfig.SkipSSLValidation,
+		MinCLIVersion:            cfConfig.MinCLIVersion,
+		MinRecommendedCLIVersion: cfConfig.MinRecommendedCLIVersion,
+	}
+}
+
+func (cfConfig *CFConfig) applyContext(context TargetContext) {
+	cfConfig.Target = context.Target
+	cfConfig.APIVersion = context.APIVersion
+	cfConfig.AuthorizationEndpoint = context.AuthorizationEndpoint
+	cfConfig.DopplerEndpoint = context.DopplerEndpoint
+	cfConfig.UAAEndpoint = context.UAAEndpoint
+	cfConfig.RoutingEndpoint = context.RoutingEndpoint
+	cfConfig.AccessToken = context.AccessToken
+	cfConfig.SSHOAuthClient = context.SSHOAuthClient
+	cfConfig.UAAOAuthClient = context.UAAOAuthClient
+	cfConfig.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	cfConfig.UAAGrantType = context.UAAGrantType
+	cfConfig.RefreshToken = context.RefreshToken
+	cfConfig.TargetedOrganization = context.TargetedOrganization
+	cfConfig.TargetedSpace = context.TargetedSpace
+	cfConfig.SkipSSLValidation = context.SkipSSLValidation
+	cfConfig.MinCLIVersion = context.MinCLIVersion
+	cfConfig.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+
+	if cfConfig.UAAOAuthClient == "" {
+		cfConfig.UAAOAuthClient = DefaultUAAOAuthClient
+		cfConfig.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
+	}
+}
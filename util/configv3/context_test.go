@@ -0,0 +1,371 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() CFConfig {
+		file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var writtenCFConfig CFConfig
+		err = json.Unmarshal(file, &writtenCFConfig)
+		Expect(err).ToNot(HaveOccurred())
+		return writtenCFConfig
+	}
+
+	Context("when the config contains contexts", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.staging.com",
+				"AccessToken": "staging-token",
+				"OrganizationFields": {"GUID": "staging-org-guid", "Name": "staging-org"},
+				"CurrentContext": "staging",
+				"Contexts": {
+					"staging": {
+						"Target": "https://api.staging.com",
+						"AccessToken": "staging-token",
+						"OrganizationFields": {"GUID": "staging-org-guid", "Name": "staging-org"}
+					},
+					"prod": {
+						"Target": "https://api.prod.com",
+						"AccessToken": "prod-token",
+						"RefreshToken": "prod-refresh-token",
+						"SSLDisabled": true,
+						"UAAOAuthClient": "prod-client",
+						"UAAOAuthClientSecret": "prod-secret",
+						"UAAGrantType": "client_credentials",
+						"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"},
+						"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"}
+					}
+				}
+			}`
+			setConfig(homeDir, rawConfig)
+		})
+
+		Describe("LoadConfig", func() {
+			It("uses the current context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.ActiveContext()).To(Equal("staging"))
+				Expect(config.Target()).To(Equal("https://api.staging.com"))
+				Expect(config.TargetedOrganization().Name).To(Equal("staging-org"))
+			})
+
+			Context("when the context flag is provided", func() {
+				It("uses the provided context", func() {
+					config, err := LoadConfig(FlagOverride{Context: "prod"})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(config.ActiveContext()).To(Equal("prod"))
+					Expect(config.Target()).To(Equal("https://api.prod.com"))
+					Expect(config.AccessToken()).To(Equal("prod-token"))
+					Expect(config.RefreshToken()).To(Equal("prod-refresh-token"))
+					Expect(config.SkipSSLValidation()).To(BeTrue())
+					Expect(config.UAAOAuthClient()).To(Equal("prod-client"))
+					Expect(config.UAAOAuthClientSecret()).To(Equal("prod-secret"))
+					Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+					Expect(config.TargetedOrganization().Name).To(Equal("prod-org"))
+					Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+				})
+			})
+
+			Context("when the CF_CONTEXT environment variable is set", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_CONTEXT", "prod")).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(os.Unsetenv("CF_CONTEXT")).To(Succeed())
+				})
+
+				It("uses the provided context", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.Target()).To(Equal("https://api.prod.com"))
+				})
+
+				It("prefers the context flag", func() {
+					config, err := LoadConfig(FlagOverride{Context: "staging"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+				})
+			})
+
+			Context("when the provided context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					_, err := LoadConfig(FlagOverride{Context: "does-not-exist"})
+					Expect(err).To(MatchError(ContextNotFoundError{Name: "does-not-exist"}))
+				})
+			})
+		})
+
+		Describe("WriteConfig", func() {
+			It("saves changes into the current context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetAccessToken("new-staging-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenCFConfig := readConfigFile()
+				Expect(writtenCFConfig.AccessToken).To(Equal("new-staging-token"))
+				Expect(writtenCFConfig.Contexts["staging"].AccessToken).To(Equal("new-staging-token"))
+			})
+
+			Context("when a context other than the current one is active", func() {
+				It("saves changes into the active context only", func() {
+					config, err := LoadConfig(FlagOverride{Context: "prod"})
+					Expect(err).ToNot(HaveOccurred())
+
+					config.SetAccessToken("new-prod-token")
+					Expect(WriteConfig(config)).To(Succeed())
+
+					writtenCFConfig := readConfigFile()
+					Expect(writtenCFConfig.CurrentContext).To(Equal("staging"))
+					Expect(writtenCFConfig.Target).To(Equal("https://api.staging.com"))
+					Expect(writtenCFConfig.AccessToken).To(Equal("staging-token"))
+					Expect(writtenCFConfig.Contexts["prod"].AccessToken).To(Equal("new-prod-token"))
+					Expect(writtenCFConfig.Contexts["staging"].AccessToken).To(Equal("staging-token"))
+				})
+			})
+
+			It("can be called while other goroutines refresh the tokens", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				var wg sync.WaitGroup
+				for i := 0; i < 5; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						config.SetAccessToken(fmt.Sprintf("access-token-%d", i))
+					}(i)
+				}
+				for i := 0; i < 5; i++ {
+					Expect(WriteConfig(config)).To(Succeed())
+				}
+				wg.Wait()
+
+				Expect(WriteConfig(config)).To(Succeed())
+				writtenCFConfig := readConfigFile()
+				Expect(writtenCFConfig.AccessToken).To(HavePrefix("access-token-"))
+				Expect(writtenCFConfig.Contexts["staging"].AccessToken).To(Equal(writtenCFConfig.AccessToken))
+			})
+		})
+
+		Describe("Contexts", func() {
+			It("returns the contexts sorted by name", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				contexts := config.Contexts()
+				Expect(contexts).To(HaveLen(2))
+				Expect(contexts[0].Name).To(Equal("prod"))
+				Expect(contexts[0].Target).To(Equal("https://api.prod.com"))
+				Expect(contexts[1].Name).To(Equal("staging"))
+				Expect(contexts[1].Target).To(Equal("https://api.staging.com"))
+			})
+		})
+
+		Describe("UseContext", func() {
+			It("switches the target to the provided context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.SetSpaceInformation("staging-space-guid", "staging-space", false)
+				Expect(config.UseContext("prod")).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenCFConfig := readConfigFile()
+				Expect(writtenCFConfig.CurrentContext).To(Equal("prod"))
+				Expect(writtenCFConfig.Target).To(Equal("https://api.prod.com"))
+				Expect(writtenCFConfig.AccessToken).To(Equal("prod-token"))
+				Expect(writtenCFConfig.Contexts["staging"].TargetedSpace.Name).To(Equal("staging-space"))
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					err = config.UseContext("does-not-exist")
+					Expect(err).To(MatchError(ContextNotFoundError{Name: "does-not-exist"}))
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+				})
+			})
+		})
+
+		Describe("CreateContext", func() {
+			It("copies the current target into a new context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.CreateContext("staging-copy")).To(Succeed())
+				Expect(config.ActiveContext()).To(Equal("staging"))
+
+				contexts := config.Contexts()
+				Expect(contexts).To(HaveLen(3))
+				Expect(contexts[2].Name).To(Equal("staging-copy"))
+				Expect(contexts[2].Target).To(Equal("https://api.staging.com"))
+				Expect(contexts[2].AccessToken).To(Equal("staging-token"))
+			})
+
+			Context("when the context already exists", func() {
+				It("returns a ContextAlreadyExistsError", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					err = config.CreateContext("prod")
+					Expect(err).To(MatchError(ContextAlreadyExistsError{Name: "prod"}))
+				})
+			})
+		})
+
+		Describe("RenameContext", func() {
+			It("renames the context and keeps it current", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RenameContext("staging", "stage")).To(Succeed())
+				Expect(config.ActiveContext()).To(Equal("stage"))
+
+				contexts := config.Contexts()
+				Expect(contexts).To(HaveLen(2))
+				Expect(contexts[1].Name).To(Equal("stage"))
+				Expect(contexts[1].Target).To(Equal("https://api.staging.com"))
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					err = config.RenameContext("does-not-exist", "some-name")
+					Expect(err).To(MatchError(ContextNotFoundError{Name: "does-not-exist"}))
+				})
+			})
+
+			Context("when the new name is already taken", func() {
+				It("returns a ContextAlreadyExistsError", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					err = config.RenameContext("staging", "prod")
+					Expect(err).To(MatchError(ContextAlreadyExistsError{Name: "prod"}))
+				})
+			})
+		})
+
+		Describe("DeleteContext", func() {
+			It("removes the context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.DeleteContext("prod")).To(Succeed())
+				Expect(config.Contexts()).To(HaveLen(1))
+			})
+
+			Context("when deleting the current context", func() {
+				It("keeps the target but clears the current context", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(config.DeleteContext("staging")).To(Succeed())
+					Expect(config.ActiveContext()).To(BeEmpty())
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+				})
+			})
+
+			Context("when deleting the context provided by the context flag", func() {
+				It("switches back to the current context without changing it", func() {
+					config, err := LoadConfig(FlagOverride{Context: "prod"})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(config.DeleteContext("prod")).To(Succeed())
+					Expect(config.ActiveContext()).To(Equal("staging"))
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+					Expect(config.AccessToken()).To(Equal("staging-token"))
+					Expect(WriteConfig(config)).To(Succeed())
+
+					writtenCFConfig := readConfigFile()
+					Expect(writtenCFConfig.Target).To(Equal("https://api.staging.com"))
+					Expect(writtenCFConfig.AccessToken).To(Equal("staging-token"))
+					Expect(writtenCFConfig.Contexts).To(HaveLen(1))
+					Expect(writtenCFConfig.Contexts["staging"].Target).To(Equal("https://api.staging.com"))
+					Expect(writtenCFConfig.Contexts["staging"].AccessToken).To(Equal("staging-token"))
+				})
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					err = config.DeleteContext("does-not-exist")
+					Expect(err).To(MatchError(ContextNotFoundError{Name: "does-not-exist"}))
+				})
+			})
+		})
+	})
+
+	Context("when the config does not contain contexts", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.foo.com",
+				"AccessToken": "some-token"
+			}`
+			setConfig(homeDir, rawConfig)
+		})
+
+		It("makes the first created context the current one", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ActiveContext()).To(BeEmpty())
+
+			Expect(config.CreateContext("foo")).To(Succeed())
+			Expect(config.ActiveContext()).To(Equal("foo"))
+			Expect(WriteConfig(config)).To(Succeed())
+
+			writtenCFConfig := readConfigFile()
+			Expect(writtenCFConfig.CurrentContext).To(Equal("foo"))
+			Expect(writtenCFConfig.Contexts["foo"].Target).To(Equal("https://api.foo.com"))
+			Expect(writtenCFConfig.Contexts["foo"].AccessToken).To(Equal("some-token"))
+		})
+
+		It("does not write any context information", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(WriteConfig(config)).To(Succeed())
+
+			file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(file)).ToNot(ContainSubstring("Context"))
+		})
+	})
+})
@@ -0,0 +1,387 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// FileCredentialStoreName is the name of the default credential store,
+	// which keeps credentials in config.json.
+	FileCredentialStoreName = "file"
+
+	// CredentialHelperPrefix is prepended to a credential store's name to find
+	// the helper executable on the PATH.
+	CredentialHelperPrefix = "cf-credential-"
+)
+
+// Credentials are the secrets the CLI keeps for a target.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken"`
+	RefreshToken         string `json:"RefreshToken"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret"`
+}
+
+// IsEmpty returns true when none of the credentials are set.
+func (credentials Credentials) IsEmpty() bool {
+	return credentials == Credentials{}
+}
+
+// CredentialKey identifies a set of credentials in a credential store.
+type CredentialKey struct {
+	// ServerURL is the API target the credentials belong to.
+	ServerURL string `json:"ServerURL"`
+
+	// Context is the name of the context the credentials belong to, if any.
+	Context string `json:"Context"`
+}
+
+// CredentialStore saves and retrieves the credentials for a target.
+type CredentialStore interface {
+	Get(key CredentialKey) (Credentials, error)
+	Store(key CredentialKey, credentials Credentials) error
+	Erase(key CredentialKey) error
+}
+
+// NewCredentialStore returns the credential store with the provided name.
+// An empty name or "file" returns the FileCredentialStore; any other name
+// returns a HelperCredentialStore.
+func NewCredentialStore(name string) CredentialStore {
+	if name == "" || name == FileCredentialStoreName {
+		return FileCredentialStore{}
+	}
+	return NewHelperCredentialStore(name)
+}
+
+// IsExternalCredentialStore returns true when the credential store with the
+// provided name keeps credentials outside of config.json.
+func IsExternalCredentialStore(name string) bool {
+	return name != "" && name != FileCredentialStoreName
+}
+
+// StoredCredentials records the keys of the credentials a config keeps in a
+// credential store, so that the credentials it stops keeping there can be
+// erased.
+type StoredCredentials struct {
+	StoreName string
+	Store     CredentialStore
+	Keys      []CredentialKey
+}
+
+// NewStoredCredentials returns the StoredCredentials of a config that keeps
+// the credentials of its target and of each context's target in the named
+// store. Keys without a target are left out.
+func NewStoredCredentials(storeName string, store CredentialStore, target string, currentContext string, contextTargets map[string]string) StoredCredentials {
+	stored := StoredCredentials{StoreName: storeName, Store: store}
+	seen := map[CredentialKey]bool{}
+	addKey := func(key CredentialKey) {
+		if key.ServerURL != "" && !seen[key] {
+			seen[key] = true
+			stored.Keys = append(stored.Keys, key)
+		}
+	}
+
+	addKey(CredentialKey{ServerURL: target, Context: currentContext})
+	for name, contextTarget := range contextTargets {
+		addKey(CredentialKey{ServerURL: contextTarget, Context: name})
+	}
+	return stored
+}
+
+// EraseStale erases the credentials recorded in stored that current no
+// longer keeps in the same credential helper: all of them when the credential
+// store changed, and otherwise those of a target that is no longer targeted.
+// The file store keeps credentials in config.json, so nothing is erased from
+// it.
+func (stored StoredCredentials) EraseStale(current StoredCredentials) error {
+	if !IsExternalCredentialStore(stored.StoreName) {
+		return nil
+	}
+
+	kept := map[CredentialKey]bool{}
+	if current.StoreName == stored.StoreName {
+		for _, key := range current.Keys {
+			kept[key] = true
+		}
+	}
+
+	for _, key := range stored.Keys {
+		if kept[key] {
+			continue
+		}
+		err := stored.Store.Erase(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FileCredentialStore is the default credential store. Credentials are
+// written to config.json along with the rest of the configuration, so there
+// is nothing for the store itself to do.
+type FileCredentialStore struct{}
+
+// Get returns empty credentials; the config file already contains them.
+func (FileCredentialStore) Get(key CredentialKey) (Credentials, error) {
+	return Credentials{}, nil
+}
+
+// Store does nothing; the credentials are saved when the config is written.
+func (FileCredentialStore) Store(key CredentialKey, credentials Credentials) error {
+	return nil
+}
+
+// Erase does nothing; the credentials are removed when the config is written.
+func (FileCredentialStore) Erase(key CredentialKey) error {
+	return nil
+}
+
+// CredentialHelperError is returned when a credential helper fails.
+type CredentialHelperError struct {
+	Helper  string
+	Action  string
+	Message string
+}
+
+func (e CredentialHelperError) Error() string {
+	return fmt.Sprintf("Credential helper '%s %s' failed: %s", e.Helper, e.Action, e.Message)
+}
+
+// HelperCredentialStore keeps credentials in an external credential helper,
+// modeled on git and docker credential helpers. The helper is an executable
+// named cf-credential-<name> on the PATH, invoked with a single argument:
+//
+//	get    reads a CredentialKey as JSON on stdin and writes the Credentials
+//	       as JSON on stdout
+//	store  reads a CredentialKey and the Credentials as a single JSON object
+//	       on stdin
+//	erase  reads a CredentialKey as JSON on stdin
+//
+// A get for unknown credentials either writes nothing or fails with
+// "credentials not found".
+//
+// Credentials retrieved or stored are remembered so that unchanged
+// credentials are not sent to the helper again.
+type HelperCredentialStore struct {
+	Helper string
+
+	known map[CredentialKey]Credentials
+}
+
+// NewHelperCredentialStore returns a HelperCredentialStore for the
+// cf-credential-<name> helper.
+func NewHelperCredentialStore(name string) *HelperCredentialStore {
+	return &HelperCredentialStore{
+		Helper: CredentialHelperPrefix + name,
+		known:  map[CredentialKey]Credentials{},
+	}
+}
+
+// Get retrieves the credentials for the key from the helper.
+func (store *HelperCredentialStore) Get(key CredentialKey) (Credentials, error) {
+	if credentials, ok := store.known[key]; ok {
+		return credentials, nil
+	}
+
+	output, err := store.run("get", key)
+	if err != nil {
+		if strings.Contains(err.Error(), "credentials not found") {
+			store.known[key] = Credentials{}
+			return Credentials{}, nil
+		}
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	if len(bytes.TrimSpace(output)) > 0 {
+		err = json.Unmarshal(output, &credentials)
+		if err != nil {
+			return Credentials{}, CredentialHelperError{Helper: store.Helper, Action: "get", Message: err.Error()}
+		}
+	}
+
+	store.known[key] = credentials
+	return credentials, nil
+}
+
+// Store saves the credentials for the key in the helper.
+func (store *HelperCredentialStore) Store(key CredentialKey, credentials Credentials) error {
+	if known, ok := store.known[key]; ok && known == credentials {
+		return nil
+	}
+
+	_, err := store.run("store", struct {
+		CredentialKey
+		Credentials
+	}{key, credentials})
+	if err != nil {
+		return err
+	}
+
+	store.known[key] = credentials
+	return nil
+}
+
+// Erase removes the credentials for the key from the helper.
+func (store *HelperCredentialStore) Erase(key CredentialKey) error {
+	if known, ok := store.known[key]; ok && known.IsEmpty() {
+		return nil
+	}
+
+	_, err := store.run("erase", key)
+	if err != nil {
+		return err
+	}
+
+	store.known[key] = Credentials{}
+	return nil
+}
+
+func (store *HelperCredentialStore) run(action string, input interface{}) ([]byte, error) {
+	rawInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(store.Helper, action)
+	cmd.Stdin = bytes.NewReader(rawInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = strings.TrimSpace(stdout.String())
+		}
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, CredentialHelperError{Helper: store.Helper, Action: action, Message: message}
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// SaveCredentials saves the active target's credentials in the credential
+// store right away instead of waiting for the config to be written. This does
+// nothing for the default file store.
+func (config *Config) SaveCredentials() error {
+	if !IsExternalCredentialStore(config.ConfigFile.CredentialStore) {
+		return nil
+	}
+
+	config.tokenMutex.RLock()
+	credentials := config.ConfigFile.credentials()
+	config.tokenMutex.RUnlock()
+
+	key := CredentialKey{ServerURL: config.ConfigFile.Target, Context: config.ActiveContext()}
+	return storeCredentials(config.credentialStore(), key, credentials)
+}
+
+// credentialStore returns the store configured in the config file, creating
+// it on first use.
+func (config *Config) credentialStore() CredentialStore {
+	if config.store == nil {
+		config.store = NewCredentialStore(config.ConfigFile.CredentialStore)
+	}
+	return config.store
+}
+
+// storedCredentials returns the StoredCredentials of the config file.
+func (cfConfig CFConfig) storedCredentials(store CredentialStore) StoredCredentials {
+	contextTargets := make(map[string]string, len(cfConfig.Contexts))
+	for name, context := range cfConfig.Contexts {
+		contextTargets[name] = context.Target
+	}
+	return NewStoredCredentials(cfConfig.CredentialStore, store, cfConfig.Target, cfConfig.CurrentContext, contextTargets)
+}
+
+// loadCredentials fills in the top level and context credentials from the
+// store.
+func (cfConfig *CFConfig) loadCredentials(store CredentialStore) error {
+	if cfConfig.Target != "" {
+		credentials, err := store.Get(CredentialKey{ServerURL: cfConfig.Target, Context: cfConfig.CurrentContext})
+		if err != nil {
+			return err
+		}
+		cfConfig.setCredentials(credentials)
+	}
+
+	for name, context := range cfConfig.Contexts {
+		if context.Target == "" {
+			continue
+		}
+
+		credentials, err := store.Get(CredentialKey{ServerURL: context.Target, Context: name})
+		if err != nil {
+			return err
+		}
+		context.AccessToken = credentials.AccessToken
+		context.RefreshToken = credentials.RefreshToken
+		context.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+		cfConfig.Contexts[name] = context
+	}
+	return nil
+}
+
+// moveCredentialsToStore saves the top level and context credentials in the
+// store and removes them from the config file.
+func (cfConfig *CFConfig) moveCredentialsToStore(store CredentialStore) error {
+	err := storeCredentials(store, CredentialKey{ServerURL: cfConfig.Target, Context: cfConfig.CurrentContext}, cfConfig.credentials())
+	if err != nil {
+		return err
+	}
+	cfConfig.setCredentials(Credentials{})
+
+	contexts := make(map[string]TargetContext, len(cfConfig.Contexts))
+	for name, context := range cfConfig.Contexts {
+		err = storeCredentials(store, CredentialKey{ServerURL: context.Target, Context: name}, Credentials{
+			AccessToken:          context.AccessToken,
+			RefreshToken:         context.RefreshToken,
+			UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+		})
+		if err != nil {
+			return err
+		}
+		context.AccessToken = ""
+		context.RefreshToken = ""
+		context.UAAOAuthClientSecret = ""
+		contexts[name] = context
+	}
+	if cfConfig.Contexts != nil {
+		cfConfig.Contexts = contexts
+	}
+	return nil
+}
+
+func (cfConfig CFConfig) credentials() Credentials {
+	return Credentials{
+		AccessToken:          cfConfig.AccessToken,
+		RefreshToken:         cfConfig.RefreshToken,
+		UAAOAuthClientSecret: cfConfig.UAAOAuthClientSecret,
+	}
+}
+
+func (cfConfig *CFConfig) setCredentials(credentials Credentials) {
+	cfConfig.AccessToken = credentials.AccessToken
+	cfConfig.RefreshToken = credentials.RefreshToken
+	cfConfig.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+}
+
+// storeCredentials stores the credentials, or erases them when they are
+// empty. Empty credentials without a target are skipped.
+func storeCredentials(store CredentialStore, key CredentialKey, credentials Credentials) error {
+	if credentials.IsEmpty() {
+		if key.ServerURL == "" {
+			return nil
+		}
+		return store.Erase(key)
+	}
+	return store.Store(key, credentials)
+}
@@ -0,0 +1,330 @@
+// +build !windows
+
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testCredentialHelper = `#!/bin/sh
+input=$(cat)
+echo "$1 $input" >> "$CF_CREDENTIAL_TEST_LOG"
+if [ "$1" = "get" ]; then
+  if [ -f "$CF_CREDENTIAL_TEST_GET" ]; then
+    cat "$CF_CREDENTIAL_TEST_GET"
+  else
+    echo "credentials not found"
+    exit 1
+  fi
+fi
+if [ "$1" = "store" ] && [ -n "$CF_CREDENTIAL_TEST_FAIL" ]; then
+  echo "$CF_CREDENTIAL_TEST_FAIL" >&2
+  exit 1
+fi
+`
+
+var _ = Describe("credential store", func() {
+	var (
+		helperDir string
+		logPath   string
+		getPath   string
+		oldPath   string
+	)
+
+	helperCalls := func() []string {
+		rawLog, err := ioutil.ReadFile(logPath)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(rawLog)), "\n")
+	}
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-test"), []byte(testCredentialHelper), 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		logPath = filepath.Join(helperDir, "log")
+		getPath = filepath.Join(helperDir, "get.json")
+		Expect(os.Setenv("CF_CREDENTIAL_TEST_LOG", logPath)).To(Succeed())
+		Expect(os.Setenv("CF_CREDENTIAL_TEST_GET", getPath)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_TEST_LOG")).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_TEST_GET")).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_TEST_FAIL")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	Describe("HelperCredentialStore", func() {
+		var (
+			store *HelperCredentialStore
+			key   CredentialKey
+		)
+
+		BeforeEach(func() {
+			store = NewHelperCredentialStore("test")
+			key = CredentialKey{ServerURL: "https://api.example.com", Context: "prod"}
+		})
+
+		Describe("Get", func() {
+			Context("when the helper has the credentials", func() {
+				BeforeEach(func() {
+					err := ioutil.WriteFile(getPath, []byte(`{"AccessToken":"some-access-token","RefreshToken":"some-refresh-token","UAAOAuthClientSecret":"some-secret"}`), 0600)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("sends the key to the helper and returns the credentials", func() {
+					credentials, err := store.Get(key)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(credentials).To(Equal(Credentials{
+						AccessToken:          "some-access-token",
+						RefreshToken:         "some-refresh-token",
+						UAAOAuthClientSecret: "some-secret",
+					}))
+
+					Expect(helperCalls()).To(Equal([]string{`get {"ServerURL":"https://api.example.com","Context":"prod"}`}))
+				})
+
+				It("only asks the helper once", func() {
+					_, err := store.Get(key)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = store.Get(key)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(helperCalls()).To(HaveLen(1))
+				})
+			})
+
+			Context("when the helper does not have the credentials", func() {
+				It("returns empty credentials", func() {
+					credentials, err := store.Get(key)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(credentials.IsEmpty()).To(BeTrue())
+				})
+			})
+
+			Context("when the helper returns invalid JSON", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(getPath, []byte(`{`), 0600)).To(Succeed())
+				})
+
+				It("returns a CredentialHelperError", func() {
+					_, err := store.Get(key)
+					Expect(err).To(BeAssignableToTypeOf(CredentialHelperError{}))
+				})
+			})
+
+			Context("when the helper does not exist", func() {
+				BeforeEach(func() {
+					store = NewHelperCredentialStore("does-not-exist")
+				})
+
+				It("returns a CredentialHelperError", func() {
+					_, err := store.Get(key)
+					Expect(err).To(BeAssignableToTypeOf(CredentialHelperError{}))
+					Expect(err.Error()).To(ContainSubstring("cf-credential-does-not-exist get"))
+				})
+			})
+		})
+
+		Describe("Store", func() {
+			var credentials Credentials
+
+			BeforeEach(func() {
+				credentials = Credentials{AccessToken: "some-access-token", RefreshToken: "some-refresh-token"}
+			})
+
+			It("sends the key and credentials to the helper once", func() {
+				Expect(store.Store(key, credentials)).To(Succeed())
+				Expect(store.Store(key, credentials)).To(Succeed())
+
+				Expect(helperCalls()).To(Equal([]string{
+					`store {"ServerURL":"https://api.example.com","Context":"prod","AccessToken":"some-access-token","RefreshToken":"some-refresh-token","UAAOAuthClientSecret":""}`,
+				}))
+			})
+
+			Context("when the helper fails", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_CREDENTIAL_TEST_FAIL", "keychain locked")).To(Succeed())
+				})
+
+				It("returns the helper's error output", func() {
+					err := store.Store(key, credentials)
+					Expect(err).To(MatchError(CredentialHelperError{
+						Helper:  "cf-credential-test",
+						Action:  "store",
+						Message: "keychain locked",
+					}))
+				})
+			})
+		})
+
+		Describe("Erase", func() {
+			It("sends the key to the helper", func() {
+				Expect(store.Erase(key)).To(Succeed())
+				Expect(helperCalls()).To(Equal([]string{`erase {"ServerURL":"https://api.example.com","Context":"prod"}`}))
+			})
+
+			Context("when the credentials are known to be empty", func() {
+				It("does not call the helper", func() {
+					_, err := store.Get(key)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(store.Erase(key)).To(Succeed())
+					Expect(helperCalls()).To(HaveLen(1))
+				})
+			})
+		})
+	})
+
+	Describe("Config", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			homeDir = setup()
+		})
+
+		AfterEach(func() {
+			teardown(homeDir)
+		})
+
+		Context("when an external credential store is configured", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.example.com",
+					"AccessToken": "",
+					"CredentialStore": "test"
+				}`)
+				err := ioutil.WriteFile(getPath, []byte(`{"AccessToken":"stored-access-token","RefreshToken":"stored-refresh-token"}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("loads the credentials from the helper", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("stored-access-token"))
+				Expect(config.RefreshToken()).To(Equal("stored-refresh-token"))
+			})
+
+			It("stores changed credentials in the helper instead of config.json", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetAccessToken("new-access-token")
+
+				Expect(WriteConfig(config)).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).ToNot(ContainSubstring("access-token"))
+				Expect(string(rawConfig)).To(ContainSubstring(`"CredentialStore": "test"`))
+
+				Expect(helperCalls()).To(Equal([]string{
+					`get {"ServerURL":"https://api.example.com","Context":""}`,
+					`store {"ServerURL":"https://api.example.com","Context":"","AccessToken":"new-access-token","RefreshToken":"stored-refresh-token","UAAOAuthClientSecret":""}`,
+				}))
+			})
+
+			It("does not call the helper when the credentials have not changed", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(helperCalls()).To(HaveLen(1))
+			})
+
+			It("erases the credentials when they are cleared", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetTokenInformation("", "", "ssh-client")
+
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(helperCalls()).To(ContainElement(`erase {"ServerURL":"https://api.example.com","Context":""}`))
+			})
+
+			It("erases the credentials of the previous target when the target changes", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetTargetInformation("https://api.other.com", "2.59.0", "", "", "", "", "", false)
+				config.SetTokenInformation("", "", "ssh-client")
+
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(helperCalls()).To(ContainElement(`erase {"ServerURL":"https://api.example.com","Context":""}`))
+			})
+
+			Context("when switching to the file store", func() {
+				It("writes the credentials to config.json and erases them from the helper", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					config.SetCredentialStore(FileCredentialStoreName)
+
+					Expect(WriteConfig(config)).To(Succeed())
+
+					rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(rawConfig)).To(ContainSubstring("stored-access-token"))
+
+					Expect(helperCalls()).To(Equal([]string{
+						`get {"ServerURL":"https://api.example.com","Context":""}`,
+						`erase {"ServerURL":"https://api.example.com","Context":""}`,
+					}))
+				})
+			})
+
+			Describe("SaveCredentials", func() {
+				It("stores the active credentials in the helper immediately", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					config.SetAccessToken("refreshed-access-token")
+
+					Expect(config.SaveCredentials()).To(Succeed())
+					Expect(helperCalls()).To(ContainElement(
+						`store {"ServerURL":"https://api.example.com","Context":"","AccessToken":"refreshed-access-token","RefreshToken":"stored-refresh-token","UAAOAuthClientSecret":""}`,
+					))
+				})
+			})
+		})
+
+		Context("when the default file store is used", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.example.com",
+					"AccessToken": "file-access-token"
+				}`)
+			})
+
+			It("keeps the credentials in config.json and never calls a helper", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("file-access-token"))
+
+				Expect(config.SaveCredentials()).To(Succeed())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).To(ContainSubstring("file-access-token"))
+				Expect(helperCalls()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// HTTPCacheDir returns the location of the cache of Cloud Controller
+// responses.
+func HTTPCacheDir() string {
+	return filepath.Join(homeDirectory(), ".cf", "http-cache")
+}
@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// PushCacheDir returns the location of the cache of file SHA1s and archives
+// used by push.
+func PushCacheDir() string {
+	return filepath.Join(homeDirectory(), ".cf", "push-cache")
+}
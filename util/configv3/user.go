@@ -5,12 +5,16 @@ import "github.com/SermoDigital/jose/jws"
 // User represents the user information provided by the JWT access token
 type User struct {
 	Name string
+
+	// IsClient is true when the token was issued to a UAA client acting on its
+	// own behalf. Name is then the client ID.
+	IsClient bool
 }
 
 // CurrentUser returns user information decoded from the JWT access token in
 // .cf/config.json
 func (config *Config) CurrentUser() (User, error) {
-	return decodeUserFromJWT(config.ConfigFile.AccessToken)
+	return decodeUserFromJWT(config.AccessToken())
 }
 
 func decodeUserFromJWT(accessToken string) (User, error) {
@@ -24,7 +28,13 @@ func decodeUserFromJWT(accessToken string) (User, error) {
 	}
 
 	claims := token.Claims()
+	if name, ok := claims.Get("user_name").(string); ok {
+		return User{Name: name}, nil
+	}
+
+	clientID, _ := claims.Get("client_id").(string)
 	return User{
-		Name: claims.Get("user_name").(string),
+		Name:     clientID,
+		IsClient: true,
 	}, nil
 }
@@ -0,0 +1,142 @@
+// Package manifestvars resolves ((var)) placeholders in manifests using values
+// from vars files and key=value pairs.
+package manifestvars
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var placeholderRegexp = regexp.MustCompile(`\(\(([-/\.\w\pL]+)\)\)`)
+
+// InvalidVarError is returned when a var is not in the KEY=VALUE format.
+type InvalidVarError struct {
+	Var string
+}
+
+func (e InvalidVarError) Error() string {
+	return fmt.Sprintf("invalid var '%s', expected KEY=VALUE", e.Var)
+}
+
+// InvalidVarsFileError is returned when a vars file is not a YAML map.
+type InvalidVarsFileError struct {
+	Path string
+}
+
+func (e InvalidVarsFileError) Error() string {
+	return fmt.Sprintf("invalid vars file %s, expected a map", e.Path)
+}
+
+// UnresolvedVariablesError is returned when placeholders do not have a value.
+// Names are sorted and unique.
+type UnresolvedVariablesError struct {
+	Names []string
+}
+
+func (e UnresolvedVariablesError) Error() string {
+	return fmt.Sprintf("Expected to find variables: %s", strings.Join(e.Names, ", "))
+}
+
+// Vars maps variable names to their values.
+type Vars map[string]interface{}
+
+// ReadVars reads the vars files in order followed by the KEY=VALUE vars. Later
+// values override earlier ones. Values in vars files keep their YAML types,
+// while the values of KEY=VALUE vars are always the literal strings.
+func ReadVars(pathsToVarsFiles []string, vars []string) (Vars, error) {
+	allVars := Vars{}
+
+	for _, path := range pathsToVarsFiles {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		fileVars := map[string]interface{}{}
+		err = yaml.Unmarshal(raw, &fileVars)
+		if err != nil {
+			return nil, InvalidVarsFileError{Path: path}
+		}
+
+		for name, value := range fileVars {
+			allVars[name] = value
+		}
+	}
+
+	for _, keyValue := range vars {
+		parts := strings.SplitN(keyValue, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, InvalidVarError{Var: keyValue}
+		}
+		allVars[parts[0]] = parts[1]
+	}
+
+	return allVars, nil
+}
+
+// Interpolate returns a copy of node, a document unmarshalled from YAML, with
+// every placeholder replaced. A string that is only a placeholder takes the
+// type of the value, otherwise the value is formatted into the string. All
+// placeholders without a value are returned in an UnresolvedVariablesError.
+func Interpolate(node interface{}, vars Vars) (interface{}, error) {
+	missing := map[string]bool{}
+	interpolated := interpolate(node, vars, missing)
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, UnresolvedVariablesError{Names: names}
+	}
+
+	return interpolated, nil
+}
+
+func interpolate(node interface{}, vars Vars, missing map[string]bool) interface{} {
+	switch typedNode := node.(type) {
+	case map[interface{}]interface{}:
+		interpolated := map[interface{}]interface{}{}
+		for key, value := range typedNode {
+			interpolated[key] = interpolate(value, vars, missing)
+		}
+		return interpolated
+	case []interface{}:
+		interpolated := make([]interface{}, 0, len(typedNode))
+		for _, value := range typedNode {
+			interpolated = append(interpolated, interpolate(value, vars, missing))
+		}
+		return interpolated
+	case string:
+		return interpolateString(typedNode, vars, missing)
+	default:
+		return node
+	}
+}
+
+func interpolateString(str string, vars Vars, missing map[string]bool) interface{} {
+	if match := placeholderRegexp.FindStringSubmatch(str); match != nil && match[0] == str {
+		value, found := vars[match[1]]
+		if !found {
+			missing[match[1]] = true
+			return str
+		}
+		return value
+	}
+
+	return placeholderRegexp.ReplaceAllStringFunc(str, func(placeholder string) string {
+		name := placeholderRegexp.FindStringSubmatch(placeholder)[1]
+		value, found := vars[name]
+		if !found {
+			missing[name] = true
+			return placeholder
+		}
+		return fmt.Sprint(value)
+	})
+}
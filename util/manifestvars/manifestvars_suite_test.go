@@ -0,0 +1,13 @@
+package manifestvars_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestManifestvars(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Manifest Vars Suite")
+}
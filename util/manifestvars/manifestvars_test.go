@@ -0,0 +1,181 @@
+package manifestvars_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/manifestvars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Vars", func() {
+	Describe("ReadVars", func() {
+		var (
+			pathsToVarsFiles []string
+			vars             []string
+
+			readVars   Vars
+			executeErr error
+		)
+
+		writeVarsFile := func(contents string) string {
+			tempFile, err := ioutil.TempFile("", "vars-file-test-")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tempFile.WriteString(contents)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tempFile.Close()).ToNot(HaveOccurred())
+			return tempFile.Name()
+		}
+
+		BeforeEach(func() {
+			pathsToVarsFiles = nil
+			vars = nil
+		})
+
+		JustBeforeEach(func() {
+			readVars, executeErr = ReadVars(pathsToVarsFiles, vars)
+		})
+
+		AfterEach(func() {
+			for _, path := range pathsToVarsFiles {
+				Expect(os.RemoveAll(path)).ToNot(HaveOccurred())
+			}
+		})
+
+		Context("when provided vars files and vars", func() {
+			BeforeEach(func() {
+				pathsToVarsFiles = []string{
+					writeVarsFile("instances: 2\nroute: first.example.com\nenabled: true\n"),
+					writeVarsFile("route: second.example.com\n"),
+				}
+				vars = []string{"memory=1G", "instances=4", "name=some=name"}
+			})
+
+			It("merges them in order, keeping the YAML types of the vars files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(readVars).To(Equal(Vars{
+					"instances": "4",
+					"route":     "second.example.com",
+					"enabled":   true,
+					"memory":    "1G",
+					"name":      "some=name",
+				}))
+			})
+		})
+
+		Context("when a var looks like a YAML number or boolean", func() {
+			BeforeEach(func() {
+				vars = []string{"version=1.10", "flag=no", "octal=0123", "empty="}
+			})
+
+			It("keeps the literal string", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(readVars).To(Equal(Vars{
+					"version": "1.10",
+					"flag":    "no",
+					"octal":   "0123",
+					"empty":   "",
+				}))
+			})
+		})
+
+		Context("when a var is not KEY=VALUE", func() {
+			BeforeEach(func() {
+				vars = []string{"memory"}
+			})
+
+			It("returns an InvalidVarError", func() {
+				Expect(executeErr).To(MatchError(InvalidVarError{Var: "memory"}))
+			})
+		})
+
+		Context("when a vars file is not a map", func() {
+			BeforeEach(func() {
+				pathsToVarsFiles = []string{writeVarsFile("- some\n- list\n")}
+			})
+
+			It("returns an InvalidVarsFileError", func() {
+				Expect(executeErr).To(MatchError(InvalidVarsFileError{Path: pathsToVarsFiles[0]}))
+			})
+		})
+
+		Context("when a vars file does not exist", func() {
+			BeforeEach(func() {
+				pathsToVarsFiles = []string{"/does/not/exist"}
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Interpolate", func() {
+		var (
+			node interface{}
+			vars Vars
+
+			interpolated interface{}
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			node = map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"name":      "((name))",
+						"instances": "((instances))",
+						"no-route":  "((no-route))",
+						"routes": []interface{}{
+							map[interface{}]interface{}{"route": "((name)).((domain))/path"},
+						},
+						"memory": 128,
+					},
+				},
+			}
+			vars = Vars{
+				"name":      "some-app",
+				"instances": 3,
+				"no-route":  false,
+				"domain":    "example.com",
+			}
+		})
+
+		JustBeforeEach(func() {
+			interpolated, executeErr = Interpolate(node, vars)
+		})
+
+		Context("when all variables are provided", func() {
+			It("replaces the placeholders and keeps the value types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(interpolated).To(Equal(map[interface{}]interface{}{
+					"applications": []interface{}{
+						map[interface{}]interface{}{
+							"name":      "some-app",
+							"instances": 3,
+							"no-route":  false,
+							"routes": []interface{}{
+								map[interface{}]interface{}{"route": "some-app.example.com/path"},
+							},
+							"memory": 128,
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when variables are missing", func() {
+			BeforeEach(func() {
+				delete(vars, "name")
+				delete(vars, "domain")
+			})
+
+			It("returns an UnresolvedVariablesError listing every missing variable", func() {
+				Expect(executeErr).To(MatchError(UnresolvedVariablesError{Names: []string{"domain", "name"}}))
+				Expect(executeErr.Error()).To(Equal("Expected to find variables: domain, name"))
+			})
+		})
+	})
+})
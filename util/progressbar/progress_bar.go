@@ -4,17 +4,25 @@ import (
 	"io"
 	"time"
 
+	"github.com/cloudfoundry/bytefmt"
 	pb "gopkg.in/cheggaaa/pb.v1"
 )
 
+// DisplayFunc displays a line of text, such as command.UI's DisplayText.
+type DisplayFunc func(template string, data ...map[string]interface{})
+
 type ProgressBar struct {
-	ready chan bool
-	bar   *pb.ProgressBar
+	ready   chan bool
+	bar     *pb.ProgressBar
+	display DisplayFunc
 }
 
-func NewProgressBar() *ProgressBar {
+// NewProgressBar returns a ProgressBar that uses display to report upload
+// attempts and checksums.
+func NewProgressBar(display DisplayFunc) *ProgressBar {
 	return &ProgressBar{
-		ready: make(chan bool),
+		ready:   make(chan bool),
+		display: display,
 	}
 }
 
@@ -30,6 +38,20 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 	return p.bar.NewProxyReader(reader)
 }
 
+// AttemptComplete finishes the bar of the attempt and displays its
+// throughput.
+func (p *ProgressBar) AttemptComplete(bytes int64, duration time.Duration, err error) {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+	displayAttempt(p.display, bytes, duration, err)
+}
+
+func (p *ProgressBar) Verified(files int, size int64, packageSHA256 string) {
+	displayVerified(p.display, files, size, packageSHA256)
+}
+
 func (p *ProgressBar) Ready() {
 	p.ready <- true
 }
@@ -38,3 +60,134 @@ func (p *ProgressBar) Complete() {
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 }
+
+// FileProgressBar draws a progress bar, prefixed with the name of the file,
+// for each file of a copy.
+type FileProgressBar struct {
+	output io.Writer
+	bar    *pb.ProgressBar
+}
+
+// NewFileProgressBar returns a FileProgressBar that draws its bars to
+// output.
+func NewFileProgressBar(output io.Writer) *FileProgressBar {
+	return &FileProgressBar{
+		output: output,
+	}
+}
+
+// NewFileWrapper finishes the bar of the previous file and returns a reader
+// that draws a bar for the size bytes of the file name as they are read.
+func (p *FileProgressBar) NewFileWrapper(name string, reader io.Reader, size int64) io.Reader {
+	p.Complete()
+
+	p.bar = pb.New64(size).SetUnits(pb.U_BYTES).Prefix(name + " ")
+	p.bar.Output = p.output
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+// Complete finishes the bar of the last file.
+func (p *FileProgressBar) Complete() {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+}
+
+// progressSteps is the number of lines a StepProgressBar displays for a read.
+const progressSteps = 4
+
+// StepProgressBar displays the progress of a read as separate lines, one
+// every quarter of the way through. Unlike ProgressBar it does not redraw the
+// terminal, so the progress of several reads can be displayed at the same
+// time.
+type StepProgressBar struct {
+	ready   chan bool
+	display DisplayFunc
+}
+
+// NewStepProgressBar returns a StepProgressBar that uses display to show the
+// number of bytes read so far at every step.
+func NewStepProgressBar(display DisplayFunc) *StepProgressBar {
+	return &StepProgressBar{
+		ready:   make(chan bool),
+		display: display,
+	}
+}
+
+func (p *StepProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	ready, ok := <-p.ready
+	if !ready || !ok {
+		return nil
+	}
+
+	return &stepReader{
+		reader:  reader,
+		total:   sizeOfFile,
+		display: p.display,
+	}
+}
+
+func (p *StepProgressBar) Ready() {
+	p.ready <- true
+}
+
+func (*StepProgressBar) Complete() {}
+
+func (p *StepProgressBar) AttemptComplete(bytes int64, duration time.Duration, err error) {
+	displayAttempt(p.display, bytes, duration, err)
+}
+
+func (p *StepProgressBar) Verified(files int, size int64, packageSHA256 string) {
+	displayVerified(p.display, files, size, packageSHA256)
+}
+
+type stepReader struct {
+	reader  io.Reader
+	read    int64
+	total   int64
+	step    int64
+	display DisplayFunc
+}
+
+func (r *stepReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	for r.total > 0 && r.step < progressSteps && r.read*progressSteps >= r.total*(r.step+1) {
+		r.step++
+		r.display("Uploaded {{.Read}} of {{.Total}}", map[string]interface{}{
+			"Read":  bytefmt.ByteSize(uint64(r.read)),
+			"Total": bytefmt.ByteSize(uint64(r.total)),
+		})
+	}
+	return n, err
+}
+
+func displayAttempt(display DisplayFunc, bytes int64, duration time.Duration, err error) {
+	var rate int64
+	if duration > 0 {
+		rate = int64(float64(bytes) / duration.Seconds())
+	}
+
+	data := map[string]interface{}{
+		"Bytes":    bytefmt.ByteSize(uint64(bytes)),
+		"Duration": duration.Round(time.Millisecond),
+		"Rate":     bytefmt.ByteSize(uint64(rate)),
+	}
+	if err != nil {
+		data["Error"] = err.Error()
+		display("Upload attempt failed after sending {{.Bytes}} in {{.Duration}} ({{.Rate}}/s): {{.Error}}", data)
+		return
+	}
+	display("Sent {{.Bytes}} in {{.Duration}} ({{.Rate}}/s)", data)
+}
+
+func displayVerified(display DisplayFunc, files int, size int64, packageSHA256 string) {
+	display("Verified {{.Count}} files ({{.Size}}) in the uploaded package with SHA256 {{.SHA256}}", map[string]interface{}{
+		"Count":  files,
+		"Size":   bytefmt.ByteSize(uint64(size)),
+		"SHA256": packageSHA256,
+	})
+}
@@ -0,0 +1,409 @@
+// Package pushcache stores the SHA1s of pushed files and the archives built
+// from them, so that pushing files that have not changed does not rehash or
+// rezip them.
+//
+// Every write to the cache replaces a whole file by renaming a temporary file
+// over it, and updates to the file hashes are merged under a lock, so several
+// pushes can use the same cache at the same time.
+package pushcache
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	filesName    = "files.json"
+	archivesName = "archives"
+	lockName     = "lock"
+
+	archiveExtension = ".zip"
+
+	// DefaultMaxArchivesSize is the disk space the archives of a cache
+	// returned by NewCache may use.
+	DefaultMaxArchivesSize int64 = 1024 * 1024 * 1024
+)
+
+var (
+	// LockTimeout is how long to wait for another process to release the
+	// cache.
+	LockTimeout = 10 * time.Second
+
+	// StaleLockAge is the age after which a lock is assumed to be left over
+	// from a process that died and is removed.
+	StaleLockAge = time.Minute
+)
+
+// LockTimeoutError is returned when the cache is locked by another process
+// for longer than LockTimeout.
+type LockTimeoutError struct {
+	Path string
+}
+
+func (e LockTimeoutError) Error() string {
+	return "timed out waiting for push cache lock " + e.Path
+}
+
+// Cache is a push cache stored in a directory.
+type Cache struct {
+	Dir string
+
+	// MaxArchivesSize is the disk space the archives may use. The least
+	// recently used archives are removed when a stored archive would make
+	// them use more, and archives larger than it are not stored. Zero means
+	// no limit.
+	MaxArchivesSize int64
+}
+
+// NewCache returns a cache stored in dir whose archives use up to
+// DefaultMaxArchivesSize. The directory is created when something is first
+// stored.
+func NewCache(dir string) *Cache {
+	return &Cache{
+		Dir:             dir,
+		MaxArchivesSize: DefaultMaxArchivesSize,
+	}
+}
+
+type fileHash struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	SHA1    string    `json:"sha1"`
+}
+
+// FileHashes are the file SHA1s loaded from a cache. A hash is only used while
+// the size and modification time of the file are unchanged.
+type FileHashes struct {
+	hashes  map[string]fileHash
+	updates map[string]fileHash
+}
+
+// Lookup returns the cached SHA1 of the file at path.
+func (hashes *FileHashes) Lookup(path string, info os.FileInfo) (string, bool) {
+	hash, ok := hashes.hashes[path]
+	if !ok || hash.Size != info.Size() || !hash.ModTime.Equal(info.ModTime()) {
+		return "", false
+	}
+	return hash.SHA1, true
+}
+
+// Store records the SHA1 of the file at path.
+func (hashes *FileHashes) Store(path string, info os.FileInfo, sha1 string) {
+	hash := fileHash{Size: info.Size(), ModTime: info.ModTime(), SHA1: sha1}
+	hashes.hashes[path] = hash
+	hashes.updates[path] = hash
+}
+
+// LoadFileHashes returns the file SHA1s in the cache. A missing or invalid
+// cache is treated as empty.
+func (cache *Cache) LoadFileHashes() *FileHashes {
+	return &FileHashes{
+		hashes:  cache.readFileHashes(),
+		updates: map[string]fileHash{},
+	}
+}
+
+// SaveFileHashes stores the SHA1s added to hashes since they were loaded,
+// keeping the ones stored by other processes in the meantime.
+func (cache *Cache) SaveFileHashes(hashes *FileHashes) error {
+	if len(hashes.updates) == 0 {
+		return nil
+	}
+
+	unlock, err := cache.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current := cache.readFileHashes()
+	for path, hash := range hashes.updates {
+		current[path] = hash
+	}
+	return cache.writeFileHashes(current)
+}
+
+// Archive returns the path to a copy of the archive stored under key. The
+// caller owns the copy and should remove it when done.
+func (cache *Cache) Archive(key string) (string, bool) {
+	cachedPath := cache.archivePath(key)
+	cached, err := os.Open(cachedPath)
+	if err != nil {
+		return "", false
+	}
+	defer cached.Close()
+
+	archive, err := ioutil.TempFile("", "cf-cli-")
+	if err != nil {
+		log.Warnln("creating archive copy:", err)
+		return "", false
+	}
+	defer archive.Close()
+
+	_, err = io.Copy(archive, cached)
+	if err != nil {
+		log.WithField("path", cachedPath).Warnln("copying cached archive:", err)
+		os.Remove(archive.Name())
+		return "", false
+	}
+
+	now := time.Now()
+	_ = os.Chtimes(cachedPath, now, now)
+	return archive.Name(), true
+}
+
+// StoreArchive stores a copy of the archive at path under key, removing the
+// least recently used archives if the archives would use more than
+// MaxArchivesSize.
+func (cache *Cache) StoreArchive(key string, path string) error {
+	archive, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	info, err := archive.Stat()
+	if err != nil {
+		return err
+	}
+	if cache.MaxArchivesSize > 0 && info.Size() > cache.MaxArchivesSize {
+		log.WithField("size", info.Size()).Debug("archive too large for push cache")
+		return nil
+	}
+
+	unlock, err := cache.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = cache.writeFile(cache.archivePath(key), archive)
+	if err != nil {
+		return err
+	}
+	return cache.evictArchives(key)
+}
+
+// Stats describes the contents of a cache.
+type Stats struct {
+	Files        int
+	Archives     int
+	ArchivesSize int64
+}
+
+// Stats returns the number of file hashes and archives in the cache and the
+// disk space used by the archives.
+func (cache *Cache) Stats() (Stats, error) {
+	stats := Stats{Files: len(cache.readFileHashes())}
+
+	archives, err := cache.archives()
+	if err != nil {
+		return Stats{}, err
+	}
+	for _, archive := range archives {
+		stats.Archives++
+		stats.ArchivesSize += archive.Size()
+	}
+	return stats, nil
+}
+
+// Prune removes the archives that have not been used for maxAge and the
+// hashes of files that no longer exist or have changed. It returns what was
+// removed.
+func (cache *Cache) Prune(maxAge time.Duration) (Stats, error) {
+	unlock, err := cache.lock()
+	if err != nil {
+		return Stats{}, err
+	}
+	defer unlock()
+
+	var pruned Stats
+
+	hashes := cache.readFileHashes()
+	for path, hash := range hashes {
+		info, statErr := os.Stat(path)
+		if statErr != nil || info.Size() != hash.Size || !info.ModTime().Equal(hash.ModTime) {
+			delete(hashes, path)
+			pruned.Files++
+		}
+	}
+	if pruned.Files > 0 {
+		err = cache.writeFileHashes(hashes)
+		if err != nil {
+			return pruned, err
+		}
+	}
+
+	archives, err := cache.archives()
+	if err != nil {
+		return pruned, err
+	}
+	for _, archive := range archives {
+		if time.Since(archive.ModTime()) < maxAge {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(cache.Dir, archivesName, archive.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			return pruned, err
+		}
+		pruned.Archives++
+		pruned.ArchivesSize += archive.Size()
+	}
+
+	return pruned, nil
+}
+
+// evictArchives removes the least recently used archives, other than the one
+// stored under key, until the archives use no more than MaxArchivesSize.
+// Archive marks an archive as used by updating its modification time.
+func (cache *Cache) evictArchives(key string) error {
+	if cache.MaxArchivesSize <= 0 {
+		return nil
+	}
+
+	archives, err := cache.archives()
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	for _, archive := range archives {
+		size += archive.Size()
+	}
+
+	sort.Slice(archives, func(i int, j int) bool {
+		return archives[i].ModTime().Before(archives[j].ModTime())
+	})
+	for _, archive := range archives {
+		if size <= cache.MaxArchivesSize {
+			break
+		}
+		if archive.Name() == key+archiveExtension {
+			continue
+		}
+
+		log.WithField("archive", archive.Name()).Debug("evicting push cache archive")
+		err = os.Remove(filepath.Join(cache.Dir, archivesName, archive.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		size -= archive.Size()
+	}
+	return nil
+}
+
+func (cache *Cache) archivePath(key string) string {
+	return filepath.Join(cache.Dir, archivesName, key+archiveExtension)
+}
+
+func (cache *Cache) archives() ([]os.FileInfo, error) {
+	files, err := ioutil.ReadDir(filepath.Join(cache.Dir, archivesName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var archives []os.FileInfo
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), archiveExtension) {
+			archives = append(archives, file)
+		}
+	}
+	return archives, nil
+}
+
+func (cache *Cache) readFileHashes() map[string]fileHash {
+	hashes := map[string]fileHash{}
+
+	raw, err := ioutil.ReadFile(filepath.Join(cache.Dir, filesName))
+	if err != nil {
+		return hashes
+	}
+
+	err = json.Unmarshal(raw, &hashes)
+	if err != nil {
+		log.WithField("dir", cache.Dir).Warnln("ignoring invalid push cache file hashes:", err)
+		return map[string]fileHash{}
+	}
+	return hashes
+}
+
+func (cache *Cache) writeFileHashes(hashes map[string]fileHash) error {
+	raw, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	return cache.writeFile(filepath.Join(cache.Dir, filesName), bytes.NewReader(raw))
+}
+
+// writeFile replaces the file at path with the contents of reader, so that
+// readers of the file never see it partially written.
+func (cache *Cache) writeFile(path string, reader io.Reader) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tempFile, reader)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+	}
+	return err
+}
+
+// lock waits for exclusive access to the cache and returns a function that
+// releases it.
+func (cache *Cache) lock() (func(), error) {
+	err := os.MkdirAll(cache.Dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	lockPath := filepath.Join(cache.Dir, lockName)
+	deadline := time.Now().Add(LockTimeout)
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			lockFile.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > StaleLockAge {
+			log.WithField("path", lockPath).Warn("removing stale push cache lock")
+			os.Remove(lockPath)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, LockTimeoutError{Path: lockPath}
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,249 @@
+package pushcache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/pushcache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		cacheDir string
+		filesDir string
+		cache    *Cache
+	)
+
+	writeFile := func(name string, contents string) (string, os.FileInfo) {
+		path := filepath.Join(filesDir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		return path, info
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "push-cache")
+		Expect(err).ToNot(HaveOccurred())
+		filesDir, err = ioutil.TempDir("", "push-cache-files")
+		Expect(err).ToNot(HaveOccurred())
+
+		cache = NewCache(filepath.Join(cacheDir, "push-cache"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+		Expect(os.RemoveAll(filesDir)).To(Succeed())
+	})
+
+	Describe("file hashes", func() {
+		It("returns the stored SHA1 while the file is unchanged", func() {
+			path, info := writeFile("some-file", "some-contents")
+
+			hashes := cache.LoadFileHashes()
+			_, ok := hashes.Lookup(path, info)
+			Expect(ok).To(BeFalse())
+
+			hashes.Store(path, info, "some-sha1")
+			Expect(cache.SaveFileHashes(hashes)).To(Succeed())
+
+			sha1, ok := cache.LoadFileHashes().Lookup(path, info)
+			Expect(ok).To(BeTrue())
+			Expect(sha1).To(Equal("some-sha1"))
+
+			_, changedInfo := writeFile("some-file", "some-other-contents")
+			_, ok = cache.LoadFileHashes().Lookup(path, changedInfo)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("keeps the hashes saved by other pushes", func() {
+			path1, info1 := writeFile("file-1", "contents-1")
+			path2, info2 := writeFile("file-2", "contents-2")
+
+			hashes1 := cache.LoadFileHashes()
+			hashes2 := cache.LoadFileHashes()
+			hashes1.Store(path1, info1, "sha-1")
+			hashes2.Store(path2, info2, "sha-2")
+			Expect(cache.SaveFileHashes(hashes1)).To(Succeed())
+			Expect(cache.SaveFileHashes(hashes2)).To(Succeed())
+
+			hashes := cache.LoadFileHashes()
+			sha1, _ := hashes.Lookup(path1, info1)
+			Expect(sha1).To(Equal("sha-1"))
+			sha1, _ = hashes.Lookup(path2, info2)
+			Expect(sha1).To(Equal("sha-2"))
+		})
+
+		It("can be saved by several pushes at the same time", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				path, info := writeFile(string('a'+rune(i)), "contents")
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					hashes := cache.LoadFileHashes()
+					hashes.Store(path, info, "some-sha1")
+					Expect(cache.SaveFileHashes(hashes)).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			stats, err := cache.Stats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Files).To(Equal(5))
+		})
+
+		Context("when the cache is locked by another push", func() {
+			var oldTimeout time.Duration
+
+			BeforeEach(func() {
+				oldTimeout = LockTimeout
+				LockTimeout = 100 * time.Millisecond
+
+				Expect(os.MkdirAll(cache.Dir, 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(cache.Dir, "lock"), nil, 0600)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				LockTimeout = oldTimeout
+			})
+
+			It("returns a LockTimeoutError", func() {
+				path, info := writeFile("some-file", "some-contents")
+				hashes := cache.LoadFileHashes()
+				hashes.Store(path, info, "some-sha1")
+
+				Expect(cache.SaveFileHashes(hashes)).To(MatchError(LockTimeoutError{Path: filepath.Join(cache.Dir, "lock")}))
+			})
+
+			Context("when the lock is stale", func() {
+				BeforeEach(func() {
+					old := time.Now().Add(-2 * StaleLockAge)
+					Expect(os.Chtimes(filepath.Join(cache.Dir, "lock"), old, old)).To(Succeed())
+				})
+
+				It("removes the lock", func() {
+					path, info := writeFile("some-file", "some-contents")
+					hashes := cache.LoadFileHashes()
+					hashes.Store(path, info, "some-sha1")
+
+					Expect(cache.SaveFileHashes(hashes)).To(Succeed())
+				})
+			})
+		})
+	})
+
+	Describe("archives", func() {
+		It("returns a copy of the stored archive", func() {
+			_, ok := cache.Archive("some-key")
+			Expect(ok).To(BeFalse())
+
+			archivePath, _ := writeFile("archive.zip", "some-zip")
+			Expect(cache.StoreArchive("some-key", archivePath)).To(Succeed())
+
+			copyPath, ok := cache.Archive("some-key")
+			Expect(ok).To(BeTrue())
+			defer os.Remove(copyPath)
+			Expect(copyPath).ToNot(Equal(archivePath))
+			Expect(ioutil.ReadFile(copyPath)).To(Equal([]byte("some-zip")))
+
+			Expect(os.Remove(copyPath)).To(Succeed())
+			_, ok = cache.Archive("some-key")
+			Expect(ok).To(BeTrue())
+		})
+
+		Context("when the archives would use more than MaxArchivesSize", func() {
+			var archivePath string
+
+			BeforeEach(func() {
+				cache.MaxArchivesSize = 10
+				archivePath, _ = writeFile("archive.zip", "12345")
+
+				Expect(cache.StoreArchive("oldest", archivePath)).To(Succeed())
+				Expect(cache.StoreArchive("used", archivePath)).To(Succeed())
+
+				old := time.Now().Add(-time.Hour)
+				Expect(os.Chtimes(filepath.Join(cache.Dir, "archives", "oldest.zip"), old, old)).To(Succeed())
+				Expect(os.Chtimes(filepath.Join(cache.Dir, "archives", "used.zip"), old.Add(-time.Hour), old.Add(-time.Hour))).To(Succeed())
+
+				copyPath, ok := cache.Archive("used")
+				Expect(ok).To(BeTrue())
+				Expect(os.Remove(copyPath)).To(Succeed())
+			})
+
+			It("evicts the least recently used archives", func() {
+				Expect(cache.StoreArchive("newest", archivePath)).To(Succeed())
+
+				_, ok := cache.Archive("oldest")
+				Expect(ok).To(BeFalse())
+				for _, key := range []string{"used", "newest"} {
+					copyPath, ok := cache.Archive(key)
+					Expect(ok).To(BeTrue())
+					Expect(os.Remove(copyPath)).To(Succeed())
+				}
+				Expect(cache.Stats()).To(Equal(Stats{Archives: 2, ArchivesSize: 10}))
+			})
+
+			It("does not store archives larger than MaxArchivesSize", func() {
+				largePath, _ := writeFile("large.zip", "12345678901")
+				Expect(cache.StoreArchive("large", largePath)).To(Succeed())
+
+				_, ok := cache.Archive("large")
+				Expect(ok).To(BeFalse())
+				Expect(cache.Stats()).To(Equal(Stats{Archives: 2, ArchivesSize: 10}))
+			})
+		})
+	})
+
+	Describe("Stats and Prune", func() {
+		var (
+			keptPath    string
+			deletedPath string
+		)
+
+		BeforeEach(func() {
+			var keptInfo, deletedInfo os.FileInfo
+			keptPath, keptInfo = writeFile("kept", "kept")
+			deletedPath, deletedInfo = writeFile("deleted", "deleted")
+
+			hashes := cache.LoadFileHashes()
+			hashes.Store(keptPath, keptInfo, "sha-1")
+			hashes.Store(deletedPath, deletedInfo, "sha-2")
+			Expect(cache.SaveFileHashes(hashes)).To(Succeed())
+			Expect(os.Remove(deletedPath)).To(Succeed())
+
+			archivePath, _ := writeFile("archive.zip", "12345")
+			Expect(cache.StoreArchive("new", archivePath)).To(Succeed())
+			Expect(cache.StoreArchive("old", archivePath)).To(Succeed())
+
+			old := time.Now().Add(-48 * time.Hour)
+			Expect(os.Chtimes(filepath.Join(cache.Dir, "archives", "old.zip"), old, old)).To(Succeed())
+		})
+
+		It("reports the contents of the cache", func() {
+			Expect(cache.Stats()).To(Equal(Stats{Files: 2, Archives: 2, ArchivesSize: 10}))
+		})
+
+		It("prunes old archives and the hashes of missing files", func() {
+			Expect(cache.Prune(24 * time.Hour)).To(Equal(Stats{Files: 1, Archives: 1, ArchivesSize: 5}))
+			Expect(cache.Stats()).To(Equal(Stats{Files: 1, Archives: 1, ArchivesSize: 5}))
+
+			_, ok := cache.Archive("old")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("prunes everything with a zero max age", func() {
+			Expect(cache.Prune(0)).To(Equal(Stats{Files: 1, Archives: 2, ArchivesSize: 10}))
+			Expect(cache.Stats()).To(Equal(Stats{Files: 1}))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package pushcache_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPushCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Push Cache Suite")
+}
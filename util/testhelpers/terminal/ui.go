@@ -127,6 +127,16 @@ func (ui *FakeUI) AskForPassword(prompt string) string {
 	return answer
 }
 
+func (ui *FakeUI) ReadLine() string {
+	if len(ui.Inputs) == 0 {
+		return ""
+	}
+
+	answer := ui.Inputs[0]
+	ui.Inputs = ui.Inputs[1:]
+	return answer
+}
+
 func (ui *FakeUI) Ok() {
 	ui.Say("OK")
 }
@@ -0,0 +1,205 @@
+// Package timings summarizes where the time went while a command ran, from
+// the spans recorded by the tracing package, for the --timings flag.
+package timings
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+)
+
+// JSONFormat is the format of a summary written as a JSON document.
+const JSONFormat = "json"
+
+var guidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// Duration is a time.Duration that is encoded in JSON as a number of
+// milliseconds.
+type Duration time.Duration
+
+// MarshalJSON encodes the duration in milliseconds.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(d) / float64(time.Millisecond))
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).Round(time.Millisecond).String()
+}
+
+// Endpoint is the time spent on the requests to one endpoint. The GUIDs in
+// its path are replaced with ":guid" so that requests for different resources
+// are counted together.
+type Endpoint struct {
+	Service       string   `json:"service"`
+	Method        string   `json:"method"`
+	Path          string   `json:"path"`
+	Count         int      `json:"count"`
+	Total         Duration `json:"total_ms"`
+	P50           Duration `json:"p50_ms"`
+	Max           Duration `json:"max_ms"`
+	BytesSent     int64    `json:"bytes_sent"`
+	BytesReceived int64    `json:"bytes_received"`
+}
+
+// Operation is the time spent on work the CLI does itself, such as zipping
+// files, or waiting for an app to stage or start.
+type Operation struct {
+	Name  string   `json:"name"`
+	Count int      `json:"count"`
+	Total Duration `json:"total_ms"`
+}
+
+// Summary is where the time went while a command ran.
+type Summary struct {
+	Command       string      `json:"command"`
+	Total         Duration    `json:"total_ms"`
+	Requests      int         `json:"requests"`
+	BytesSent     int64       `json:"bytes_sent"`
+	BytesReceived int64       `json:"bytes_received"`
+	Endpoints     []Endpoint  `json:"endpoints"`
+	Operations    []Operation `json:"operations"`
+}
+
+// Summarize groups spans by endpoint and operation. The span without a
+// parent is the command, and sets the total time. Endpoints and operations
+// are sorted by the time spent on them, longest first.
+func Summarize(spans []*tracing.Span) Summary {
+	summary := Summary{
+		Endpoints:  []Endpoint{},
+		Operations: []Operation{},
+	}
+
+	endpoints := map[string]*Endpoint{}
+	durations := map[string][]time.Duration{}
+	operations := map[string]*Operation{}
+
+	for _, span := range spans {
+		switch {
+		case span.ParentSpanID == "":
+			summary.Command = span.Name
+			summary.Total = Duration(span.Duration())
+		case span.Kind == tracing.SpanKindClient:
+			method := span.Attributes[tracing.AttributeMethod]
+			path := guidPattern.ReplaceAllString(span.Attributes[tracing.AttributePath], ":guid")
+			key := strings.Join([]string{span.Attributes[tracing.AttributePeerService], method, path}, " ")
+
+			endpoint, ok := endpoints[key]
+			if !ok {
+				endpoint = &Endpoint{
+					Service: span.Attributes[tracing.AttributePeerService],
+					Method:  method,
+					Path:    path,
+				}
+				endpoints[key] = endpoint
+			}
+
+			duration := span.Duration()
+			endpoint.Count++
+			endpoint.Total += Duration(duration)
+			if Duration(duration) > endpoint.Max {
+				endpoint.Max = Duration(duration)
+			}
+			durations[key] = append(durations[key], duration)
+
+			sent := size(span, tracing.AttributeRequestBodySize)
+			received := size(span, tracing.AttributeResponseBodySize)
+			endpoint.BytesSent += sent
+			endpoint.BytesReceived += received
+
+			summary.Requests++
+			summary.BytesSent += sent
+			summary.BytesReceived += received
+		case span.Kind == tracing.SpanKindInternal:
+			operation, ok := operations[span.Name]
+			if !ok {
+				operation = &Operation{Name: span.Name}
+				operations[span.Name] = operation
+			}
+			operation.Count++
+			operation.Total += Duration(span.Duration())
+		}
+	}
+
+	for key, endpoint := range endpoints {
+		endpoint.P50 = Duration(median(durations[key]))
+		summary.Endpoints = append(summary.Endpoints, *endpoint)
+	}
+	sort.Slice(summary.Endpoints, func(i int, j int) bool {
+		if summary.Endpoints[i].Total != summary.Endpoints[j].Total {
+			return summary.Endpoints[i].Total > summary.Endpoints[j].Total
+		}
+		return summary.Endpoints[i].Path < summary.Endpoints[j].Path
+	})
+
+	for _, operation := range operations {
+		summary.Operations = append(summary.Operations, *operation)
+	}
+	sort.Slice(summary.Operations, func(i int, j int) bool {
+		if summary.Operations[i].Total != summary.Operations[j].Total {
+			return summary.Operations[i].Total > summary.Operations[j].Total
+		}
+		return summary.Operations[i].Name < summary.Operations[j].Name
+	})
+
+	return summary
+}
+
+// Write writes the summary to w as a JSON document when format is
+// JSONFormat, and as tables otherwise.
+func (summary Summary) Write(w io.Writer, format string) error {
+	if format == JSONFormat {
+		raw, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", raw)
+		return err
+	}
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "\nTimings for %s:\n", summary.Command)
+	fmt.Fprintf(table, "total time:\t%s\n", summary.Total)
+	fmt.Fprintf(table, "requests:\t%d\n", summary.Requests)
+	fmt.Fprintf(table, "bytes sent:\t%d\n", summary.BytesSent)
+	fmt.Fprintf(table, "bytes received:\t%d\n", summary.BytesReceived)
+
+	if len(summary.Endpoints) > 0 {
+		fmt.Fprintf(table, "\nendpoint\tcount\ttotal\tp50\tmax\tsent\treceived\n")
+		for _, endpoint := range summary.Endpoints {
+			fmt.Fprintf(table, "%s %s\t%d\t%s\t%s\t%s\t%d\t%d\n",
+				endpoint.Method, endpoint.Path, endpoint.Count, endpoint.Total, endpoint.P50, endpoint.Max, endpoint.BytesSent, endpoint.BytesReceived)
+		}
+	}
+
+	if len(summary.Operations) > 0 {
+		fmt.Fprintf(table, "\noperation\tcount\ttotal\n")
+		for _, operation := range summary.Operations {
+			fmt.Fprintf(table, "%s\t%d\t%s\n", operation.Name, operation.Count, operation.Total)
+		}
+	}
+
+	return table.Flush()
+}
+
+func size(span *tracing.Span, attribute string) int64 {
+	value, err := strconv.ParseInt(span.Attributes[attribute], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// median returns the lower median of durations.
+func median(durations []time.Duration) time.Duration {
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i int, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[(len(sorted)-1)/2]
+}
@@ -0,0 +1,162 @@
+package timings_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/tracing"
+	. "code.cloudfoundry.org/cli/util/timings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timings", func() {
+	var (
+		tracer *tracing.Tracer
+		now    time.Time
+		spans  []*tracing.Span
+	)
+
+	// finishAfter finishes span after it has taken duration.
+	finishAfter := func(span *tracing.Span, duration time.Duration) {
+		now = now.Add(duration)
+		span.Finish()
+	}
+
+	request := func(method string, path string, duration time.Duration, sent string, received string) {
+		span := tracer.StartClient(method + " " + path)
+		span.SetAttribute(tracing.AttributePeerService, "cloud_controller")
+		span.SetAttribute(tracing.AttributeMethod, method)
+		span.SetAttribute(tracing.AttributePath, path)
+		if sent != "" {
+			span.SetAttribute(tracing.AttributeRequestBodySize, sent)
+		}
+		span.SetAttribute(tracing.AttributeResponseBodySize, received)
+		finishAfter(span, duration)
+	}
+
+	BeforeEach(func() {
+		recorder := new(tracing.SpanRecorder)
+		tracer = tracing.NewTracer(recorder)
+		now = time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+		tracer.Now = func() time.Time { return now }
+
+		root := tracer.StartCommand("cf push")
+		request("GET", "/v2/apps/2d1ca4b6-8f43-4a47-b8c3-6bd5bce8e5c3", 100*time.Millisecond, "", "200")
+		request("GET", "/v2/apps/9a3a4e1b-25d7-4d2e-9f2b-3f1e6d2a6b8e", 300*time.Millisecond, "", "300")
+		request("GET", "/v2/apps/0f0e8a3c-3b6f-4b5e-8a8a-0c1f2e3d4c5b", 200*time.Millisecond, "", "100")
+		request("PUT", "/v2/apps/0f0e8a3c-3b6f-4b5e-8a8a-0c1f2e3d4c5b/bits", 2*time.Second, "5000", "10")
+
+		zip := tracer.StartInternal("zip files")
+		finishAfter(zip, 500*time.Millisecond)
+		staging := tracer.StartInternal("wait for staging")
+		finishAfter(staging, 30*time.Second)
+
+		finishAfter(root, time.Second)
+
+		Expect(tracer.Flush()).To(Succeed())
+		spans = recorder.Spans
+	})
+
+	Describe("Summarize", func() {
+		It("groups requests by endpoint and operations by name", func() {
+			summary := Summarize(spans)
+			Expect(summary.Command).To(Equal("cf push"))
+			Expect(summary.Total).To(Equal(Duration(34100 * time.Millisecond)))
+			Expect(summary.Requests).To(Equal(4))
+			Expect(summary.BytesSent).To(BeEquivalentTo(5000))
+			Expect(summary.BytesReceived).To(BeEquivalentTo(610))
+
+			Expect(summary.Endpoints).To(Equal([]Endpoint{
+				{
+					Service:       "cloud_controller",
+					Method:        "PUT",
+					Path:          "/v2/apps/:guid/bits",
+					Count:         1,
+					Total:         Duration(2 * time.Second),
+					P50:           Duration(2 * time.Second),
+					Max:           Duration(2 * time.Second),
+					BytesSent:     5000,
+					BytesReceived: 10,
+				},
+				{
+					Service:       "cloud_controller",
+					Method:        "GET",
+					Path:          "/v2/apps/:guid",
+					Count:         3,
+					Total:         Duration(600 * time.Millisecond),
+					P50:           Duration(200 * time.Millisecond),
+					Max:           Duration(300 * time.Millisecond),
+					BytesReceived: 600,
+				},
+			}))
+
+			Expect(summary.Operations).To(Equal([]Operation{
+				{Name: "wait for staging", Count: 1, Total: Duration(30 * time.Second)},
+				{Name: "zip files", Count: 1, Total: Duration(500 * time.Millisecond)},
+			}))
+		})
+
+		It("returns an empty summary when there are no spans", func() {
+			summary := Summarize(nil)
+			Expect(summary.Endpoints).To(BeEmpty())
+			Expect(summary.Operations).To(BeEmpty())
+		})
+	})
+
+	Describe("Write", func() {
+		var out *bytes.Buffer
+
+		BeforeEach(func() {
+			out = new(bytes.Buffer)
+		})
+
+		It("writes tables", func() {
+			Expect(Summarize(spans).Write(out, "table")).To(Succeed())
+			Expect(out.String()).To(Equal(`
+Timings for cf push:
+total time:      34.1s
+requests:        4
+bytes sent:      5000
+bytes received:  610
+
+endpoint                 count  total  p50    max    sent  received
+PUT /v2/apps/:guid/bits  1      2s     2s     2s     5000  10
+GET /v2/apps/:guid       3      600ms  200ms  300ms  0     600
+
+operation         count  total
+wait for staging  1      30s
+zip files         1      500ms
+`))
+		})
+
+		It("writes a JSON document with durations in milliseconds", func() {
+			Expect(Summarize(spans).Write(out, JSONFormat)).To(Succeed())
+
+			var document map[string]interface{}
+			Expect(json.Unmarshal(out.Bytes(), &document)).To(Succeed())
+			Expect(document["total_ms"]).To(BeNumerically("==", 34100))
+			Expect(document["requests"]).To(BeNumerically("==", 4))
+
+			endpoints := document["endpoints"].([]interface{})
+			Expect(endpoints[1]).To(Equal(map[string]interface{}{
+				"service":        "cloud_controller",
+				"method":         "GET",
+				"path":           "/v2/apps/:guid",
+				"count":          float64(3),
+				"total_ms":       float64(600),
+				"p50_ms":         float64(200),
+				"max_ms":         float64(300),
+				"bytes_sent":     float64(0),
+				"bytes_received": float64(600),
+			}))
+			Expect(document["operations"]).To(ContainElement(map[string]interface{}{
+				"name":     "zip files",
+				"count":    float64(1),
+				"total_ms": float64(500),
+			}))
+		})
+	})
+})
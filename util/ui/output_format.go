@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DocumentSchemaVersion is the version of the document envelope and of every
+// document kind written by DisplayDocument. It must be incremented whenever a
+// field is renamed, removed or changes meaning; adding fields does not
+// require a new version.
+const DocumentSchemaVersion = 1
+
+// OutputFormat is the format command results are displayed in.
+type OutputFormat string
+
+const (
+	// OutputFormatTable displays human readable tables. This is the default.
+	OutputFormatTable OutputFormat = ""
+	// OutputFormatJSON displays a single JSON document.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatYAML displays a single YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// UnsupportedOutputFormatError is returned when the output format is not one
+// of the supported formats.
+type UnsupportedOutputFormatError struct {
+	Format string
+}
+
+func (e UnsupportedOutputFormatError) Error() string {
+	return fmt.Sprintf("Output format '%s' is not supported", e.Format)
+}
+
+// ParseOutputFormat converts the provided value to an OutputFormat. An empty
+// value and "table" are both the default table format.
+func ParseOutputFormat(format string) (OutputFormat, error) {
+	switch format {
+	case "", "table":
+		return OutputFormatTable, nil
+	case string(OutputFormatJSON):
+		return OutputFormatJSON, nil
+	case string(OutputFormatYAML):
+		return OutputFormatYAML, nil
+	default:
+		return OutputFormatTable, UnsupportedOutputFormatError{Format: format}
+	}
+}
+
+// Document is the versioned envelope every structured document is wrapped
+// in.
+type Document struct {
+	SchemaVersion int         `json:"schema_version" yaml:"schema_version"`
+	Kind          string      `json:"kind" yaml:"kind"`
+	Data          interface{} `json:"data" yaml:"data"`
+}
+
+// IsStructuredOutput returns true when command results should be displayed
+// with DisplayDocument instead of tables.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.OutputFormat != OutputFormatTable
+}
+
+// DisplayDocument wraps data in a Document of the provided kind and writes it
+// to ui.DocumentOut in the configured output format.
+func (ui *UI) DisplayDocument(kind string, data interface{}) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	document := Document{
+		SchemaVersion: DocumentSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+
+	switch ui.OutputFormat {
+	case OutputFormatJSON:
+		return writeJSONDocument(ui.DocumentOut, document)
+	case OutputFormatYAML:
+		return writeYAMLDocument(ui.DocumentOut, document)
+	default:
+		return UnsupportedOutputFormatError{Format: string(ui.OutputFormat)}
+	}
+}
+
+func writeJSONDocument(out io.Writer, document Document) error {
+	raw, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "%s\n", raw)
+	return err
+}
+
+func writeYAMLDocument(out io.Writer, document Document) error {
+	raw, err := yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(raw)
+	return err
+}
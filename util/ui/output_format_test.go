@@ -0,0 +1,121 @@
+package ui_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Output Format", func() {
+	type someData struct {
+		Name  string   `json:"name" yaml:"name"`
+		Items []string `json:"items" yaml:"items"`
+	}
+
+	var (
+		ui          *UI
+		fakeConfig  *uifakes.FakeConfig
+		documentOut *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+	})
+
+	Describe("NewUI", func() {
+		Context("when the output format is not set", func() {
+			It("displays tables", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(ui.IsStructuredOutput()).To(BeFalse())
+			})
+		})
+
+		Context("when the output format is structured", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns("json")
+			})
+
+			It("writes all other output to STDERR", func() {
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(ui.IsStructuredOutput()).To(BeTrue())
+				Expect(ui.Out).To(Equal(os.Stderr))
+				Expect(ui.DocumentOut).To(Equal(os.Stdout))
+			})
+		})
+
+		Context("when the output format is not supported", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns("xml")
+			})
+
+			It("returns an UnsupportedOutputFormatError", func() {
+				_, err := NewUI(fakeConfig)
+				Expect(err).To(MatchError(UnsupportedOutputFormatError{Format: "xml"}))
+			})
+		})
+	})
+
+	Describe("DisplayDocument", func() {
+		BeforeEach(func() {
+			ui = NewTestUI(nil, NewBuffer(), NewBuffer())
+			documentOut = NewBuffer()
+			ui.DocumentOut = documentOut
+		})
+
+		Context("when the output format is JSON", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = OutputFormatJSON
+			})
+
+			It("writes a versioned JSON document", func() {
+				err := ui.DisplayDocument("some-kind", someData{Name: "some-name", Items: []string{}})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(documentOut.Contents()).To(MatchJSON(`{
+					"schema_version": 1,
+					"kind": "some-kind",
+					"data": {"name": "some-name", "items": []}
+				}`))
+			})
+		})
+
+		Context("when the output format is YAML", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = OutputFormatYAML
+			})
+
+			It("writes a versioned YAML document", func() {
+				err := ui.DisplayDocument("some-kind", someData{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(documentOut.Contents()).To(MatchYAML(`
+schema_version: 1
+kind: some-kind
+data:
+  name: some-name
+  items:
+  - a
+  - b
+`))
+			})
+		})
+
+		Context("when the output format is the table format", func() {
+			It("returns an UnsupportedOutputFormatError", func() {
+				err := ui.DisplayDocument("some-kind", someData{})
+				Expect(err).To(MatchError(UnsupportedOutputFormatError{Format: ""}))
+				Expect(documentOut.Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -1,5 +1,18 @@
 package ui
 
+import "strings"
+
 // RedactedValue is the text that is displayed for redacted content. (eg
 // authorization tokens, passwords, etc.)
 const RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+// redactSecrets replaces every occurrence of the UI's secrets in text with
+// RedactedValue.
+func (ui *UI) redactSecrets(text string) string {
+	for _, secret := range ui.Secrets {
+		if secret != "" {
+			text = strings.Replace(text, secret, RedactedValue, -1)
+		}
+	}
+	return text
+}
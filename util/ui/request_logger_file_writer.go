@@ -40,7 +40,7 @@ func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
-	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
+	sanitized := display.ui.redactSecrets(display.dumpSanitizer.ReplaceAllString(dump, RedactedValue))
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(sanitized)
 		if err != nil {
@@ -77,8 +77,9 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 		return err
 	}
 
+	redacted := display.ui.redactSecrets(buff.String())
 	for _, logFile := range display.logFiles {
-		_, err = logFile.Write(buff.Bytes())
+		_, err = logFile.WriteString(redacted)
 		if err != nil {
 			return err
 		}
@@ -88,7 +89,7 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 
 func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
 	for _, logFile := range display.logFiles {
-		_, err := logFile.WriteString(fmt.Sprintf("%s\n", msg))
+		_, err := logFile.WriteString(fmt.Sprintf("%s\n", display.ui.redactSecrets(msg)))
 		if err != nil {
 			return err
 		}
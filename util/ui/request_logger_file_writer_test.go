@@ -176,6 +176,25 @@ Origin: wss://doppler.bosh-lite.com:443`
 				})
 			})
 
+			Context("when the body contains a secret", func() {
+				BeforeEach(func() {
+					testUI.Secrets = []string{"some-secret"}
+				})
+
+				It("redacts the secret", func() {
+					err := display.DisplayJSONBody([]byte(`{"a":"b some-secret"}`))
+					Expect(err).ToNot(HaveOccurred())
+
+					err = display.Stop()
+					Expect(err).ToNot(HaveOccurred())
+
+					contents, err := ioutil.ReadFile(logFile1)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(ContainSubstring(`"a": "b [PRIVATE DATA HIDDEN]"`))
+					Expect(string(contents)).ToNot(ContainSubstring("some-secret"))
+				})
+			})
+
 			Context("when the body is empty", func() {
 				It("does not write the body", func() {
 					err := display.DisplayJSONBody(nil)
@@ -32,12 +32,12 @@ func (display *RequestLoggerTerminalDisplay) DisplayBody([]byte) error {
 
 func (display *RequestLoggerTerminalDisplay) DisplayDump(dump string) error {
 	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
-	fmt.Fprintf(display.ui.Out, "%s\n", sanitized)
+	fmt.Fprintf(display.ui.Out, "%s\n", display.ui.redactSecrets(sanitized))
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayHeader(name string, value string) error {
-	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText(name), value)
+	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText(name), display.ui.redactSecrets(value))
 	return nil
 }
 
@@ -53,7 +53,7 @@ func (display *RequestLoggerTerminalDisplay) DisplayJSONBody(body []byte) error
 
 	sanitized, err := SanitizeJSON(body)
 	if err != nil {
-		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		fmt.Fprintf(display.ui.Out, "%s\n", display.ui.redactSecrets(string(body)))
 		return nil
 	}
 
@@ -63,20 +63,20 @@ func (display *RequestLoggerTerminalDisplay) DisplayJSONBody(body []byte) error
 	encoder.SetIndent("", "  ")
 	err = encoder.Encode(sanitized)
 	if err != nil {
-		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		fmt.Fprintf(display.ui.Out, "%s\n", display.ui.redactSecrets(string(body)))
 	}
 
-	fmt.Fprintf(display.ui.Out, "%s\n", buff.String())
+	fmt.Fprintf(display.ui.Out, "%s\n", display.ui.redactSecrets(buff.String()))
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayMessage(msg string) error {
-	fmt.Fprintf(display.ui.Out, "%s\n", msg)
+	fmt.Fprintf(display.ui.Out, "%s\n", display.ui.redactSecrets(msg))
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
-	fmt.Fprintf(display.ui.Out, "%s %s %s\n", method, uri, httpProtocol)
+	fmt.Fprintf(display.ui.Out, "%s %s %s\n", method, display.ui.redactSecrets(uri), httpProtocol)
 	return nil
 }
 
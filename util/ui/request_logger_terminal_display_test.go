@@ -79,6 +79,23 @@ Origin: wss://doppler.bosh-lite.com:443`
 
 			Expect(testUI.Out).To(Say("Header: Value"))
 		})
+
+		Context("when the value contains a secret", func() {
+			BeforeEach(func() {
+				testUI.Secrets = []string{"some-secret"}
+			})
+
+			It("redacts the secret", func() {
+				err := display.DisplayHeader("Header", "Value some-secret")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = display.Stop()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Header: Value \\[PRIVATE DATA HIDDEN\\]"))
+				Expect(testUI.Out).ToNot(Say("some-secret"))
+			})
+		})
 	})
 
 	Describe("DisplayHost", func() {
@@ -93,6 +110,24 @@ Origin: wss://doppler.bosh-lite.com:443`
 		})
 	})
 
+	Describe("DisplayMessage", func() {
+		Context("when the message contains a secret", func() {
+			BeforeEach(func() {
+				testUI.Secrets = []string{"some-secret", ""}
+			})
+
+			It("redacts the secret", func() {
+				err := display.DisplayMessage("failed with some-secret")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = display.Stop()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("failed with \\[PRIVATE DATA HIDDEN\\]"))
+			})
+		})
+	})
+
 	Describe("DisplayJSONBody", func() {
 		Context("when provided well formed JSON", func() {
 			It("displayed a formated output", func() {
@@ -3,10 +3,11 @@ package ui
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"regexp"
 )
 
-var keysToSanitize = regexp.MustCompile("(?i).*(?:token|password).*")
+var keysToSanitize = regexp.MustCompile("(?i).*(?:token|password|secret).*")
 
 const tokenEndpoint = "token_endpoint"
 
@@ -36,3 +37,18 @@ func iterateAndRedact(blob map[string]interface{}) map[string]interface{} {
 
 	return blob
 }
+
+// SanitizeFormValues redacts the values of the form fields that SanitizeJSON
+// would redact.
+func SanitizeFormValues(values url.Values) url.Values {
+	sanitized := url.Values{}
+	for key, value := range values {
+		if keysToSanitize.Match([]byte(key)) && key != tokenEndpoint {
+			sanitized[key] = []string{RedactedValue}
+		} else {
+			sanitized[key] = value
+		}
+	}
+
+	return sanitized
+}
@@ -1,6 +1,8 @@
 package ui_test
 
 import (
+	"net/url"
+
 	. "code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -16,7 +18,8 @@ var _ = Describe("SanitizeJSON", func() {
 				"again": {
 					"real password ": "Don't tell nobody, it's banana",
 					"token_endpoint": "some url",
-					"testtokentest": "banana pants"
+					"testtokentest": "banana pants",
+					"client_secret": "shhh"
 				}
 			}
 		}`)
@@ -29,6 +32,7 @@ var _ = Describe("SanitizeJSON", func() {
 					"real password ": RedactedValue,
 					"token_endpoint": "some url",
 					"testtokentest":  RedactedValue,
+					"client_secret":  RedactedValue,
 				},
 			},
 		}
@@ -38,3 +42,22 @@ var _ = Describe("SanitizeJSON", func() {
 		Expect(redacted).To(Equal(expected))
 	})
 })
+
+var _ = Describe("SanitizeFormValues", func() {
+	It("redacts the same fields as SanitizeJSON", func() {
+		values := url.Values{
+			"grant_type":     {"refresh_token"},
+			"refresh_token":  {"some-refresh-token"},
+			"password":       {"some-password"},
+			"token_endpoint": {"some url"},
+		}
+
+		Expect(SanitizeFormValues(values)).To(Equal(url.Values{
+			"grant_type":     {"refresh_token"},
+			"refresh_token":  {RedactedValue},
+			"password":       {RedactedValue},
+			"token_endpoint": {"some url"},
+		}))
+		Expect(values.Get("password")).To(Equal("some-password"))
+	})
+})
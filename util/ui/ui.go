@@ -6,6 +6,7 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -35,6 +36,10 @@ type Config interface {
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
 	TerminalWidth() int
+	// OutputFormat is the format command results are displayed in
+	OutputFormat() string
+	// Secrets are credentials that must never be displayed
+	Secrets() []string
 }
 
 //go:generate counterfeiter . LogMessage
@@ -78,6 +83,17 @@ type UI struct {
 	TerminalWidth int
 
 	TimezoneLocation *time.Location
+
+	// OutputFormat is the format command results are displayed in. When it is
+	// a structured format, Out is STDERR so that STDOUT only contains the
+	// document.
+	OutputFormat OutputFormat
+	// DocumentOut is the buffer DisplayDocument writes to
+	DocumentOut io.Writer
+
+	// Secrets are values, such as passwords read from the environment, that
+	// are replaced with RedactedValue wherever they appear in request logs.
+	Secrets []string
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -88,11 +104,21 @@ func NewUI(config Config) (*UI, error) {
 		return nil, err
 	}
 
+	outputFormat, err := ParseOutputFormat(config.OutputFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	out := color.Output
+	if outputFormat != OutputFormatTable {
+		out = os.Stderr
+	}
+
 	location := time.Now().Location()
 
 	return &UI{
 		In:               os.Stdin,
-		Out:              color.Output,
+		Out:              out,
 		Err:              os.Stderr,
 		colorEnabled:     config.ColorEnabled(),
 		translate:        translateFunc,
@@ -101,6 +127,9 @@ func NewUI(config Config) (*UI, error) {
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
 		TimezoneLocation: location,
+		OutputFormat:     outputFormat,
+		DocumentOut:      os.Stdout,
+		Secrets:          config.Secrets(),
 	}, nil
 }
 
@@ -121,6 +150,7 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
 		TimezoneLocation: time.UTC,
+		DocumentOut:      out,
 	}
 }
 
@@ -143,17 +173,10 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 // satisfies TranslatableError, otherwise it outputs the original error message
 // to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
 func (ui *UI) DisplayError(err error) {
-	var errMsg string
-	if translatableError, ok := err.(TranslatableError); ok {
-		errMsg = translatableError.Translate(ui.translate)
-	} else {
-		errMsg = err.Error()
-	}
-	fmt.Fprintf(ui.Err, "%s\n", errMsg)
-
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	fmt.Fprintf(ui.Err, "%s\n", ui.TranslateError(err))
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
@@ -213,6 +236,38 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	}
 }
 
+// DisplayLogMessageJSON outputs a given log message to UI.Out as a single
+// line JSON object.
+func (ui *UI) DisplayLogMessageJSON(message LogMessage) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	raw, err := json.Marshal(struct {
+		Timestamp      string `json:"timestamp"`
+		SourceType     string `json:"source_type"`
+		SourceInstance string `json:"source_instance"`
+		MessageType    string `json:"message_type"`
+		Message        string `json:"message"`
+	}{
+		Timestamp:      message.Timestamp().UTC().Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		MessageType:    message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(ui.Out, "%s\n", raw)
+	return err
+}
+
+// Reader returns the input buffer.
+func (ui *UI) Reader() io.Reader {
+	return ui.In
+}
+
 // DisplayNewline outputs a newline to UI.Out.
 func (ui *UI) DisplayNewline() {
 	ui.terminalLock.Lock()
@@ -319,11 +374,17 @@ func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]
 // DisplayWarning translates the warning, substitutes in templateValues, and
 // outputs to ui.Err. Only the first map in templateValues is used.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
 	fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(template, templateValues...))
 }
 
 // DisplayWarnings translates the warnings and outputs to ui.Err.
 func (ui *UI) DisplayWarnings(warnings []string) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
 	for _, warning := range warnings {
 		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
 	}
@@ -349,6 +410,15 @@ func (ui *UI) TranslateText(template string, templateValues ...map[string]interf
 	return ui.translate(template, getFirstSet(templateValues))
 }
 
+// TranslateError returns the message of err, translated if it is a
+// TranslatableError.
+func (ui *UI) TranslateError(err error) string {
+	if translatableError, ok := err.(TranslatableError); ok {
+		return translatableError.Translate(ui.translate)
+	}
+	return err.Error()
+}
+
 // UserFriendlyDate converts the time to UTC and then formats it to ISO8601.
 func (ui *UI) UserFriendlyDate(input time.Time) string {
 	return input.Local().Format("Mon 02 Jan 15:04:05 MST 2006")
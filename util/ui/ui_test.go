@@ -38,6 +38,15 @@ var _ = Describe("UI", func() {
 		Expect(ui.TimezoneLocation).To(Equal(location))
 	})
 
+	It("sets the Secrets from the config", func() {
+		fakeConfig.SecretsReturns([]string{"some-password"})
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ui.Secrets).To(ConsistOf("some-password"))
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -198,6 +207,25 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayLogMessageJSON", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 500))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints the message as a single line of JSON to STDOUT", func() {
+			err := ui.DisplayLogMessageJSON(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ui.Out).To(Say(`^\{"timestamp":"2016-07-19T23:08:12.0000005Z","source_type":"APP/PROC/WEB","source_instance":"12","message_type":"ERR","message":"This is a \\"log\\" message"\}\n$`))
+		})
+	})
+
 	Describe("DisplayLogMessage", func() {
 		var message *uifakes.FakeLogMessage
 
@@ -540,6 +568,20 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("TranslateError", func() {
+		It("returns the translated message of a TranslatableError", func() {
+			fakeTranslateErr := new(uifakes.FakeTranslatableError)
+			fakeTranslateErr.TranslateReturns("I am an error")
+
+			Expect(ui.TranslateError(fakeTranslateErr)).To(Equal("I am an error"))
+			Expect(fakeTranslateErr.TranslateArgsForCall(0)).NotTo(BeNil())
+		})
+
+		It("returns the message of a generic error", func() {
+			Expect(ui.TranslateError(errors.New("I am a BANANA!"))).To(Equal("I am a BANANA!"))
+		})
+	})
+
 	Describe("TranslateText", func() {
 		It("returns the template", func() {
 			Expect(ui.TranslateText("some-template")).To(Equal("some-template"))
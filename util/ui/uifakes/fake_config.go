@@ -45,6 +45,24 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	OutputFormatStub        func() string
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 string
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SecretsStub        func() []string
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct{}
+	secretsReturns     struct {
+		result1 []string
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -209,6 +227,86 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() string {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 string) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 string) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Secrets() []string {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct{}{})
+	fake.recordInvocation("Secrets", []interface{}{})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.secretsReturns.result1
+}
+
+func (fake *FakeConfig) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeConfig) SecretsReturns(result1 []string) {
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) SecretsReturnsOnCall(i int, result1 []string) {
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -220,6 +318,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.isTTYMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value